@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/apikey"
+	"github.com/mikalai-mitsin/example/internal/pkg/containers"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/urfave/cli/v2"
+)
+
+var apikeyCommand = &cli.Command{
+	Name:      "apikey",
+	Usage:     "Manage the API keys of the service callers",
+	ArgsUsage: "",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "create",
+			Usage:     "Create a key, the key is printed once",
+			Action:    runAPIKeyCreate,
+			ArgsUsage: "NAME",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     "scope",
+					Usage:    "Permission granted to the key, e.g. post_list, repeat for more",
+					Required: true,
+				},
+				&cli.DurationFlag{
+					Name:  "expires-in",
+					Usage: "Expire the key after the `DURATION`, e.g. 720h, never by default",
+				},
+			},
+		},
+		{
+			Name:      "list",
+			Usage:     "Print the keys with their scopes and last use",
+			Action:    runAPIKeyList,
+			ArgsUsage: "",
+		},
+		{
+			Name:      "revoke",
+			Usage:     "Revoke the key with ID",
+			Action:    runAPIKeyRevoke,
+			ArgsUsage: "ID",
+		},
+	},
+}
+
+// runAPIKeyCreate - create api key
+func runAPIKeyCreate(cliContext *cli.Context) error {
+	if cliContext.NArg() != 1 {
+		return cli.Exit("NAME is required", 1)
+	}
+	create := apikey.KeyCreate{
+		Name:   cliContext.Args().First(),
+		Scopes: cliContext.StringSlice("scope"),
+	}
+	if expiresIn := cliContext.Duration("expires-in"); expiresIn != 0 {
+		if expiresIn < 0 {
+			return cli.Exit("--expires-in must be positive", 1)
+		}
+		expiresAt := time.Now().UTC().Add(expiresIn)
+		create.ExpiresAt = &expiresAt
+	}
+	return apiKeys(func(ctx context.Context, store *apikey.Store) error {
+		key, secret, err := store.Create(ctx, create)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(
+			cliContext.App.Writer,
+			"id   %s\nkey  %s\nthe key is not shown again, pass it as \"Authorization: ApiKey <key>\"\n",
+			key.ID,
+			secret,
+		)
+		return err
+	})
+}
+
+// runAPIKeyList - print api keys
+func runAPIKeyList(cliContext *cli.Context) error {
+	return apiKeys(func(ctx context.Context, store *apikey.Store) error {
+		keys, err := store.List(ctx)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if _, err := fmt.Fprintf(
+				cliContext.App.Writer,
+				"%s  %-20s  expires %-20s  last used %-20s  %s\n",
+				key.ID,
+				key.Name,
+				formatTime(key.ExpiresAt),
+				formatTime(key.LastUsedAt),
+				strings.Join(key.Scopes, ","),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// runAPIKeyRevoke - revoke api key
+func runAPIKeyRevoke(cliContext *cli.Context) error {
+	if cliContext.NArg() != 1 {
+		return cli.Exit("ID is required", 1)
+	}
+	id, err := uuid.Parse(cliContext.Args().First())
+	if err != nil {
+		return cli.Exit("ID must be a uuid", 1)
+	}
+	return apiKeys(func(ctx context.Context, store *apikey.Store) error {
+		return store.Revoke(ctx, id)
+	})
+}
+
+// apiKeys - runs the command in the api key container, a failed command exits
+// with non-zero code.
+func apiKeys(command func(ctx context.Context, store *apikey.Store) error) error {
+	app := containers.NewAPIKeyContainer(configPath, command)
+	if err := app.Err(); err != nil {
+		return exitError(err)
+	}
+	return nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.Format(time.RFC3339)
+}
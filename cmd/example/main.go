@@ -31,12 +31,10 @@ func main() {
 		},
 		Action: runServer,
 		Commands: []*cli.Command{
-			{
-				Name:      "migrate",
-				Usage:     "Run migrations",
-				Action:    runMigrations,
-				ArgsUsage: "",
-			},
+			migrateCommand,
+			schemaCommand,
+			purgeCommand,
+			apikeyCommand,
 			{
 				Name:      "server",
 				Usage:     "Run API server",
@@ -56,10 +54,3 @@ func runServer(context *cli.Context) error {
 	app.Run()
 	return nil
 }
-
-// runMigrations - migrate database
-func runMigrations(context *cli.Context) error {
-	app := containers.NewMigrateContainer(configPath)
-	app.Run()
-	return nil
-}
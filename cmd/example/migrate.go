@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/containers"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/urfave/cli/v2"
+)
+
+const migrationsDir = "internal/pkg/postgres/migrations"
+
+var migrateCommand = &cli.Command{
+	Name:      "migrate",
+	Usage:     "Run migrations",
+	Action:    runMigrateUp,
+	ArgsUsage: "",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "up",
+			Usage:     "Apply all or N pending migrations",
+			Action:    runMigrateUp,
+			ArgsUsage: "[N]",
+		},
+		{
+			Name:      "down",
+			Usage:     "Revert N applied migrations, or all of them with --all",
+			Action:    runMigrateDown,
+			ArgsUsage: "[N]",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "all",
+					Usage: "Revert all applied migrations",
+				},
+			},
+		},
+		{
+			Name:      "goto",
+			Usage:     "Migrate up or down to version V",
+			Action:    runMigrateGoto,
+			ArgsUsage: "V",
+		},
+		{
+			Name:      "version",
+			Usage:     "Print the current version",
+			Action:    runMigrateVersion,
+			ArgsUsage: "",
+		},
+		{
+			Name:      "force",
+			Usage:     "Set version V without running migrations and clear the dirty state",
+			Action:    runMigrateForce,
+			ArgsUsage: "V",
+		},
+		{
+			Name:      "status",
+			Usage:     "Print applied and pending migrations",
+			Action:    runMigrateStatus,
+			ArgsUsage: "",
+		},
+		{
+			Name:      "create",
+			Usage:     "Create empty up and down files of a new migration",
+			Action:    runMigrateCreate,
+			ArgsUsage: "NAME",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "dir",
+					Usage: "Migrations `DIR`",
+					Value: migrationsDir,
+				},
+			},
+		},
+	},
+}
+
+// runMigrateUp - apply migrations
+func runMigrateUp(cliContext *cli.Context) error {
+	steps, err := optionalUintArg(cliContext, 0)
+	if err != nil {
+		return err
+	}
+	return migrate(func(ctx context.Context, manager *postgres.MigrateManager) error {
+		return manager.Up(ctx, steps)
+	})
+}
+
+// runMigrateDown - revert migrations
+func runMigrateDown(cliContext *cli.Context) error {
+	steps, err := optionalUintArg(cliContext, 0)
+	if err != nil {
+		return err
+	}
+	if steps == 0 && !cliContext.Bool("all") {
+		return cli.Exit("pass the number of migrations to revert or --all", 1)
+	}
+	return migrate(func(ctx context.Context, manager *postgres.MigrateManager) error {
+		return manager.Down(ctx, steps)
+	})
+}
+
+// runMigrateGoto - migrate to version
+func runMigrateGoto(cliContext *cli.Context) error {
+	version, err := requiredUintArg(cliContext, "V")
+	if err != nil {
+		return err
+	}
+	return migrate(func(ctx context.Context, manager *postgres.MigrateManager) error {
+		return manager.Goto(ctx, version)
+	})
+}
+
+// runMigrateVersion - print version
+func runMigrateVersion(cliContext *cli.Context) error {
+	return migrate(func(ctx context.Context, manager *postgres.MigrateManager) error {
+		version, dirty, err := manager.Version(ctx)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			_, err = fmt.Fprintf(cliContext.App.Writer, "%d (dirty)\n", version)
+			return err
+		}
+		_, err = fmt.Fprintf(cliContext.App.Writer, "%d\n", version)
+		return err
+	})
+}
+
+// runMigrateForce - force version
+func runMigrateForce(cliContext *cli.Context) error {
+	if cliContext.NArg() != 1 {
+		return cli.Exit("V is required", 1)
+	}
+	version, err := strconv.Atoi(cliContext.Args().First())
+	if err != nil || version < -1 {
+		return cli.Exit("V must be a version or -1", 1)
+	}
+	return migrate(func(ctx context.Context, manager *postgres.MigrateManager) error {
+		return manager.Force(ctx, version)
+	})
+}
+
+// runMigrateStatus - print migrations
+func runMigrateStatus(cliContext *cli.Context) error {
+	return migrate(func(ctx context.Context, manager *postgres.MigrateManager) error {
+		statuses, err := manager.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			switch {
+			case status.Dirty:
+				state = "dirty"
+			case status.Applied:
+				state = "applied"
+			}
+			if _, err := fmt.Fprintf(
+				cliContext.App.Writer,
+				"%06d  %-8s  %s\n",
+				status.Version,
+				state,
+				status.Name,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// runMigrateCreate - create migration files
+func runMigrateCreate(cliContext *cli.Context) error {
+	if cliContext.NArg() != 1 {
+		return cli.Exit("NAME is required", 1)
+	}
+	files, err := postgres.CreateMigration(cliContext.String("dir"), cliContext.Args().First())
+	if err != nil {
+		return exitError(err)
+	}
+	for _, file := range files {
+		if _, err := fmt.Fprintln(cliContext.App.Writer, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrate - runs the command in the migrate container, a failed command exits
+// with non-zero code.
+func migrate(command func(ctx context.Context, manager *postgres.MigrateManager) error) error {
+	app := containers.NewMigrateContainer(configPath, command)
+	if err := app.Err(); err != nil {
+		return exitError(err)
+	}
+	return nil
+}
+
+// exitError - exits with non-zero code printing the error with its cause.
+func exitError(err error) error {
+	var domainError *errs.Error
+	if errors.As(err, &domainError) && domainError.Cause() != nil {
+		return cli.Exit(fmt.Sprintf("%s: %s", domainError.Error(), domainError.Cause()), 1)
+	}
+	return cli.Exit(err.Error(), 1)
+}
+
+func optionalUintArg(cliContext *cli.Context, value uint) (uint, error) {
+	if cliContext.NArg() == 0 {
+		return value, nil
+	}
+	if cliContext.NArg() > 1 {
+		return 0, cli.Exit("too many arguments", 1)
+	}
+	number, err := strconv.ParseUint(cliContext.Args().First(), 10, 64)
+	if err != nil {
+		return 0, cli.Exit("N must be a positive number", 1)
+	}
+	return uint(number), nil
+}
+
+func requiredUintArg(cliContext *cli.Context, name string) (uint, error) {
+	if cliContext.NArg() != 1 {
+		return 0, cli.Exit(name+" is required", 1)
+	}
+	number, err := strconv.ParseUint(cliContext.Args().First(), 10, 64)
+	if err != nil {
+		return 0, cli.Exit(name+" must be a positive number", 1)
+	}
+	return uint(number), nil
+}
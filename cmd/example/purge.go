@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/containers"
+	"github.com/mikalai-mitsin/example/internal/pkg/purge"
+	"github.com/urfave/cli/v2"
+)
+
+var purgeCommand = &cli.Command{
+	Name:      "purge",
+	Usage:     "Hard delete the soft deleted records older than the retention",
+	Action:    runPurge,
+	ArgsUsage: "",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Print the number of records to purge without deleting them",
+		},
+	},
+}
+
+// runPurge - purge soft deleted records
+func runPurge(cliContext *cli.Context) error {
+	dryRun := cliContext.Bool("dry-run")
+	app := containers.NewPurgeContainer(configPath, func(ctx context.Context, worker *purge.Worker) error {
+		results, err := worker.Purge(ctx, dryRun)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if _, err := fmt.Fprintf(cliContext.App.Writer, "%-8s  %d\n", result.Entity, result.Count); err != nil {
+				return err
+			}
+		}
+		if dryRun {
+			_, err = fmt.Fprintln(cliContext.App.Writer, "dry run, nothing is deleted")
+		}
+		return err
+	})
+	if err := app.Err(); err != nil {
+		return exitError(err)
+	}
+	return nil
+}
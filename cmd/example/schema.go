@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/containers"
+	"github.com/urfave/cli/v2"
+)
+
+var schemaCommand = &cli.Command{
+	Name:  "schema",
+	Usage: "Database schema tools",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "verify",
+			Usage:     "Compare the repositories with the database schema",
+			Action:    runSchemaVerify,
+			ArgsUsage: "",
+		},
+	},
+}
+
+// runSchemaVerify - verify database schema
+func runSchemaVerify(cliContext *cli.Context) error {
+	app := containers.NewSchemaContainer(configPath)
+	if err := app.Err(); err != nil {
+		return exitError(err)
+	}
+	_, err := fmt.Fprintln(cliContext.App.Writer, "schema is up to date")
+	return err
+}
@@ -0,0 +1,72 @@
+package access
+
+import (
+	grantGrpcHandlers "github.com/mikalai-mitsin/example/internal/app/access/handlers/grpc/grant"
+	grantHttpHandlers "github.com/mikalai-mitsin/example/internal/app/access/handlers/http/grant"
+	grantPostgresRepositories "github.com/mikalai-mitsin/example/internal/app/access/repositories/postgres/grant"
+	grantServices "github.com/mikalai-mitsin/example/internal/app/access/services/grant"
+	grantUseCases "github.com/mikalai-mitsin/example/internal/app/access/usecases/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
+	"github.com/mikalai-mitsin/example/internal/pkg/clock"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/grpc"
+	"github.com/mikalai-mitsin/example/internal/pkg/http"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type App struct {
+	readDB           postgres.Database
+	writeDB          postgres.Database
+	dtxManager       *dtx.Manager
+	logger           log.Logger
+	grantRepository  *grantPostgresRepositories.GrantRepository
+	grantService     *grantServices.GrantService
+	grantUseCase     *grantUseCases.GrantUseCase
+	httpGrantHandler *grantHttpHandlers.GrantHandler
+	grpcGrantHandler *grantGrpcHandlers.GrantServiceServer
+}
+
+func NewApp(
+	readDB, writeDB postgres.Database,
+	dtxManager *dtx.Manager,
+	logger log.Logger,
+	clock *clock.Clock,
+	uuidGenerator *uuid.UUIDv7Generator,
+	authorizer *authz.Authorizer,
+) *App {
+	grantRepository := grantPostgresRepositories.NewGrantRepository(readDB, writeDB, logger)
+	grantService := grantServices.NewGrantService(grantRepository, clock, logger, uuidGenerator)
+	grantUseCase := grantUseCases.NewGrantUseCase(grantService, dtxManager, authorizer, logger)
+	httpGrantHandler := grantHttpHandlers.NewGrantHandler(grantUseCase, logger)
+	grpcGrantHandler := grantGrpcHandlers.NewGrantServiceServer(grantUseCase, logger)
+	return &App{
+		readDB:           readDB,
+		writeDB:          writeDB,
+		dtxManager:       dtxManager,
+		logger:           logger,
+		grantRepository:  grantRepository,
+		grantService:     grantService,
+		grantUseCase:     grantUseCase,
+		httpGrantHandler: httpGrantHandler,
+		grpcGrantHandler: grpcGrantHandler,
+	}
+}
+func (a *App) RegisterHTTP(httpServer *http.Server) error {
+	if err := a.httpGrantHandler.RegisterHTTP(httpServer); err != nil {
+		return err
+	}
+	return nil
+}
+func (a *App) RegisterGRPC(grpcServer *grpc.Server) error {
+	if err := a.grpcGrantHandler.RegisterGRPC(grpcServer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tables - tables of the app repositories.
+func Tables() []postgres.Table {
+	return []postgres.Table{grantPostgresRepositories.Table}
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+// Permissions of the grant actions.
+const (
+	PermissionGrantList   authz.Permission = "grant_list"
+	PermissionGrantDetail authz.Permission = "grant_detail"
+	PermissionGrantCreate authz.Permission = "grant_create"
+	PermissionGrantDelete authz.Permission = "grant_delete"
+)
+
+// Grant - role granted to the user, the user is the subject of the access token.
+type Grant struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UserId    string    `json:"user_id"`
+	RoleId    string    `json:"role_id"`
+}
+
+func (m *Grant) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.ID, validation.Required),
+		validation.Field(&m.CreatedAt, validation.Required),
+		validation.Field(&m.UserId, validation.Required),
+		validation.Field(&m.RoleId, validation.Required),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+// GrantFilterMaxValues - the most values of a list field of the filter.
+const GrantFilterMaxValues = 100
+
+type GrantFilter struct {
+	PageSize     *uint64  `json:"page_size"`
+	PageNumber   *uint64  `json:"page_number"`
+	IncludeCount *bool    `json:"include_count"`
+	UserIds      []string `json:"user_ids"`
+	RoleIds      []string `json:"role_ids"`
+}
+
+func (m *GrantFilter) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.PageSize),
+		validation.Field(&m.PageNumber),
+		validation.Field(&m.IncludeCount),
+		validation.Field(&m.UserIds, validation.Length(0, GrantFilterMaxValues), validation.Each(validation.Required)),
+		validation.Field(&m.RoleIds, validation.Length(0, GrantFilterMaxValues), validation.Each(validation.Required)),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+// GrantList - page of the grants, Count is nil when the filter excludes it.
+type GrantList struct {
+	Items []Grant `json:"items"`
+	Count *uint64 `json:"count"`
+}
+
+type GrantCreate struct {
+	UserId string `json:"user_id"`
+	RoleId string `json:"role_id"`
+}
+
+func (m *GrantCreate) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.UserId, validation.Required),
+		validation.Field(&m.RoleId, validation.Required),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+// GrantDelete - revokes the role from the user.
+type GrantDelete struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func (m *GrantDelete) Validate() error {
+	err := validation.ValidateStruct(m, validation.Field(&m.ID, validation.Required))
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaswdr/faker"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+func NewMockGrant(t *testing.T) Grant {
+	t.Helper()
+	return Grant{
+		ID:        uuid.NewUUID(),
+		CreatedAt: faker.New().Time().Time(time.Now()),
+		UserId:    faker.New().UUID().V4(),
+		RoleId:    "editor",
+	}
+}
+func NewMockGrantFilter(t *testing.T) GrantFilter {
+	t.Helper()
+	return GrantFilter{
+		PageSize:   pointer.Of(faker.New().UInt64()),
+		PageNumber: pointer.Of(faker.New().UInt64()),
+		UserIds:    []string{faker.New().UUID().V4()},
+		RoleIds:    []string{"editor"},
+	}
+}
+func NewMockGrantCreate(t *testing.T) GrantCreate {
+	t.Helper()
+	return GrantCreate{
+		UserId: faker.New().UUID().V4(),
+		RoleId: "editor",
+	}
+}
+func NewMockGrantDelete(t *testing.T) GrantDelete {
+	t.Helper()
+	return GrantDelete{ID: uuid.NewUUID()}
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func encodeGrantCreate(input *examplepb.GrantCreate) entities.GrantCreate {
+	create := entities.GrantCreate{
+		UserId: input.GetUserId(),
+		RoleId: input.GetRoleId(),
+	}
+	return create
+}
+func encodeGrantFilter(input *examplepb.GrantFilter) entities.GrantFilter {
+	filter := entities.GrantFilter{
+		PageSize:     nil,
+		PageNumber:   nil,
+		IncludeCount: nil,
+		UserIds:      input.GetUserIds(),
+		RoleIds:      input.GetRoleIds(),
+	}
+	if input.GetPageSize() != nil {
+		filter.PageSize = pointer.Of(input.GetPageSize().GetValue())
+	}
+	if input.GetPageNumber() != nil {
+		filter.PageNumber = pointer.Of(input.GetPageNumber().GetValue())
+	}
+	if input.GetIncludeCount() != nil {
+		filter.IncludeCount = pointer.Of(input.GetIncludeCount().GetValue())
+	}
+	return filter
+}
+func encodeGrantDelete(input *examplepb.GrantDelete) entities.GrantDelete {
+	del := entities.GrantDelete{ID: uuid.MustParse(input.GetId())}
+	return del
+}
+func decodeGrant(grant entities.Grant) *examplepb.Grant {
+	response := &examplepb.Grant{
+		Id:        grant.ID.String(),
+		CreatedAt: timestamppb.New(grant.CreatedAt),
+		UserId:    grant.UserId,
+		RoleId:    grant.RoleId,
+	}
+	return response
+}
+func decodeListGrant(list entities.GrantList) *examplepb.ListGrant {
+	response := &examplepb.ListGrant{
+		Items: make([]*examplepb.Grant, 0, len(list.Items)),
+		Count: 0,
+	}
+	for _, grant := range list.Items {
+		response.Items = append(response.Items, decodeGrant(grant))
+	}
+	if list.Count != nil {
+		response.Count = *list.Count
+	}
+	return response
+}
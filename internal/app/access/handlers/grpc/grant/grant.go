@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/grpc"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+)
+
+type GrantServiceServer struct {
+	examplepb.UnimplementedGrantServiceServer
+	grantUseCase grantUseCase
+	logger       logger
+}
+
+func NewGrantServiceServer(grantUseCase grantUseCase, logger logger) *GrantServiceServer {
+	return &GrantServiceServer{grantUseCase: grantUseCase, logger: logger}
+}
+
+func (s *GrantServiceServer) Create(
+	ctx context.Context,
+	input *examplepb.GrantCreate,
+) (*examplepb.Grant, error) {
+	item, err := s.grantUseCase.Create(ctx, encodeGrantCreate(input))
+	if err != nil {
+		return nil, err
+	}
+	return decodeGrant(item), nil
+}
+
+func (s *GrantServiceServer) Get(
+	ctx context.Context,
+	input *examplepb.GrantGet,
+) (*examplepb.Grant, error) {
+	item, err := s.grantUseCase.Get(ctx, uuid.MustParse(input.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return decodeGrant(item), nil
+}
+
+func (s *GrantServiceServer) List(
+	ctx context.Context,
+	filter *examplepb.GrantFilter,
+) (*examplepb.ListGrant, error) {
+	list, err := s.grantUseCase.List(ctx, encodeGrantFilter(filter))
+	if err != nil {
+		return nil, err
+	}
+	return decodeListGrant(list), nil
+}
+
+func (s *GrantServiceServer) Delete(
+	ctx context.Context,
+	input *examplepb.GrantDelete,
+) (*examplepb.Grant, error) {
+	grant, err := s.grantUseCase.Delete(ctx, encodeGrantDelete(input))
+	if err != nil {
+		return nil, err
+	}
+	return decodeGrant(grant), nil
+}
+func (s *GrantServiceServer) RegisterGRPC(grpcServer *grpc.Server) error {
+	grpcServer.AddHandler(&examplepb.GrantService_ServiceDesc, s)
+	return nil
+}
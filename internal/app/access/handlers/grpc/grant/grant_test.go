@@ -0,0 +1,358 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNewGrantServiceServer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantUseCase := NewMockgrantUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	type args struct {
+		grantUseCase grantUseCase
+		logger       logger
+	}
+	tests := []struct {
+		name string
+		args args
+		want examplepb.GrantServiceServer
+	}{
+		{
+			name: "ok",
+			args: args{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			want: &GrantServiceServer{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewGrantServiceServer(tt.args.grantUseCase, tt.args.logger)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantServiceServer_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantUseCase := NewMockgrantUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	grant := entities.NewMockGrant(t)
+	input := &examplepb.GrantCreate{UserId: grant.UserId, RoleId: grant.RoleId}
+	create := entities.GrantCreate{UserId: grant.UserId, RoleId: grant.RoleId}
+	type fields struct {
+		grantUseCase grantUseCase
+		logger       logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.GrantCreate
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockGrantUseCase.EXPECT().Create(ctx, create).Return(grant, nil)
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: input,
+			},
+			want:    decodeGrant(grant),
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockGrantUseCase.EXPECT().
+					Create(ctx, create).
+					Return(entities.Grant{}, errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: input,
+			},
+			want:    nil,
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := GrantServiceServer{
+				grantUseCase: tt.fields.grantUseCase,
+				logger:       tt.fields.logger,
+			}
+			got, err := s.Create(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantServiceServer_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantUseCase := NewMockgrantUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	grant := entities.NewMockGrant(t)
+	type fields struct {
+		grantUseCase grantUseCase
+		logger       logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.GrantGet
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockGrantUseCase.EXPECT().Get(ctx, grant.ID).Return(grant, nil)
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.GrantGet{Id: grant.ID.String()},
+			},
+			want:    decodeGrant(grant),
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockGrantUseCase.EXPECT().
+					Get(ctx, grant.ID).
+					Return(entities.Grant{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.GrantGet{Id: grant.ID.String()},
+			},
+			want:    nil,
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := GrantServiceServer{
+				grantUseCase: tt.fields.grantUseCase,
+				logger:       tt.fields.logger,
+			}
+			got, err := s.Get(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantServiceServer_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantUseCase := NewMockgrantUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	grant := entities.NewMockGrant(t)
+	input := &examplepb.GrantFilter{
+		PageNumber:   wrapperspb.UInt64(2),
+		PageSize:     wrapperspb.UInt64(5),
+		IncludeCount: wrapperspb.Bool(true),
+		UserIds:      []string{grant.UserId},
+		RoleIds:      []string{grant.RoleId},
+	}
+	filter := entities.GrantFilter{
+		PageNumber:   pointer.Of(uint64(2)),
+		PageSize:     pointer.Of(uint64(5)),
+		IncludeCount: pointer.Of(true),
+		UserIds:      []string{grant.UserId},
+		RoleIds:      []string{grant.RoleId},
+	}
+	list := entities.GrantList{Items: []entities.Grant{grant}, Count: pointer.Of(uint64(1))}
+	type fields struct {
+		grantUseCase grantUseCase
+		logger       logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.GrantFilter
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.ListGrant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockGrantUseCase.EXPECT().List(ctx, filter).Return(list, nil)
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: input,
+			},
+			want: &examplepb.ListGrant{
+				Items: []*examplepb.Grant{decodeGrant(grant)},
+				Count: 1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockGrantUseCase.EXPECT().
+					List(ctx, filter).
+					Return(entities.GrantList{}, errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: input,
+			},
+			want:    nil,
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := GrantServiceServer{
+				grantUseCase: tt.fields.grantUseCase,
+				logger:       tt.fields.logger,
+			}
+			got, err := s.List(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantServiceServer_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantUseCase := NewMockgrantUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	grant := entities.NewMockGrant(t)
+	type fields struct {
+		grantUseCase grantUseCase
+		logger       logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.GrantDelete
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockGrantUseCase.EXPECT().
+					Delete(ctx, entities.GrantDelete{ID: grant.ID}).
+					Return(grant, nil)
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.GrantDelete{Id: grant.ID.String()},
+			},
+			want:    decodeGrant(grant),
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockGrantUseCase.EXPECT().
+					Delete(ctx, entities.GrantDelete{ID: grant.ID}).
+					Return(entities.Grant{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				grantUseCase: mockGrantUseCase,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.GrantDelete{Id: grant.ID.String()},
+			},
+			want:    nil,
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := GrantServiceServer{
+				grantUseCase: tt.fields.grantUseCase,
+				logger:       tt.fields.logger,
+			}
+			got, err := s.Delete(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
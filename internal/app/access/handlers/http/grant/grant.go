@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	httpServer "github.com/mikalai-mitsin/example/internal/pkg/http"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type GrantHandler struct {
+	grantUseCase grantUseCase
+	logger       logger
+}
+
+func NewGrantHandler(grantUseCase grantUseCase, logger logger) *GrantHandler {
+	return &GrantHandler{grantUseCase: grantUseCase, logger: logger}
+}
+
+// Create
+//
+// @Summary Grant role to user
+// @Tags grant
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param form body GrantCreateDTO true "Create grant request"
+// @Success 201 {object} GrantDTO "Created grant"
+// @Failure 400 {object} errs.Error "Invalid request body or validation error"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 404 {object} errs.Error "Role not found"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/access/grants/ [POST]
+func (h *GrantHandler) Create(w http.ResponseWriter, r *http.Request) {
+	createDTO, err := NewGrantCreateDTO(r)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	create, err := createDTO.toEntity()
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	grant, err := h.grantUseCase.Create(r.Context(), create)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	response, err := NewGrantDTO(grant)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, response)
+}
+
+// Get
+//
+// @Summary Get grant by id
+// @Tags grant
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "UUID"
+// @Success 200 {object} GrantDTO "Requested grant"
+// @Failure 400 {object} errs.Error "Invalid request body or validation error"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 404 {object} errs.Error "Not found"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/access/grants/{id} [GET]
+func (h *GrantHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id := uuid.MustParse(chi.URLParam(r, "id"))
+	grant, err := h.grantUseCase.Get(r.Context(), id)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	response, err := NewGrantDTO(grant)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
+
+// List
+//
+// @Summary List of grants
+// @Tags grant
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param filter query GrantFilterDTO true "Filter of grants"
+// @Success 200 {object} GrantListDTO "Filtered list of grants"
+// @Failure 400 {object} errs.Error "Invalid request body or validation error"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/access/grants/ [GET]
+func (h *GrantHandler) List(w http.ResponseWriter, r *http.Request) {
+	filterDTO, err := NewGrantFilterDTO(r)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	filter, err := filterDTO.toEntity()
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	list, err := h.grantUseCase.List(r.Context(), filter)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	response, err := NewGrantListDto(list)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
+
+// Delete
+//
+// @Summary Revoke grant by id
+// @Tags grant
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "UUID"
+// @Success 200 {object} GrantDTO "Revoked grant"
+// @Failure 400 {object} errs.Error "Invalid request body or validation error"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 404 {object} errs.Error "Not found"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/access/grants/{id} [DELETE]
+func (h *GrantHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	delDTO, err := NewGrantDeleteDTO(r)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	del, err := delDTO.toEntity()
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	grant, err := h.grantUseCase.Delete(r.Context(), del)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	response, err := NewGrantDTO(grant)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
+func (h *GrantHandler) router() chi.Router {
+	router := chi.NewRouter()
+	router.Route("/", func(g chi.Router) {
+		g.Post("/", h.Create)
+		g.Get("/", h.List)
+		g.Get("/{id}", h.Get)
+		g.Delete("/{id}", h.Delete)
+	})
+	return router
+}
+func (h *GrantHandler) RegisterHTTP(httpServer *httpServer.Server) error {
+	httpServer.Mount("/api/v1/access/grants", h.router())
+	return nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type GrantDTO struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UserId    string    `json:"user_id"`
+	RoleId    string    `json:"role_id"`
+}
+
+func NewGrantDTO(entity entities.Grant) (GrantDTO, error) {
+	dto := GrantDTO{
+		ID:        entity.ID,
+		CreatedAt: entity.CreatedAt,
+		UserId:    entity.UserId,
+		RoleId:    entity.RoleId,
+	}
+	return dto, nil
+}
+
+type GrantListDTO struct {
+	Items []GrantDTO `json:"items"`
+	Count *uint64    `json:"count,omitempty"`
+}
+
+func NewGrantListDto(list entities.GrantList) (GrantListDTO, error) {
+	response := GrantListDTO{
+		Items: make([]GrantDTO, len(list.Items)),
+		Count: list.Count,
+	}
+	for i, grant := range list.Items {
+		dto, err := NewGrantDTO(grant)
+		if err != nil {
+			return GrantListDTO{}, err
+		}
+		response.Items[i] = dto
+	}
+	return response, nil
+}
+
+type GrantFilterDTO struct {
+	PageSize     *uint64  `json:"page_size"`
+	PageNumber   *uint64  `json:"page_number"`
+	IncludeCount *bool    `json:"include_count"`
+	UserIds      []string `json:"user_id"`
+	RoleIds      []string `json:"role_id"`
+}
+
+func NewGrantFilterDTO(r *http.Request) (GrantFilterDTO, error) {
+	filter := GrantFilterDTO{
+		PageSize:     nil,
+		PageNumber:   nil,
+		IncludeCount: nil,
+	}
+	if r.URL.Query().Has("page_size") {
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size"))
+		if err != nil {
+			return GrantFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("page_size", "Invalid page_size.").
+				WithCause(err)
+		}
+		filter.PageSize = pointer.Of(uint64(pageSize))
+	}
+	if r.URL.Query().Has("page_number") {
+		pageNumber, err := strconv.Atoi(r.URL.Query().Get("page_number"))
+		if err != nil {
+			return GrantFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("page_number", "Invalid page_number.").
+				WithCause(err)
+		}
+		filter.PageNumber = pointer.Of(uint64(pageNumber))
+	}
+	if r.URL.Query().Has("include_count") {
+		includeCount, err := strconv.ParseBool(r.URL.Query().Get("include_count"))
+		if err != nil {
+			return GrantFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("include_count", "Invalid include_count.").
+				WithCause(err)
+		}
+		filter.IncludeCount = pointer.Of(includeCount)
+	}
+	filter.UserIds = r.URL.Query()["user_id"]
+	filter.RoleIds = r.URL.Query()["role_id"]
+	return filter, nil
+}
+func (dto GrantFilterDTO) toEntity() (entities.GrantFilter, error) {
+	filter := entities.GrantFilter{
+		PageSize:     dto.PageSize,
+		PageNumber:   dto.PageNumber,
+		IncludeCount: dto.IncludeCount,
+		UserIds:      dto.UserIds,
+		RoleIds:      dto.RoleIds,
+	}
+	return filter, nil
+}
+
+type GrantCreateDTO struct {
+	UserId string `json:"user_id"`
+	RoleId string `json:"role_id"`
+}
+
+func NewGrantCreateDTO(r *http.Request) (GrantCreateDTO, error) {
+	create := GrantCreateDTO{}
+	if err := render.DecodeJSON(r.Body, &create); err != nil {
+		return GrantCreateDTO{}, err
+	}
+	return create, nil
+}
+func (dto GrantCreateDTO) toEntity() (entities.GrantCreate, error) {
+	create := entities.GrantCreate{UserId: dto.UserId, RoleId: dto.RoleId}
+	return create, nil
+}
+
+type GrantDeleteDTO struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func NewGrantDeleteDTO(r *http.Request) (GrantDeleteDTO, error) {
+	del := GrantDeleteDTO{ID: uuid.MustParse(chi.URLParam(r, "id"))}
+	return del, nil
+}
+func (dto GrantDeleteDTO) toEntity() (entities.GrantDelete, error) {
+	del := entities.GrantDelete{ID: dto.ID}
+	return del, nil
+}
@@ -0,0 +1,20 @@
+package handlers
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type grantUseCase interface {
+	Create(context.Context, entities.GrantCreate) (entities.Grant, error)
+	Get(context.Context, uuid.UUID) (entities.Grant, error)
+	List(context.Context, entities.GrantFilter) (entities.GrantList, error)
+	Delete(context.Context, entities.GrantDelete) (entities.Grant, error)
+}
+type logger interface {
+	log.Logger
+}
@@ -0,0 +1,163 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type GrantRepository struct {
+	readDB  database
+	writeDB database
+	logger  logger
+}
+
+func NewGrantRepository(readDB database, writeDB database, logger logger) *GrantRepository {
+	return &GrantRepository{readDB: readDB, writeDB: writeDB, logger: logger}
+}
+
+func encodeFilter(q sq.SelectBuilder, filter entities.GrantFilter) sq.SelectBuilder {
+	if len(filter.UserIds) > 0 {
+		q = q.Where(sq.Eq{"user_roles.user_id": filter.UserIds})
+	}
+	if len(filter.RoleIds) > 0 {
+		q = q.Where(sq.Eq{"user_roles.role_id": filter.RoleIds})
+	}
+	return q
+}
+
+type GrantDTO struct {
+	ID        uuid.UUID `db:"id,omitempty"`
+	CreatedAt time.Time `db:"created_at,omitempty"`
+	UserId    string    `db:"user_id"`
+	RoleId    string    `db:"role_id"`
+}
+
+// Table - table of the repository with the columns it uses.
+var Table = postgres.NewTable("public.user_roles", GrantDTO{})
+
+type GrantListDTO []GrantDTO
+
+func (list GrantListDTO) toEntities() []entities.Grant {
+	items := make([]entities.Grant, len(list))
+	for i := range list {
+		items[i] = list[i].toEntity()
+	}
+	return items
+}
+func NewGrantDTOFromEntity(entity entities.Grant) GrantDTO {
+	dto := GrantDTO{
+		ID:        entity.ID,
+		CreatedAt: entity.CreatedAt,
+		UserId:    entity.UserId,
+		RoleId:    entity.RoleId,
+	}
+	return dto
+}
+func (dto GrantDTO) toEntity() entities.Grant {
+	entity := entities.Grant{
+		ID:        dto.ID,
+		CreatedAt: dto.CreatedAt,
+		UserId:    dto.UserId,
+		RoleId:    dto.RoleId,
+	}
+	return entity
+}
+func (r *GrantRepository) Create(ctx context.Context, entity entities.Grant) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	dto := NewGrantDTOFromEntity(entity)
+	q := sq.Insert("public.user_roles").
+		Columns("id", "created_at", "user_id", "role_id").
+		Values(dto.ID, dto.CreatedAt, dto.UserId, dto.RoleId)
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if _, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...); err != nil {
+		e := errs.FromPostgresError(err)
+		return e
+	}
+	return nil
+}
+func (r *GrantRepository) Get(ctx context.Context, id uuid.UUID) (entities.Grant, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	dto := &GrantDTO{}
+	q := sq.Select("user_roles.id", "user_roles.created_at", "user_roles.user_id", "user_roles.role_id").
+		From("public.user_roles").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("grant_id", id.String())
+		return entities.Grant{}, e
+	}
+	return dto.toEntity(), nil
+}
+
+func (r *GrantRepository) List(
+	ctx context.Context,
+	filter entities.GrantFilter,
+) ([]entities.Grant, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto GrantListDTO
+	const pageSize = uint64(10)
+	if filter.PageSize == nil {
+		filter.PageSize = pointer.Of(pageSize)
+	}
+	q := sq.Select("user_roles.id", "user_roles.created_at", "user_roles.user_id", "user_roles.role_id").
+		From("public.user_roles").
+		Limit(*filter.PageSize).
+		OrderBy("user_roles.created_at ASC", "user_roles.id ASC")
+	q = encodeFilter(q, filter)
+	if filter.PageNumber != nil && *filter.PageNumber > 1 {
+		q = q.Offset((*filter.PageNumber - 1) * *filter.PageSize)
+	}
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.readDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err)
+		return nil, e
+	}
+	return dto.toEntities(), nil
+}
+func (r *GrantRepository) Count(ctx context.Context, filter entities.GrantFilter) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	q := sq.Select("count(id)").From("public.user_roles")
+	q = encodeFilter(q, filter)
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	var count uint64
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, &count, query, args...); err != nil {
+		e := errs.FromPostgresError(err)
+		return 0, e
+	}
+	return count, nil
+}
+func (r *GrantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	q := sq.Delete("public.user_roles").Where(sq.Eq{"id": id})
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
+	if err != nil {
+		e := errs.FromPostgresError(err).WithParam("grant_id", fmt.Sprint(id))
+		return e
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		e := errs.FromPostgresError(err).WithParam("grant_id", fmt.Sprint(id))
+		return e
+	}
+	if affected == 0 {
+		e := errs.NewEntityNotFoundError().WithParam("grant_id", fmt.Sprint(id))
+		return e
+	}
+	return nil
+}
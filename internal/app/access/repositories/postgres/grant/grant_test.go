@@ -0,0 +1,571 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+func TestNewGrantRepository(t *testing.T) {
+	mockDB, _, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	type args struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	tests := []struct {
+		name  string
+		setup func()
+		args  args
+		want  *GrantRepository
+	}{
+		{
+			name:  "ok",
+			setup: func() {},
+			args: args{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			want: &GrantRepository{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got := NewGrantRepository(tt.args.readDB, tt.args.writeDB, tt.args.logger)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantRepository_Create(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "INSERT INTO public.user_roles (id,created_at,user_id,role_id) VALUES ($1,$2,$3,$4)"
+	grant := entities.NewMockGrant(t)
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx   context.Context
+		grant entities.Grant
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(grant.ID, grant.CreatedAt, grant.UserId, grant.RoleId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				grant: grant,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(grant.ID, grant.CreatedAt, grant.UserId, grant.RoleId).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				grant: grant,
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &GrantRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			err := r.Create(tt.args.ctx, tt.args.grant)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGrantRepository_Get(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	query := "SELECT user_roles.id, user_roles.created_at, user_roles.user_id, user_roles.role_id FROM public.user_roles WHERE id = $1 LIMIT 1"
+	grant := entities.NewMockGrant(t)
+	ctx := context.Background()
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				rows := newGrantRows(t, []entities.Grant{grant})
+				mock.ExpectQuery(query).WithArgs(grant.ID).WillReturnRows(rows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want:    grant,
+			wantErr: nil,
+		},
+		{
+			name: "unexpected behavior",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(grant.ID).WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want: entities.Grant{},
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("grant_id", grant.ID.String()),
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(grant.ID).WillReturnError(sql.ErrNoRows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewEntityNotFoundError().WithParam("grant_id", grant.ID.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &GrantRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.Get(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantRepository_List(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	query := "SELECT user_roles.id, user_roles.created_at, user_roles.user_id, user_roles.role_id FROM public.user_roles ORDER BY user_roles.created_at ASC, user_roles.id ASC LIMIT 10"
+	filterQuery := "SELECT user_roles.id, user_roles.created_at, user_roles.user_id, user_roles.role_id FROM public.user_roles WHERE user_roles.user_id IN ($1) AND user_roles.role_id IN ($2) ORDER BY user_roles.created_at ASC, user_roles.id ASC LIMIT 5 OFFSET 5"
+	grants := []entities.Grant{entities.NewMockGrant(t), entities.NewMockGrant(t)}
+	filter := entities.GrantFilter{
+		PageSize:   pointer.Of(uint64(5)),
+		PageNumber: pointer.Of(uint64(2)),
+		UserIds:    []string{grants[0].UserId},
+		RoleIds:    []string{grants[0].RoleId},
+	}
+	ctx := context.Background()
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx    context.Context
+		filter entities.GrantFilter
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).WillReturnRows(newGrantRows(t, grants))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: entities.GrantFilter{},
+			},
+			want:    grants,
+			wantErr: nil,
+		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(grants[0].UserId, grants[0].RoleId).
+					WillReturnRows(newGrantRows(t, grants[:1]))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    grants[:1],
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: entities.GrantFilter{},
+			},
+			want:    nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &GrantRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.List(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantRepository_Count(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	query := "SELECT count(id) FROM public.user_roles"
+	filterQuery := "SELECT count(id) FROM public.user_roles WHERE user_roles.user_id IN ($1) AND user_roles.role_id IN ($2)"
+	filter := entities.GrantFilter{UserIds: []string{"user"}, RoleIds: []string{"admin"}}
+	ctx := context.Background()
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx    context.Context
+		filter entities.GrantFilter
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    uint64
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: entities.GrantFilter{},
+			},
+			want:    2,
+			wantErr: nil,
+		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs("user", "admin").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    1,
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: entities.GrantFilter{},
+			},
+			want:    0,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &GrantRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.Count(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantRepository_Delete(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "DELETE FROM public.user_roles WHERE id = $1"
+	grant := entities.NewMockGrant(t)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(grant.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "grant not found",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(grant.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			wantErr: errs.NewEntityNotFoundError().WithParam("grant_id", grant.ID.String()),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(grant.ID).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("grant_id", grant.ID.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &GrantRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			err := r.Delete(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func newGrantRows(t *testing.T, grants []entities.Grant) *sqlmock.Rows {
+	t.Helper()
+	rows := sqlmock.NewRows([]string{
+		"id",
+		"created_at",
+		"user_id",
+		"role_id",
+	})
+	for _, grant := range grants {
+		rows.AddRow(
+			grant.ID,
+			grant.CreatedAt,
+			grant.UserId,
+			grant.RoleId,
+		)
+	}
+	return rows
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type GrantService struct {
+	grantRepository grantRepository
+	clock           clock
+	logger          logger
+	uuid            uuidGenerator
+}
+
+func NewGrantService(
+	grantRepository grantRepository,
+	clock clock,
+	logger logger,
+	uuid uuidGenerator,
+) *GrantService {
+	return &GrantService{
+		grantRepository: grantRepository,
+		clock:           clock,
+		logger:          logger,
+		uuid:            uuid,
+	}
+}
+
+func (s *GrantService) Create(
+	ctx context.Context,
+	create entities.GrantCreate,
+) (entities.Grant, error) {
+	if err := create.Validate(); err != nil {
+		return entities.Grant{}, err
+	}
+	grant := entities.Grant{
+		ID:        s.uuid.NewUUID(),
+		CreatedAt: s.clock.Now().UTC(),
+		UserId:    create.UserId,
+		RoleId:    create.RoleId,
+	}
+	if err := s.grantRepository.Create(ctx, grant); err != nil {
+		return entities.Grant{}, err
+	}
+	return grant, nil
+}
+func (s *GrantService) Get(ctx context.Context, id uuid.UUID) (entities.Grant, error) {
+	grant, err := s.grantRepository.Get(ctx, id)
+	if err != nil {
+		return entities.Grant{}, err
+	}
+	return grant, nil
+}
+
+func (s *GrantService) List(
+	ctx context.Context,
+	filter entities.GrantFilter,
+) (entities.GrantList, error) {
+	if err := filter.Validate(); err != nil {
+		return entities.GrantList{}, err
+	}
+	grants, err := s.grantRepository.List(ctx, filter)
+	if err != nil {
+		return entities.GrantList{}, err
+	}
+	list := entities.GrantList{Items: grants, Count: nil}
+	if filter.IncludeCount == nil || *filter.IncludeCount {
+		count, err := s.grantRepository.Count(ctx, filter)
+		if err != nil {
+			return entities.GrantList{}, err
+		}
+		list.Count = pointer.Of(count)
+	}
+	return list, nil
+}
+
+func (s *GrantService) Delete(
+	ctx context.Context,
+	del entities.GrantDelete,
+) (entities.Grant, error) {
+	if err := del.Validate(); err != nil {
+		return entities.Grant{}, err
+	}
+	grant, err := s.grantRepository.Get(ctx, del.ID)
+	if err != nil {
+		return entities.Grant{}, err
+	}
+	if err := s.grantRepository.Delete(ctx, grant.ID); err != nil {
+		return entities.Grant{}, err
+	}
+	return grant, nil
+}
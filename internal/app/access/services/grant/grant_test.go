@@ -0,0 +1,442 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGrantService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantRepository := NewMockgrantRepository(ctrl)
+	mockClock := NewMockclock(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockUUID := NewMockuuidGenerator(ctrl)
+	type args struct {
+		grantRepository grantRepository
+		clock           clock
+		logger          logger
+		uuid            uuidGenerator
+	}
+	tests := []struct {
+		name  string
+		setup func()
+		args  args
+		want  *GrantService
+	}{
+		{
+			name:  "ok",
+			setup: func() {},
+			args: args{
+				grantRepository: mockGrantRepository,
+				clock:           mockClock,
+				logger:          mockLogger,
+				uuid:            mockUUID,
+			},
+			want: &GrantService{
+				grantRepository: mockGrantRepository,
+				clock:           mockClock,
+				logger:          mockLogger,
+				uuid:            mockUUID,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got := NewGrantService(tt.args.grantRepository, tt.args.clock, tt.args.logger, tt.args.uuid)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantRepository := NewMockgrantRepository(ctrl)
+	mockClock := NewMockclock(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockUUID := NewMockuuidGenerator(ctrl)
+	ctx := context.Background()
+	create := entities.NewMockGrantCreate(t)
+	now := time.Now().UTC()
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	grant := entities.Grant{ID: id, CreatedAt: now, UserId: create.UserId, RoleId: create.RoleId}
+	type fields struct {
+		grantRepository grantRepository
+		clock           clock
+		logger          logger
+		uuid            uuidGenerator
+	}
+	type args struct {
+		ctx    context.Context
+		create entities.GrantCreate
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockUUID.EXPECT().NewUUID().Return(id)
+				mockGrantRepository.EXPECT().Create(ctx, grant).Return(nil)
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				clock:           mockClock,
+				logger:          mockLogger,
+				uuid:            mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    grant,
+			wantErr: nil,
+		},
+		{
+			name: "unknown role",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockUUID.EXPECT().NewUUID().Return(id)
+				mockGrantRepository.EXPECT().
+					Create(ctx, grant).
+					Return(errs.NewReferenceNotFoundError())
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				clock:           mockClock,
+				logger:          mockLogger,
+				uuid:            mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewReferenceNotFoundError(),
+		},
+		{
+			name:  "invalid",
+			setup: func() {},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				clock:           mockClock,
+				logger:          mockLogger,
+				uuid:            mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: entities.GrantCreate{},
+			},
+			want: entities.Grant{},
+			wantErr: errs.NewInvalidFormError().WithParams(
+				errs.Param{Key: "role_id", Value: "cannot be blank"},
+				errs.Param{Key: "user_id", Value: "cannot be blank"},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &GrantService{
+				grantRepository: tt.fields.grantRepository,
+				clock:           tt.fields.clock,
+				logger:          tt.fields.logger,
+				uuid:            tt.fields.uuid,
+			}
+			got, err := s.Create(tt.args.ctx, tt.args.create)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantService_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantRepository := NewMockgrantRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	grant := entities.NewMockGrant(t)
+	type fields struct {
+		grantRepository grantRepository
+		logger          logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockGrantRepository.EXPECT().Get(ctx, grant.ID).Return(grant, nil)
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want:    grant,
+			wantErr: nil,
+		},
+		{
+			name: "Grant not found",
+			setup: func() {
+				mockGrantRepository.EXPECT().
+					Get(ctx, grant.ID).
+					Return(entities.Grant{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &GrantService{
+				grantRepository: tt.fields.grantRepository,
+				logger:          tt.fields.logger,
+			}
+			got, err := s.Get(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantService_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantRepository := NewMockgrantRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	filter := entities.NewMockGrantFilter(t)
+	withoutCount := filter
+	withoutCount.IncludeCount = pointer.Of(false)
+	grants := []entities.Grant{entities.NewMockGrant(t), entities.NewMockGrant(t)}
+	type fields struct {
+		grantRepository grantRepository
+		logger          logger
+	}
+	type args struct {
+		ctx    context.Context
+		filter entities.GrantFilter
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.GrantList
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockGrantRepository.EXPECT().List(ctx, filter).Return(grants, nil)
+				mockGrantRepository.EXPECT().Count(ctx, filter).Return(uint64(2), nil)
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.GrantList{Items: grants, Count: pointer.Of(uint64(2))},
+			wantErr: nil,
+		},
+		{
+			name: "without count",
+			setup: func() {
+				mockGrantRepository.EXPECT().List(ctx, withoutCount).Return(grants, nil)
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: withoutCount,
+			},
+			want:    entities.GrantList{Items: grants, Count: nil},
+			wantErr: nil,
+		},
+		{
+			name: "list error",
+			setup: func() {
+				mockGrantRepository.EXPECT().
+					List(ctx, filter).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.GrantList{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "count error",
+			setup: func() {
+				mockGrantRepository.EXPECT().List(ctx, filter).Return(grants, nil)
+				mockGrantRepository.EXPECT().
+					Count(ctx, filter).
+					Return(uint64(0), errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.GrantList{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &GrantService{
+				grantRepository: tt.fields.grantRepository,
+				logger:          tt.fields.logger,
+			}
+			got, err := s.List(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantService_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantRepository := NewMockgrantRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	grant := entities.NewMockGrant(t)
+	type fields struct {
+		grantRepository grantRepository
+		logger          logger
+	}
+	type args struct {
+		ctx context.Context
+		del entities.GrantDelete
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockGrantRepository.EXPECT().Get(ctx, grant.ID).Return(grant, nil)
+				mockGrantRepository.EXPECT().Delete(ctx, grant.ID).Return(nil)
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: entities.GrantDelete{ID: grant.ID},
+			},
+			want:    grant,
+			wantErr: nil,
+		},
+		{
+			name: "Grant not found",
+			setup: func() {
+				mockGrantRepository.EXPECT().
+					Get(ctx, grant.ID).
+					Return(entities.Grant{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: entities.GrantDelete{ID: grant.ID},
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+		{
+			name: "delete error",
+			setup: func() {
+				mockGrantRepository.EXPECT().Get(ctx, grant.ID).Return(grant, nil)
+				mockGrantRepository.EXPECT().
+					Delete(ctx, grant.ID).
+					Return(errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				grantRepository: mockGrantRepository,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: entities.GrantDelete{ID: grant.ID},
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &GrantService{
+				grantRepository: tt.fields.grantRepository,
+				logger:          tt.fields.logger,
+			}
+			got, err := s.Delete(tt.args.ctx, tt.args.del)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package services
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+	"time"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type grantRepository interface {
+	Create(context.Context, entities.Grant) error
+	Get(context.Context, uuid.UUID) (entities.Grant, error)
+	List(context.Context, entities.GrantFilter) ([]entities.Grant, error)
+	Count(context.Context, entities.GrantFilter) (uint64, error)
+	Delete(context.Context, uuid.UUID) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
+type logger interface {
+	log.Logger
+}
+type uuidGenerator interface {
+	NewUUID() uuid.UUID
+}
@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type GrantUseCase struct {
+	grantService grantService
+	dtxManager   dtxManager
+	authorizer   authorizer
+	logger       logger
+}
+
+func NewGrantUseCase(
+	grantService grantService,
+	dtxManager dtxManager,
+	authorizer authorizer,
+	logger logger,
+) *GrantUseCase {
+	return &GrantUseCase{
+		grantService: grantService,
+		dtxManager:   dtxManager,
+		authorizer:   authorizer,
+		logger:       logger,
+	}
+}
+
+// Create - grants the role to the user.
+func (u *GrantUseCase) Create(
+	ctx context.Context,
+	create entities.GrantCreate,
+) (entities.Grant, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionGrantCreate); err != nil {
+		return entities.Grant{}, err
+	}
+	var grant entities.Grant
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		grant, err = u.grantService.Create(ctx, create)
+		return err
+	})
+	if err != nil {
+		return entities.Grant{}, err
+	}
+	u.logger.WithContext(ctx).Info(
+		"role granted",
+		log.String("user_id", grant.UserId),
+		log.String("role_id", grant.RoleId),
+	)
+	return grant, nil
+}
+func (u *GrantUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Grant, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionGrantDetail); err != nil {
+		return entities.Grant{}, err
+	}
+	grant, err := u.grantService.Get(ctx, id)
+	if err != nil {
+		return entities.Grant{}, err
+	}
+	return grant, nil
+}
+
+func (u *GrantUseCase) List(
+	ctx context.Context,
+	filter entities.GrantFilter,
+) (entities.GrantList, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionGrantList); err != nil {
+		return entities.GrantList{}, err
+	}
+	list, err := u.grantService.List(ctx, filter)
+	if err != nil {
+		return entities.GrantList{}, err
+	}
+	return list, nil
+}
+
+// Delete - revokes the role from the user.
+func (u *GrantUseCase) Delete(
+	ctx context.Context,
+	del entities.GrantDelete,
+) (entities.Grant, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionGrantDelete); err != nil {
+		return entities.Grant{}, err
+	}
+	var grant entities.Grant
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		grant, err = u.grantService.Delete(ctx, del)
+		return err
+	})
+	if err != nil {
+		return entities.Grant{}, err
+	}
+	u.logger.WithContext(ctx).Info(
+		"role revoked",
+		log.String("user_id", grant.UserId),
+		log.String("role_id", grant.RoleId),
+	)
+	return grant, nil
+}
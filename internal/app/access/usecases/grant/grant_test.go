@@ -0,0 +1,506 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGrantUseCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantService := NewMockgrantService(ctrl)
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	type args struct {
+		grantService grantService
+		dtxManager   dtxManager
+		authorizer   authorizer
+		logger       logger
+	}
+	tests := []struct {
+		name  string
+		setup func()
+		args  args
+		want  *GrantUseCase
+	}{
+		{
+			name:  "ok",
+			setup: func() {},
+			args: args{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			want: &GrantUseCase{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got := NewGrantUseCase(
+				tt.args.grantService,
+				tt.args.dtxManager,
+				tt.args.authorizer,
+				tt.args.logger,
+			)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantUseCase_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantService := NewMockgrantService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
+	grant := entities.NewMockGrant(t)
+	create := entities.NewMockGrantCreate(t)
+	type fields struct {
+		grantService grantService
+		dtxManager   dtxManager
+		authorizer   authorizer
+		logger       logger
+	}
+	type args struct {
+		ctx    context.Context
+		create entities.GrantCreate
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockGrantService.EXPECT().Create(txCtx, create).Return(grant, nil)
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    grant,
+			wantErr: nil,
+		},
+		{
+			name: "create error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockGrantService.EXPECT().
+					Create(txCtx, create).
+					Return(entities.Grant{}, errs.NewReferenceNotFoundError())
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewReferenceNotFoundError(),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionGrantCreate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &GrantUseCase{
+				grantService: tt.fields.grantService,
+				dtxManager:   tt.fields.dtxManager,
+				authorizer:   tt.fields.authorizer,
+				logger:       tt.fields.logger,
+			}
+			got, err := u.Create(tt.args.ctx, tt.args.create)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantUseCase_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantService := NewMockgrantService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	ctx := context.Background()
+	grant := entities.NewMockGrant(t)
+	type fields struct {
+		grantService grantService
+		dtxManager   dtxManager
+		authorizer   authorizer
+		logger       logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantDetail).Return(nil)
+				mockGrantService.EXPECT().Get(ctx, grant.ID).Return(grant, nil)
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want:    grant,
+			wantErr: nil,
+		},
+		{
+			name: "Grant not found",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantDetail).Return(nil)
+				mockGrantService.EXPECT().
+					Get(ctx, grant.ID).
+					Return(entities.Grant{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionGrantDetail).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  grant.ID,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &GrantUseCase{
+				grantService: tt.fields.grantService,
+				dtxManager:   tt.fields.dtxManager,
+				authorizer:   tt.fields.authorizer,
+				logger:       tt.fields.logger,
+			}
+			got, err := u.Get(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantUseCase_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantService := NewMockgrantService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	ctx := context.Background()
+	filter := entities.NewMockGrantFilter(t)
+	list := entities.GrantList{
+		Items: []entities.Grant{entities.NewMockGrant(t)},
+		Count: pointer.Of(uint64(1)),
+	}
+	type fields struct {
+		grantService grantService
+		dtxManager   dtxManager
+		authorizer   authorizer
+		logger       logger
+	}
+	type args struct {
+		ctx    context.Context
+		filter entities.GrantFilter
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.GrantList
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantList).Return(nil)
+				mockGrantService.EXPECT().List(ctx, filter).Return(list, nil)
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    list,
+			wantErr: nil,
+		},
+		{
+			name: "list error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantList).Return(nil)
+				mockGrantService.EXPECT().
+					List(ctx, filter).
+					Return(entities.GrantList{}, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.GrantList{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionGrantList).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.GrantList{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &GrantUseCase{
+				grantService: tt.fields.grantService,
+				dtxManager:   tt.fields.dtxManager,
+				authorizer:   tt.fields.authorizer,
+				logger:       tt.fields.logger,
+			}
+			got, err := u.List(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrantUseCase_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGrantService := NewMockgrantService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
+	grant := entities.NewMockGrant(t)
+	del := entities.GrantDelete{ID: grant.ID}
+	type fields struct {
+		grantService grantService
+		dtxManager   dtxManager
+		authorizer   authorizer
+		logger       logger
+	}
+	type args struct {
+		ctx context.Context
+		del entities.GrantDelete
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Grant
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockGrantService.EXPECT().Delete(txCtx, del).Return(grant, nil)
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    grant,
+			wantErr: nil,
+		},
+		{
+			name: "delete error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionGrantDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockGrantService.EXPECT().
+					Delete(txCtx, del).
+					Return(entities.Grant{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionGrantDelete).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				grantService: mockGrantService,
+				dtxManager:   mockDtxManager,
+				authorizer:   mockAuthorizer,
+				logger:       mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Grant{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &GrantUseCase{
+				grantService: tt.fields.grantService,
+				dtxManager:   tt.fields.dtxManager,
+				authorizer:   tt.fields.authorizer,
+				logger:       tt.fields.logger,
+			}
+			got, err := u.Delete(tt.args.ctx, tt.args.del)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
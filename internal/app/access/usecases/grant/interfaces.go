@@ -0,0 +1,28 @@
+package usecases
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+	"database/sql"
+
+	entities "github.com/mikalai-mitsin/example/internal/app/access/entities/grant"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type grantService interface {
+	Create(context.Context, entities.GrantCreate) (entities.Grant, error)
+	Get(context.Context, uuid.UUID) (entities.Grant, error)
+	List(context.Context, entities.GrantFilter) (entities.GrantList, error)
+	Delete(context.Context, entities.GrantDelete) (entities.Grant, error)
+}
+type logger interface {
+	log.Logger
+}
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type authorizer interface {
+	Authorize(context.Context, authz.Permission) error
+}
@@ -1,7 +1,10 @@
 package articles
 
 import (
-	"github.com/jmoiron/sqlx"
+	"context"
+	"time"
+
+	articleEntities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
 	articleGrpcHandlers "github.com/mikalai-mitsin/example/internal/app/articles/handlers/grpc/article"
 	articleHttpHandlers "github.com/mikalai-mitsin/example/internal/app/articles/handlers/http/article"
 	articleKafkaHandlers "github.com/mikalai-mitsin/example/internal/app/articles/handlers/kafka/article"
@@ -9,21 +12,26 @@ import (
 	articlePostgresRepositories "github.com/mikalai-mitsin/example/internal/app/articles/repositories/postgres/article"
 	articleServices "github.com/mikalai-mitsin/example/internal/app/articles/services/article"
 	articleUseCases "github.com/mikalai-mitsin/example/internal/app/articles/usecases/article"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
 	"github.com/mikalai-mitsin/example/internal/pkg/clock"
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/grpc"
 	"github.com/mikalai-mitsin/example/internal/pkg/http"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/outbox"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/purge"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type App struct {
-	readDB               *sqlx.DB
-	writeDB              *sqlx.DB
+	readDB               postgres.Database
+	writeDB              postgres.Database
 	dtxManager           *dtx.Manager
 	logger               log.Logger
-	kafkaProducer        *kafka.Producer
+	outbox               *outbox.Outbox
 	articleRepository    *articlePostgresRepositories.ArticleRepository
 	articleService       *articleServices.ArticleService
 	articleUseCase       *articleUseCases.ArticleUseCase
@@ -35,37 +43,53 @@ type App struct {
 }
 
 func NewApp(
-	readDB, writeDB *sqlx.DB,
+	readDB, writeDB postgres.Database,
+	cursors *postgres.CursorCodec,
 	dtxManager *dtx.Manager,
 	logger log.Logger,
 	clock *clock.Clock,
 	uuidGenerator *uuid.UUIDv7Generator,
-	kafkaProducer *kafka.Producer,
+	eventOutbox *outbox.Outbox,
+	authorizer *authz.Authorizer,
+	authenticator *auth.Authenticator,
 ) *App {
-	articleRepository := articlePostgresRepositories.NewArticleRepository(readDB, writeDB, logger)
+	articleRepository := articlePostgresRepositories.NewArticleRepository(readDB, writeDB, cursors, logger)
 	articleService := articleServices.NewArticleService(
 		articleRepository,
 		clock,
 		logger,
 		uuidGenerator,
 	)
-	articleEventProducer := articleKafkaRepositories.NewArticleEventProducer(kafkaProducer, logger)
+	articleEventProducer := articleKafkaRepositories.NewArticleEventProducer(
+		eventOutbox,
+		clock,
+		logger,
+		uuidGenerator,
+	)
 	articleEventService := articleServices.NewArticleEventService(articleEventProducer, logger)
 	articleUseCase := articleUseCases.NewArticleUseCase(
 		articleService,
 		articleEventService,
 		dtxManager,
+		authorizer,
 		logger,
 	)
 	httpArticleHandler := articleHttpHandlers.NewArticleHandler(articleUseCase, logger)
-	kafkaArticleHandler := articleKafkaHandlers.NewArticleHandler(articleUseCase, logger)
+	kafkaArticleHandler := articleKafkaHandlers.NewArticleHandler(
+		articleUseCase,
+		authenticator,
+		dtxManager,
+		eventOutbox,
+		clock,
+		logger,
+	)
 	grpcArticleHandler := articleGrpcHandlers.NewArticleServiceServer(articleUseCase, logger)
 	return &App{
 		readDB:               readDB,
 		writeDB:              writeDB,
 		dtxManager:           dtxManager,
 		logger:               logger,
-		kafkaProducer:        kafkaProducer,
+		outbox:               eventOutbox,
 		articleRepository:    articleRepository,
 		articleService:       articleService,
 		articleUseCase:       articleUseCase,
@@ -94,3 +118,20 @@ func (a *App) RegisterKafka(consumer *kafka.Consumer) error {
 	}
 	return nil
 }
+
+// RegisterPurge - purges of the soft deleted records of the app entities.
+func (a *App) RegisterPurge(worker *purge.Worker) error {
+	worker.Register("article", func(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error) {
+		articles, err := a.articleUseCase.Purge(
+			ctx,
+			articleEntities.ArticlePurge{DeletedBefore: deletedBefore, Limit: limit},
+		)
+		return len(articles), err
+	})
+	return nil
+}
+
+// Tables - tables of the app repositories.
+func Tables() []postgres.Table {
+	return []postgres.Table{articlePostgresRepositories.Table}
+}
@@ -4,10 +4,23 @@ import (
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
+// Permissions of the article actions, the restore requires the delete one and the
+// moderate one allows to update, delete and restore the articles of other authors.
+const (
+	PermissionArticleList     authz.Permission = "article_list"
+	PermissionArticleDetail   authz.Permission = "article_detail"
+	PermissionArticleCreate   authz.Permission = "article_create"
+	PermissionArticleUpdate   authz.Permission = "article_update"
+	PermissionArticleDelete   authz.Permission = "article_delete"
+	PermissionArticleModerate authz.Permission = "article_moderate"
+)
+
 type Article struct {
 	ID          uuid.UUID  `json:"id"`
 	CreatedAt   time.Time  `json:"created_at"`
@@ -17,6 +30,8 @@ type Article struct {
 	Subtitle    string     `json:"subtitle"`
 	Body        string     `json:"body"`
 	IsPublished bool       `json:"is_published"`
+	AuthorId    string     `json:"author_id"`
+	Headline    *string    `json:"headline"`
 }
 
 func (m *Article) Validate() error {
@@ -30,6 +45,7 @@ func (m *Article) Validate() error {
 		validation.Field(&m.Subtitle, validation.Required),
 		validation.Field(&m.Body, validation.Required),
 		validation.Field(&m.IsPublished),
+		validation.Field(&m.AuthorId),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -40,7 +56,7 @@ func (m *Article) Validate() error {
 type ArticleOrdering string
 
 func (o ArticleOrdering) Validate() error {
-	if err := validation.Validate(o.String(), validation.In(ArticleOrderingUpdatedAtASC.String(), ArticleOrderingUpdatedAtDESC.String(), ArticleOrderingSubtitleDESC.String(), ArticleOrderingIsPublishedASC.String(), ArticleOrderingDeletedAtDESC.String(), ArticleOrderingSubtitleASC.String(), ArticleOrderingBodyDESC.String(), ArticleOrderingIsPublishedDESC.String(), ArticleOrderingIdASC.String(), ArticleOrderingDeletedAtASC.String(), ArticleOrderingTitleASC.String(), ArticleOrderingTitleDESC.String(), ArticleOrderingIdDESC.String(), ArticleOrderingCreatedAtASC.String(), ArticleOrderingBodyASC.String(), ArticleOrderingCreatedAtDESC.String())); err != nil {
+	if err := validation.Validate(o.String(), validation.In(ArticleOrderingUpdatedAtASC.String(), ArticleOrderingUpdatedAtDESC.String(), ArticleOrderingSubtitleDESC.String(), ArticleOrderingIsPublishedASC.String(), ArticleOrderingDeletedAtDESC.String(), ArticleOrderingSubtitleASC.String(), ArticleOrderingBodyDESC.String(), ArticleOrderingIsPublishedDESC.String(), ArticleOrderingIdASC.String(), ArticleOrderingDeletedAtASC.String(), ArticleOrderingTitleASC.String(), ArticleOrderingTitleDESC.String(), ArticleOrderingIdDESC.String(), ArticleOrderingCreatedAtASC.String(), ArticleOrderingBodyASC.String(), ArticleOrderingCreatedAtDESC.String(), ArticleOrderingRelevance.String())); err != nil {
 		return err
 	}
 	return nil
@@ -66,12 +82,48 @@ const ArticleOrderingTitleASC ArticleOrdering = "title"
 const ArticleOrderingIsPublishedDESC ArticleOrdering = "-is_published"
 const ArticleOrderingUpdatedAtDESC ArticleOrdering = "-updated_at"
 
+// ArticleOrderingRelevance - the most relevant to the search first.
+const ArticleOrderingRelevance ArticleOrdering = "relevance"
+
+// ArticleSearchLanguages - languages of the search, english by default.
+var ArticleSearchLanguages = []any{
+	"simple",
+	"danish",
+	"dutch",
+	"english",
+	"finnish",
+	"french",
+	"german",
+	"hungarian",
+	"italian",
+	"norwegian",
+	"portuguese",
+	"romanian",
+	"russian",
+	"spanish",
+	"swedish",
+	"turkish",
+}
+
+// ArticleFilterMaxValues - the most values of a list field of the filter.
+const ArticleFilterMaxValues = 100
+
 type ArticleFilter struct {
-	PageSize   *uint64           `json:"page_size"`
-	PageNumber *uint64           `json:"page_number"`
-	Search     *string           `json:"search"`
-	OrderBy    []ArticleOrdering `json:"order_by"`
-	IsDeleted  *bool             `json:"is_deleted"`
+	PageSize      *uint64           `json:"page_size"`
+	PageNumber    *uint64           `json:"page_number"`
+	Search        *string           `json:"search"`
+	Language      *string           `json:"language"`
+	OrderBy       []ArticleOrdering `json:"order_by"`
+	IsDeleted     *bool             `json:"is_deleted"`
+	Cursor        *string           `json:"cursor"`
+	IncludeCount  *bool             `json:"include_count"`
+	IDs           []uuid.UUID       `json:"ids"`
+	CreatedAfter  *time.Time        `json:"created_after"`
+	CreatedBefore *time.Time        `json:"created_before"`
+	UpdatedAfter  *time.Time        `json:"updated_after"`
+	UpdatedBefore *time.Time        `json:"updated_before"`
+	IsPublished   *bool             `json:"is_published"`
+	AuthorIds     []string          `json:"author_ids"`
 }
 
 func (m *ArticleFilter) Validate() error {
@@ -80,8 +132,44 @@ func (m *ArticleFilter) Validate() error {
 		validation.Field(&m.PageSize),
 		validation.Field(&m.PageNumber),
 		validation.Field(&m.Search),
-		validation.Field(&m.OrderBy),
+		validation.Field(&m.Language, validation.In(ArticleSearchLanguages...)),
+		validation.Field(
+			&m.OrderBy,
+			validation.When(
+				m.Search == nil,
+				validation.Each(
+					validation.NotIn(ArticleOrderingRelevance).
+						Error("relevance ordering requires a search"),
+				),
+			),
+			validation.When(
+				m.Cursor != nil,
+				validation.Each(
+					validation.NotIn(ArticleOrderingRelevance).
+						Error("relevance ordering does not support cursors"),
+				),
+			),
+		),
 		validation.Field(&m.IsDeleted),
+		validation.Field(&m.Cursor),
+		validation.Field(&m.IncludeCount),
+		validation.Field(&m.IDs, validation.Length(0, ArticleFilterMaxValues), validation.Each(uuid.Required)),
+		validation.Field(&m.CreatedAfter),
+		validation.Field(
+			&m.CreatedBefore,
+			validation.When(m.CreatedAfter != nil, validation.Min(pointer.Value(m.CreatedAfter)).Exclusive()),
+		),
+		validation.Field(&m.UpdatedAfter),
+		validation.Field(
+			&m.UpdatedBefore,
+			validation.When(m.UpdatedAfter != nil, validation.Min(pointer.Value(m.UpdatedAfter)).Exclusive()),
+		),
+		validation.Field(&m.IsPublished),
+		validation.Field(
+			&m.AuthorIds,
+			validation.Length(0, ArticleFilterMaxValues),
+			validation.Each(validation.Required),
+		),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -89,11 +177,22 @@ func (m *ArticleFilter) Validate() error {
 	return nil
 }
 
+// ArticleList - page of the articles, Count is nil when the filter excludes it and
+// NextCursor is nil on the last page.
+type ArticleList struct {
+	Items      []Article `json:"items"`
+	Count      *uint64   `json:"count"`
+	NextCursor *string   `json:"next_cursor"`
+}
+
+// ArticleCreate - AuthorId is the authenticated subject creating the article,
+// empty for the trusted callers.
 type ArticleCreate struct {
 	Title       string `json:"title"`
 	Subtitle    string `json:"subtitle"`
 	Body        string `json:"body"`
 	IsPublished bool   `json:"is_published"`
+	AuthorId    string `json:"author_id"`
 }
 
 func (m *ArticleCreate) Validate() error {
@@ -103,6 +202,7 @@ func (m *ArticleCreate) Validate() error {
 		validation.Field(&m.Subtitle, validation.Required),
 		validation.Field(&m.Body, validation.Required),
 		validation.Field(&m.IsPublished),
+		validation.Field(&m.AuthorId),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -144,3 +244,34 @@ func (m *ArticleDelete) Validate() error {
 	}
 	return nil
 }
+
+// ArticleRestore - undoes the soft deletion of the article.
+type ArticleRestore struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func (m *ArticleRestore) Validate() error {
+	err := validation.ValidateStruct(m, validation.Field(&m.ID, validation.Required))
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+// ArticlePurge - hard deletes a batch of the articles soft deleted before DeletedBefore.
+type ArticlePurge struct {
+	DeletedBefore time.Time `json:"deleted_before"`
+	Limit         uint64    `json:"limit"`
+}
+
+func (m *ArticlePurge) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.DeletedBefore, validation.Required),
+		validation.Field(&m.Limit, validation.Required),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
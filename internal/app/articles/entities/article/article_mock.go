@@ -20,6 +20,7 @@ func NewMockArticle(t *testing.T) Article {
 		Subtitle:    faker.New().Lorem().Sentence(15),
 		Body:        faker.New().Lorem().Sentence(15),
 		IsPublished: faker.New().Bool(),
+		AuthorId:    uuid.NewUUID().String(),
 	}
 }
 func NewMockArticleFilter(t *testing.T) ArticleFilter {
@@ -56,6 +57,7 @@ func NewMockArticleCreate(t *testing.T) ArticleCreate {
 		Subtitle:    faker.New().Lorem().Sentence(15),
 		Body:        faker.New().Lorem().Sentence(15),
 		IsPublished: faker.New().Bool(),
+		AuthorId:    uuid.NewUUID().String(),
 	}
 }
 func NewMockArticleUpdate(t *testing.T) ArticleUpdate {
@@ -72,3 +74,11 @@ func NewMockArticleDelete(t *testing.T) ArticleDelete {
 	t.Helper()
 	return ArticleDelete{ID: uuid.NewUUID()}
 }
+func NewMockArticleRestore(t *testing.T) ArticleRestore {
+	t.Helper()
+	return ArticleRestore{ID: uuid.NewUUID()}
+}
+func NewMockArticlePurge(t *testing.T) ArticlePurge {
+	t.Helper()
+	return ArticlePurge{DeletedBefore: time.Now().UTC(), Limit: 100}
+}
@@ -44,11 +44,11 @@ func (s *ArticleServiceServer) List(
 	ctx context.Context,
 	filter *examplepb.ArticleFilter,
 ) (*examplepb.ListArticle, error) {
-	items, count, err := s.articleUseCase.List(ctx, encodeArticleFilter(filter))
+	list, err := s.articleUseCase.List(ctx, encodeArticleFilter(filter))
 	if err != nil {
 		return nil, err
 	}
-	return decodeListArticle(items, count), nil
+	return decodeListArticle(list), nil
 }
 
 func (s *ArticleServiceServer) Update(
@@ -72,6 +72,16 @@ func (s *ArticleServiceServer) Delete(
 	}
 	return decodeArticle(article), nil
 }
+func (s *ArticleServiceServer) Restore(
+	ctx context.Context,
+	input *examplepb.ArticleRestore,
+) (*examplepb.Article, error) {
+	article, err := s.articleUseCase.Restore(ctx, encodeArticleRestore(input))
+	if err != nil {
+		return nil, err
+	}
+	return decodeArticle(article), nil
+}
 func (s *ArticleServiceServer) RegisterGRPC(grpcServer *grpc.Server) error {
 	grpcServer.AddHandler(&examplepb.ArticleService_ServiceDesc, s)
 	return nil
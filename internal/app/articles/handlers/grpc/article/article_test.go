@@ -6,10 +6,12 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 
 	"testing"
+	"time"
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -219,6 +221,92 @@ func TestArticleServiceServer_Delete(t *testing.T) {
 	}
 }
 
+func TestArticleServiceServer_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockArticleUseCase := NewMockarticleUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	article := entities.NewMockArticle(t)
+	restore := entities.NewMockArticleRestore(t)
+	restore.ID = article.ID
+	type fields struct {
+		UnimplementedArticleServiceServer examplepb.UnimplementedArticleServiceServer
+		articleUseCase                    articleUseCase
+		logger                            logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.ArticleRestore
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockArticleUseCase.EXPECT().Restore(ctx, restore).Return(article, nil)
+			},
+			fields: fields{
+				UnimplementedArticleServiceServer: examplepb.UnimplementedArticleServiceServer{},
+				articleUseCase:                    mockArticleUseCase,
+				logger:                            mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				input: &examplepb.ArticleRestore{
+					Id: article.ID.String(),
+				},
+			},
+			want:    decodeArticle(article),
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockArticleUseCase.EXPECT().Restore(ctx, restore).
+					Return(entities.Article{}, errs.NewUnexpectedBehaviorError("i error")).
+					Times(1)
+			},
+			fields: fields{
+				UnimplementedArticleServiceServer: examplepb.UnimplementedArticleServiceServer{},
+				articleUseCase:                    mockArticleUseCase,
+				logger:                            mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				input: &examplepb.ArticleRestore{
+					Id: article.ID.String(),
+				},
+			},
+			want: nil,
+			wantErr: &errs.Error{
+				Code:    13,
+				Message: "Unexpected behavior.",
+				Params:  errs.Params{{Key: "details", Value: "i error"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := ArticleServiceServer{
+				UnimplementedArticleServiceServer: tt.fields.UnimplementedArticleServiceServer,
+				articleUseCase:                    tt.fields.articleUseCase,
+				logger:                            tt.fields.logger,
+			}
+			got, err := s.Restore(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestArticleServiceServer_Get(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -308,8 +396,9 @@ func TestArticleServiceServer_List(t *testing.T) {
 	filter := entities.NewMockArticleFilter(t)
 	count := faker.New().UInt64Between(2, 20)
 	response := &examplepb.ListArticle{
-		Items: make([]*examplepb.Article, 0, int(count)),
-		Count: count,
+		Items:      make([]*examplepb.Article, 0, int(count)),
+		Count:      count,
+		NextCursor: wrapperspb.String("next"),
 	}
 	articles := make([]entities.Article, 0, int(count))
 	type fields struct {
@@ -334,7 +423,7 @@ func TestArticleServiceServer_List(t *testing.T) {
 			setup: func() {
 				mockArticleUseCase.EXPECT().
 					List(ctx, gomock.Any()).
-					Return(articles, count, nil).
+					Return(entities.ArticleList{Items: articles, Count: pointer.Of(count), NextCursor: pointer.Of("next")}, nil).
 					Times(1)
 			},
 			fields: fields{
@@ -360,7 +449,7 @@ func TestArticleServiceServer_List(t *testing.T) {
 				mockArticleUseCase.
 					EXPECT().
 					List(ctx, gomock.Any()).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("i error")).
+					Return(entities.ArticleList{}, errs.NewUnexpectedBehaviorError("i error")).
 					Times(1)
 			},
 			fields: fields{
@@ -483,6 +572,7 @@ func Test_decodeArticle(t *testing.T) {
 		Subtitle:    string(article.Subtitle),
 		Body:        string(article.Body),
 		IsPublished: bool(article.IsPublished),
+		AuthorId:    article.AuthorId,
 	}
 	type args struct {
 		article entities.Article
@@ -499,6 +589,28 @@ func Test_decodeArticle(t *testing.T) {
 			},
 			want: result,
 		},
+		{
+			name: "with headline",
+			args: args{
+				article: func() entities.Article {
+					withHeadline := article
+					withHeadline.Headline = pointer.Of("<b>cat</b> in the house")
+					return withHeadline
+				}(),
+			},
+			want: &examplepb.Article{
+				Id:          article.ID.String(),
+				UpdatedAt:   timestamppb.New(article.UpdatedAt),
+				CreatedAt:   timestamppb.New(article.CreatedAt),
+				DeletedAt:   timestamppb.New(*article.DeletedAt),
+				Title:       string(article.Title),
+				Subtitle:    string(article.Subtitle),
+				Body:        string(article.Body),
+				IsPublished: bool(article.IsPublished),
+				Headline:    wrapperspb.String("<b>cat</b> in the house"),
+				AuthorId:    article.AuthorId,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -534,6 +646,57 @@ func Test_encodeArticleFilter(t *testing.T) {
 				Search:     pointer.Of("my name is"),
 			},
 		},
+		{
+			name: "relevance in language",
+			args: args{
+				input: &examplepb.ArticleFilter{
+					Search:   wrapperspb.String(`"le chat" -chien`),
+					Language: wrapperspb.String("french"),
+					OrderBy:  []string{"relevance"},
+				},
+			},
+			want: entities.ArticleFilter{
+				OrderBy:  []entities.ArticleOrdering{entities.ArticleOrderingRelevance},
+				Search:   pointer.Of(`"le chat" -chien`),
+				Language: pointer.Of("french"),
+			},
+		},
+		{
+			name: "with cursor",
+			args: args{
+				input: &examplepb.ArticleFilter{
+					PageSize:     wrapperspb.UInt64(5),
+					Cursor:       wrapperspb.String("cursor"),
+					IncludeCount: wrapperspb.Bool(false),
+				},
+			},
+			want: entities.ArticleFilter{
+				PageSize:     pointer.Of(uint64(5)),
+				OrderBy:      []entities.ArticleOrdering{},
+				Cursor:       pointer.Of("cursor"),
+				IncludeCount: pointer.Of(false),
+			},
+		},
+		{
+			name: "with fields",
+			args: args{
+				input: &examplepb.ArticleFilter{
+					Ids:           []string{"0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c"},
+					CreatedAfter:  timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					UpdatedBefore: timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+					IsPublished:   wrapperspb.Bool(true),
+					AuthorIds:     []string{"author"},
+				},
+			},
+			want: entities.ArticleFilter{
+				OrderBy:       []entities.ArticleOrdering{},
+				IDs:           []uuid.UUID{uuid.MustParse("0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c")},
+				CreatedAfter:  pointer.Of(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedBefore: pointer.Of(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+				IsPublished:   pointer.Of(true),
+				AuthorIds:     []string{"author"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -20,11 +20,14 @@ func encodeArticleCreate(input *examplepb.ArticleCreate) entities.ArticleCreate
 }
 func encodeArticleFilter(input *examplepb.ArticleFilter) entities.ArticleFilter {
 	filter := entities.ArticleFilter{
-		PageSize:   nil,
-		PageNumber: nil,
-		IsDeleted:  nil,
-		OrderBy:    []entities.ArticleOrdering{},
-		Search:     nil,
+		PageSize:     nil,
+		PageNumber:   nil,
+		IsDeleted:    nil,
+		OrderBy:      []entities.ArticleOrdering{},
+		Search:       nil,
+		Language:     nil,
+		Cursor:       nil,
+		IncludeCount: nil,
 	}
 	if input.GetPageSize() != nil {
 		filter.PageSize = pointer.Of(input.GetPageSize().GetValue())
@@ -38,9 +41,37 @@ func encodeArticleFilter(input *examplepb.ArticleFilter) entities.ArticleFilter
 	if input.GetSearch() != nil {
 		filter.Search = pointer.Of(input.GetSearch().GetValue())
 	}
+	if input.GetLanguage() != nil {
+		filter.Language = pointer.Of(input.GetLanguage().GetValue())
+	}
+	if input.GetCursor() != nil {
+		filter.Cursor = pointer.Of(input.GetCursor().GetValue())
+	}
+	if input.GetIncludeCount() != nil {
+		filter.IncludeCount = pointer.Of(input.GetIncludeCount().GetValue())
+	}
 	for _, orderBy := range input.GetOrderBy() {
 		filter.OrderBy = append(filter.OrderBy, entities.ArticleOrdering(orderBy))
 	}
+	for _, id := range input.GetIds() {
+		filter.IDs = append(filter.IDs, uuid.MustParse(id))
+	}
+	if input.GetCreatedAfter() != nil {
+		filter.CreatedAfter = pointer.Of(input.GetCreatedAfter().AsTime())
+	}
+	if input.GetCreatedBefore() != nil {
+		filter.CreatedBefore = pointer.Of(input.GetCreatedBefore().AsTime())
+	}
+	if input.GetUpdatedAfter() != nil {
+		filter.UpdatedAfter = pointer.Of(input.GetUpdatedAfter().AsTime())
+	}
+	if input.GetUpdatedBefore() != nil {
+		filter.UpdatedBefore = pointer.Of(input.GetUpdatedBefore().AsTime())
+	}
+	if input.GetIsPublished() != nil {
+		filter.IsPublished = pointer.Of(input.GetIsPublished().GetValue())
+	}
+	filter.AuthorIds = append(filter.AuthorIds, input.GetAuthorIds()...)
 	return filter
 }
 func encodeArticleUpdate(input *examplepb.ArticleUpdate) entities.ArticleUpdate {
@@ -63,6 +94,10 @@ func encodeArticleDelete(input *examplepb.ArticleDelete) entities.ArticleDelete
 	del := entities.ArticleDelete{ID: uuid.MustParse(input.GetId())}
 	return del
 }
+func encodeArticleRestore(input *examplepb.ArticleRestore) entities.ArticleRestore {
+	restore := entities.ArticleRestore{ID: uuid.MustParse(input.GetId())}
+	return restore
+}
 func decodeArticle(article entities.Article) *examplepb.Article {
 	response := &examplepb.Article{
 		Id:          article.ID.String(),
@@ -73,20 +108,32 @@ func decodeArticle(article entities.Article) *examplepb.Article {
 		Subtitle:    article.Subtitle,
 		Body:        article.Body,
 		IsPublished: article.IsPublished,
+		AuthorId:    article.AuthorId,
+		Headline:    nil,
 	}
 	if article.DeletedAt != nil {
 		response.DeletedAt = timestamppb.New(*article.DeletedAt)
 	}
+	if article.Headline != nil {
+		response.Headline = wrapperspb.String(*article.Headline)
+	}
 	return response
 }
-func decodeListArticle(items []entities.Article, count uint64) *examplepb.ListArticle {
+func decodeListArticle(list entities.ArticleList) *examplepb.ListArticle {
 	response := &examplepb.ListArticle{
-		Items: make([]*examplepb.Article, 0, len(items)),
-		Count: count,
+		Items:      make([]*examplepb.Article, 0, len(list.Items)),
+		Count:      0,
+		NextCursor: nil,
 	}
-	for _, article := range items {
+	for _, article := range list.Items {
 		response.Items = append(response.Items, decodeArticle(article))
 	}
+	if list.Count != nil {
+		response.Count = *list.Count
+	}
+	if list.NextCursor != nil {
+		response.NextCursor = wrapperspb.String(*list.NextCursor)
+	}
 	return response
 }
 func decodeArticleUpdate(update entities.ArticleUpdate) *examplepb.ArticleUpdate {
@@ -91,13 +91,12 @@ func (mr *MockarticleUseCaseMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockarticleUseCase) List(arg0 context.Context, arg1 article.ArticleFilter) ([]article.Article, uint64, error) {
+func (m *MockarticleUseCase) List(arg0 context.Context, arg1 article.ArticleFilter) (article.ArticleList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]article.Article)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(article.ArticleList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -106,6 +105,21 @@ func (mr *MockarticleUseCaseMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockarticleUseCase)(nil).List), arg0, arg1)
 }
 
+// Restore mocks base method.
+func (m *MockarticleUseCase) Restore(arg0 context.Context, arg1 article.ArticleRestore) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockarticleUseCaseMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockarticleUseCase)(nil).Restore), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockarticleUseCase) Update(arg0 context.Context, arg1 article.ArticleUpdate) (article.Article, error) {
 	m.ctrl.T.Helper()
@@ -24,12 +24,14 @@ func NewArticleHandler(articleUseCase articleUseCase, logger logger) *ArticleHan
 // @Summary Create article
 // @Tags article
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param form body ArticleCreateDTO true "Create article request"
 // @Success 201 {object} ArticleDTO "Created article"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/articles/articles/ [POST]
@@ -63,12 +65,14 @@ func (h *ArticleHandler) Create(w http.ResponseWriter, r *http.Request) {
 // @Summary Get article by id
 // @Tags article
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} ArticleDTO "Requested article"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/articles/articles/{id} [GET]
@@ -93,12 +97,14 @@ func (h *ArticleHandler) Get(w http.ResponseWriter, r *http.Request) {
 // @Summary List of articles
 // @Tags article
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param filter query ArticleFilterDTO true "Filter of articles"
 // @Success 200 {object} ArticleListDTO "Filtered list of articles"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/articles/articles/ [GET]
@@ -113,12 +119,12 @@ func (h *ArticleHandler) List(w http.ResponseWriter, r *http.Request) {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	articles, count, err := h.articleUseCase.List(r.Context(), filter)
+	list, err := h.articleUseCase.List(r.Context(), filter)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	response, err := NewArticleListDto(articles, count)
+	response, err := NewArticleListDto(list)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
@@ -132,6 +138,7 @@ func (h *ArticleHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Summary Update article
 // @Tags article
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
@@ -139,6 +146,7 @@ func (h *ArticleHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} ArticleDTO "Updated article"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/articles/articles/{id} [PATCH]
@@ -172,12 +180,14 @@ func (h *ArticleHandler) Update(w http.ResponseWriter, r *http.Request) {
 // @Summary Delete article by id
 // @Tags article
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} ArticleDTO "Updated article"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/articles/articles/{id} [DELETE]
@@ -205,6 +215,47 @@ func (h *ArticleHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, response)
 }
+
+// Restore
+//
+// @Summary Restore deleted article by id
+// @Tags article
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "UUID"
+// @Success 200 {object} ArticleDTO "Restored article"
+// @Failure 400 {object} errs.Error "Invalid request or article is not deleted"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 404 {object} errs.Error "Not found"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/articles/articles/{id}/restore [POST]
+func (h *ArticleHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	restoreDTO, err := NewArticleRestoreDTO(r)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	restore, err := restoreDTO.toEntity()
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	article, err := h.articleUseCase.Restore(r.Context(), restore)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	response, err := NewArticleDTO(article)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
 func (h *ArticleHandler) router() chi.Router {
 	router := chi.NewRouter()
 	router.Route("/", func(g chi.Router) {
@@ -213,6 +264,7 @@ func (h *ArticleHandler) router() chi.Router {
 		g.Get("/{id}", h.Get)
 		g.Patch("/{id}", h.Update)
 		g.Delete("/{id}", h.Delete)
+		g.Post("/{id}/restore", h.Restore)
 	})
 	return router
 }
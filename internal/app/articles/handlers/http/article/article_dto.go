@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/render"
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	httpServer "github.com/mikalai-mitsin/example/internal/pkg/http"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -23,6 +24,8 @@ type ArticleDTO struct {
 	Subtitle    string     `json:"subtitle"`
 	Body        string     `json:"body"`
 	IsPublished bool       `json:"is_published"`
+	AuthorId    string     `json:"author_id"`
+	Headline    *string    `json:"headline,omitempty"`
 }
 
 func NewArticleDTO(entity entities.Article) (ArticleDTO, error) {
@@ -35,18 +38,25 @@ func NewArticleDTO(entity entities.Article) (ArticleDTO, error) {
 		Subtitle:    entity.Subtitle,
 		Body:        entity.Body,
 		IsPublished: entity.IsPublished,
+		AuthorId:    entity.AuthorId,
+		Headline:    entity.Headline,
 	}
 	return dto, nil
 }
 
 type ArticleListDTO struct {
-	Items []ArticleDTO `json:"items"`
-	Count uint64       `json:"count"`
+	Items      []ArticleDTO `json:"items"`
+	Count      *uint64      `json:"count,omitempty"`
+	NextCursor *string      `json:"next_cursor,omitempty"`
 }
 
-func NewArticleListDto(articles []entities.Article, count uint64) (ArticleListDTO, error) {
-	response := ArticleListDTO{Items: make([]ArticleDTO, len(articles)), Count: count}
-	for i, article := range articles {
+func NewArticleListDto(list entities.ArticleList) (ArticleListDTO, error) {
+	response := ArticleListDTO{
+		Items:      make([]ArticleDTO, len(list.Items)),
+		Count:      list.Count,
+		NextCursor: list.NextCursor,
+	}
+	for i, article := range list.Items {
 		dto, err := NewArticleDTO(article)
 		if err != nil {
 			return ArticleListDTO{}, err
@@ -57,20 +67,33 @@ func NewArticleListDto(articles []entities.Article, count uint64) (ArticleListDT
 }
 
 type ArticleFilterDTO struct {
-	PageSize   *uint64  `json:"page_size"`
-	PageNumber *uint64  `json:"page_number"`
-	OrderBy    []string `json:"order_by"`
-	IsDeleted  *bool    `json:"is_deleted"`
-	Search     *string  `json:"search"`
+	PageSize      *uint64     `json:"page_size"`
+	PageNumber    *uint64     `json:"page_number"`
+	OrderBy       []string    `json:"order_by"`
+	IsDeleted     *bool       `json:"is_deleted"`
+	Search        *string     `json:"search"`
+	Language      *string     `json:"language"`
+	Cursor        *string     `json:"cursor"`
+	IncludeCount  *bool       `json:"include_count"`
+	IDs           []uuid.UUID `json:"id"`
+	CreatedAfter  *time.Time  `json:"created_after"`
+	CreatedBefore *time.Time  `json:"created_before"`
+	UpdatedAfter  *time.Time  `json:"updated_after"`
+	UpdatedBefore *time.Time  `json:"updated_before"`
+	IsPublished   *bool       `json:"is_published"`
+	AuthorIds     []string    `json:"author_id"`
 }
 
 func NewArticleFilterDTO(r *http.Request) (ArticleFilterDTO, error) {
 	filter := ArticleFilterDTO{
-		PageSize:   nil,
-		PageNumber: nil,
-		OrderBy:    nil,
-		IsDeleted:  nil,
-		Search:     nil,
+		PageSize:     nil,
+		PageNumber:   nil,
+		OrderBy:      nil,
+		IsDeleted:    nil,
+		Search:       nil,
+		Language:     nil,
+		Cursor:       nil,
+		IncludeCount: nil,
 	}
 	if r.URL.Query().Has("page_size") {
 		pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size"))
@@ -105,15 +128,66 @@ func NewArticleFilterDTO(r *http.Request) (ArticleFilterDTO, error) {
 	if r.URL.Query().Has("search") {
 		filter.Search = pointer.Of(r.URL.Query().Get("search"))
 	}
+	if r.URL.Query().Has("cursor") {
+		filter.Cursor = pointer.Of(r.URL.Query().Get("cursor"))
+	}
+	if r.URL.Query().Has("include_count") {
+		includeCount, err := strconv.ParseBool(r.URL.Query().Get("include_count"))
+		if err != nil {
+			return ArticleFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("include_count", "Invalid include_count.").
+				WithCause(err)
+		}
+		filter.IncludeCount = pointer.Of(includeCount)
+	}
+	if r.URL.Query().Has("language") {
+		filter.Language = pointer.Of(r.URL.Query().Get("language"))
+	}
+	var err error
+	if filter.IDs, err = httpServer.ParseUUIDs(r, "id"); err != nil {
+		return ArticleFilterDTO{}, err
+	}
+	if filter.CreatedAfter, err = httpServer.ParseTime(r, "created_after"); err != nil {
+		return ArticleFilterDTO{}, err
+	}
+	if filter.CreatedBefore, err = httpServer.ParseTime(r, "created_before"); err != nil {
+		return ArticleFilterDTO{}, err
+	}
+	if filter.UpdatedAfter, err = httpServer.ParseTime(r, "updated_after"); err != nil {
+		return ArticleFilterDTO{}, err
+	}
+	if filter.UpdatedBefore, err = httpServer.ParseTime(r, "updated_before"); err != nil {
+		return ArticleFilterDTO{}, err
+	}
+	if r.URL.Query().Has("is_published") {
+		isPublished, err := strconv.ParseBool(r.URL.Query().Get("is_published"))
+		if err != nil {
+			return ArticleFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("is_published", "Invalid is_published.").
+				WithCause(err)
+		}
+		filter.IsPublished = pointer.Of(isPublished)
+	}
+	filter.AuthorIds = r.URL.Query()["author_id"]
 	return filter, nil
 }
 func (dto ArticleFilterDTO) toEntity() (entities.ArticleFilter, error) {
 	filter := entities.ArticleFilter{
-		PageSize:   dto.PageSize,
-		PageNumber: dto.PageNumber,
-		IsDeleted:  dto.IsDeleted,
-		OrderBy:    []entities.ArticleOrdering{},
-		Search:     dto.Search,
+		PageSize:      dto.PageSize,
+		PageNumber:    dto.PageNumber,
+		IsDeleted:     dto.IsDeleted,
+		OrderBy:       []entities.ArticleOrdering{},
+		Search:        dto.Search,
+		Language:      dto.Language,
+		Cursor:        dto.Cursor,
+		IncludeCount:  dto.IncludeCount,
+		IDs:           dto.IDs,
+		CreatedAfter:  dto.CreatedAfter,
+		CreatedBefore: dto.CreatedBefore,
+		UpdatedAfter:  dto.UpdatedAfter,
+		UpdatedBefore: dto.UpdatedBefore,
+		IsPublished:   dto.IsPublished,
+		AuthorIds:     dto.AuthorIds,
 	}
 	for _, orderBy := range dto.OrderBy {
 		filter.OrderBy = append(filter.OrderBy, entities.ArticleOrdering(orderBy))
@@ -188,3 +262,16 @@ func (dto ArticleDeleteDTO) toEntity() (entities.ArticleDelete, error) {
 	del := entities.ArticleDelete{ID: dto.ID}
 	return del, nil
 }
+
+type ArticleRestoreDTO struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func NewArticleRestoreDTO(r *http.Request) (ArticleRestoreDTO, error) {
+	restore := ArticleRestoreDTO{ID: uuid.MustParse(chi.URLParam(r, "id"))}
+	return restore, nil
+}
+func (dto ArticleRestoreDTO) toEntity() (entities.ArticleRestore, error) {
+	restore := entities.ArticleRestore{ID: dto.ID}
+	return restore, nil
+}
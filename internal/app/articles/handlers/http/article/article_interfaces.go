@@ -12,9 +12,10 @@ import (
 type articleUseCase interface {
 	Create(context.Context, entities.ArticleCreate) (entities.Article, error)
 	Get(context.Context, uuid.UUID) (entities.Article, error)
-	List(context.Context, entities.ArticleFilter) ([]entities.Article, uint64, error)
+	List(context.Context, entities.ArticleFilter) (entities.ArticleList, error)
 	Update(context.Context, entities.ArticleUpdate) (entities.Article, error)
 	Delete(context.Context, entities.ArticleDelete) (entities.Article, error)
+	Restore(context.Context, entities.ArticleRestore) (entities.Article, error)
 }
 type logger interface {
 	log.Logger
@@ -4,36 +4,123 @@ import (
 	"context"
 
 	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	topicName = "example.articles.article.v1"
-	groupID   = "example.articles.article"
+	topicName        = "example.articles.article.commands.v1"
+	resultsTopicName = "example.articles.article.results.v1"
+	groupID          = "example.articles.article"
 )
 
 type ArticleHandler struct {
 	articleUseCase articleUseCase
-	logger         logger
+	commands       *kafka.CommandHandler
 }
 
-func NewArticleHandler(articleUseCase articleUseCase, logger logger) *ArticleHandler {
-	return &ArticleHandler{articleUseCase: articleUseCase, logger: logger}
+func NewArticleHandler(
+	articleUseCase articleUseCase,
+	authenticator authenticator,
+	dtxManager dtxManager,
+	outbox outbox,
+	clock clock,
+	logger logger,
+) *ArticleHandler {
+	return &ArticleHandler{
+		articleUseCase: articleUseCase,
+		commands: kafka.NewCommandHandler(
+			resultsTopicName,
+			authenticator,
+			dtxManager,
+			outbox,
+			clock,
+			logger,
+		),
+	}
 }
+
+// Handle - executes a command and publishes its result for the caller.
 func (h *ArticleHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
-	logger := h.logger.WithContext(ctx)
-	logger.Info(
-		"received message",
-		log.String("topic", msg.Topic),
-		log.Int32("partition", msg.Partition),
-		log.Int64("offset", msg.Offset),
-		log.String("key", string(msg.Key)),
-		log.String("value", string(msg.Value)),
-	)
-	return nil
+	return h.commands.Handle(ctx, msg, h.execute)
+}
+
+func (h *ArticleHandler) execute(ctx context.Context, command *examplepb.Command) (proto.Message, error) {
+	switch {
+	case command.GetPayload().MessageIs(&examplepb.ArticleCreate{}):
+		input := &examplepb.ArticleCreate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		create := encodeArticleCreate(input)
+		if err := create.Validate(); err != nil {
+			return nil, err
+		}
+		article, err := h.articleUseCase.Create(ctx, create)
+		if err != nil {
+			return nil, err
+		}
+		return decodeArticle(article), nil
+	case command.GetPayload().MessageIs(&examplepb.ArticleUpdate{}):
+		input := &examplepb.ArticleUpdate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		update, err := encodeArticleUpdate(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := update.Validate(); err != nil {
+			return nil, err
+		}
+		article, err := h.articleUseCase.Update(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+		return decodeArticle(article), nil
+	case command.GetPayload().MessageIs(&examplepb.ArticleDelete{}):
+		input := &examplepb.ArticleDelete{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		del, err := encodeArticleDelete(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := del.Validate(); err != nil {
+			return nil, err
+		}
+		article, err := h.articleUseCase.Delete(ctx, del)
+		if err != nil {
+			return nil, err
+		}
+		return decodeArticle(article), nil
+	case command.GetPayload().MessageIs(&examplepb.ArticleRestore{}):
+		input := &examplepb.ArticleRestore{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		restore, err := encodeArticleRestore(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := restore.Validate(); err != nil {
+			return nil, err
+		}
+		article, err := h.articleUseCase.Restore(ctx, restore)
+		if err != nil {
+			return nil, err
+		}
+		return decodeArticle(article), nil
+	default:
+		return nil, errs.NewInvalidFormError().WithParam("payload", "Unknown command.")
+	}
 }
 func (h *ArticleHandler) RegisterKafka(consumer *kafka.Consumer) error {
-	consumer.AddHandler(kafka.NewHandler(topicName, groupID, h.Handle))
+	consumer.AddHandler(
+		kafka.NewHandler(topicName, groupID, h.Handle).WithMessageID(kafka.CommandID),
+	)
 	return nil
 }
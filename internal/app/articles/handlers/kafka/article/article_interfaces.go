@@ -3,8 +3,11 @@ package handlers
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -12,9 +15,25 @@ import (
 type articleUseCase interface {
 	Create(context.Context, entities.ArticleCreate) (entities.Article, error)
 	Get(context.Context, uuid.UUID) (entities.Article, error)
-	List(context.Context, entities.ArticleFilter) ([]entities.Article, uint64, error)
+	List(context.Context, entities.ArticleFilter) (entities.ArticleList, error)
 	Update(context.Context, entities.ArticleUpdate) (entities.Article, error)
 	Delete(context.Context, entities.ArticleDelete) (entities.Article, error)
+	Restore(context.Context, entities.ArticleRestore) (entities.Article, error)
+}
+type authenticator interface {
+	Enabled() bool
+	AuthenticateHeader(ctx context.Context, header string) (context.Context, error)
+}
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type outbox interface {
+	Send(ctx context.Context, msg *kafka.Message) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
 }
 type logger interface {
 	log.Logger
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func encodeArticleCreate(input *examplepb.ArticleCreate) entities.ArticleCreate {
+	create := entities.ArticleCreate{
+		Title:       input.GetTitle(),
+		Subtitle:    input.GetSubtitle(),
+		Body:        input.GetBody(),
+		IsPublished: input.GetIsPublished(),
+	}
+	return create
+}
+func encodeArticleUpdate(input *examplepb.ArticleUpdate) (entities.ArticleUpdate, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.ArticleUpdate{}, err
+	}
+	update := entities.ArticleUpdate{ID: id}
+	if input.GetTitle() != nil {
+		update.Title = pointer.Of(string(input.GetTitle().GetValue()))
+	}
+	if input.GetSubtitle() != nil {
+		update.Subtitle = pointer.Of(string(input.GetSubtitle().GetValue()))
+	}
+	if input.GetBody() != nil {
+		update.Body = pointer.Of(string(input.GetBody().GetValue()))
+	}
+	if input.GetIsPublished() != nil {
+		update.IsPublished = pointer.Of(bool(input.GetIsPublished().GetValue()))
+	}
+	return update, nil
+}
+func encodeArticleDelete(input *examplepb.ArticleDelete) (entities.ArticleDelete, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.ArticleDelete{}, err
+	}
+	del := entities.ArticleDelete{ID: id}
+	return del, nil
+}
+func encodeArticleRestore(input *examplepb.ArticleRestore) (entities.ArticleRestore, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.ArticleRestore{}, err
+	}
+	restore := entities.ArticleRestore{ID: id}
+	return restore, nil
+}
+func decodeArticle(article entities.Article) *examplepb.Article {
+	response := &examplepb.Article{
+		Id:          article.ID.String(),
+		CreatedAt:   timestamppb.New(article.CreatedAt),
+		UpdatedAt:   timestamppb.New(article.UpdatedAt),
+		DeletedAt:   nil,
+		Title:       article.Title,
+		Subtitle:    article.Subtitle,
+		Body:        article.Body,
+		IsPublished: article.IsPublished,
+		AuthorId:    article.AuthorId,
+	}
+	if article.DeletedAt != nil {
+		response.DeletedAt = timestamppb.New(*article.DeletedAt)
+	}
+	return response
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"google.golang.org/protobuf/proto"
 )
@@ -15,18 +15,36 @@ const (
 
 type ArticleEventProducer struct {
 	producer producer
+	clock    clock
 	logger   logger
+	uuid     uuidGenerator
 }
 
 func NewArticleEventProducer(
 	producer producer,
+	clock clock,
 	logger logger,
+	uuid uuidGenerator,
 ) *ArticleEventProducer {
-	return &ArticleEventProducer{producer: producer, logger: logger}
+	return &ArticleEventProducer{producer: producer, clock: clock, logger: logger, uuid: uuid}
 }
 
-func (p *ArticleEventProducer) Send(ctx context.Context, article entities.Article) error {
-	data, err := proto.Marshal(decodeArticle(article))
+func (p *ArticleEventProducer) Send(
+	ctx context.Context,
+	eventType events.Type,
+	article entities.Article,
+) error {
+	event, err := kafka.NewEvent(
+		p.uuid.NewUUID(),
+		eventType,
+		p.clock.Now().UTC(),
+		article.ID,
+		decodeArticle(article),
+	)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(event)
 	if err != nil {
 		return err
 	}
@@ -36,7 +54,7 @@ func (p *ArticleEventProducer) Send(ctx context.Context, article entities.Articl
 		Key:   article.ID.String(),
 	}
 	if err := p.producer.Send(ctx, message); err != nil {
-		return errs.FromKafkaError(err)
+		return err
 	}
 	return nil
 }
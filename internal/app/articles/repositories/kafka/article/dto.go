@@ -16,6 +16,7 @@ func decodeArticle(article entities.Article) *examplepb.Article {
 		Subtitle:    article.Subtitle,
 		Body:        article.Body,
 		IsPublished: article.IsPublished,
+		AuthorId:    article.AuthorId,
 	}
 	if article.DeletedAt != nil {
 		response.DeletedAt = timestamppb.New(*article.DeletedAt)
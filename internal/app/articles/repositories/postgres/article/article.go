@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -17,11 +18,17 @@ import (
 type ArticleRepository struct {
 	readDB  database
 	writeDB database
+	cursors cursorCodec
 	logger  logger
 }
 
-func NewArticleRepository(readDB database, writeDB database, logger logger) *ArticleRepository {
-	return &ArticleRepository{readDB: readDB, writeDB: writeDB, logger: logger}
+func NewArticleRepository(
+	readDB database,
+	writeDB database,
+	cursors cursorCodec,
+	logger logger,
+) *ArticleRepository {
+	return &ArticleRepository{readDB: readDB, writeDB: writeDB, cursors: cursors, logger: logger}
 }
 
 var orderByMap = map[entities.ArticleOrdering]string{
@@ -43,16 +50,91 @@ var orderByMap = map[entities.ArticleOrdering]string{
 	entities.ArticleOrderingIsPublishedASC:  "articles.is_published ASC",
 }
 
-func encodeOrderBy(orderBy []entities.ArticleOrdering) []string {
-	columns := make([]string, len(orderBy))
-	for i, item := range orderBy {
+// searchFields - fields of the search in the order of their relevance.
+var searchFields = []string{"title", "subtitle", "body"}
+
+// nullableColumns - columns of the ordering which can be NULL.
+var nullableColumns = []string{"articles.deleted_at"}
+
+// encodeOrderBy - orders the query and returns the columns of the keyset of
+// the cursor, the id is added if missing to make the order total. There is no
+// keyset for the relevance ordering, it is not a column.
+func encodeOrderBy(
+	q sq.SelectBuilder,
+	orderBy []entities.ArticleOrdering,
+	search *postgres.Search,
+) (sq.SelectBuilder, []string) {
+	columns := make([]string, 0, len(orderBy)+1)
+	keyset := true
+	for _, item := range orderBy {
+		if item == entities.ArticleOrderingRelevance {
+			if search != nil {
+				q = q.OrderByClause(search.Rank())
+				keyset = false
+			}
+			continue
+		}
 		column, exists := orderByMap[item]
 		if !exists {
 			continue
 		}
-		columns[i] = column
+		q = q.OrderBy(column)
+		columns = append(columns, column)
+	}
+	if !slices.Contains(orderBy, entities.ArticleOrderingIdASC) &&
+		!slices.Contains(orderBy, entities.ArticleOrderingIdDESC) {
+		q = q.OrderBy(orderByMap[entities.ArticleOrderingIdASC])
+		columns = append(columns, orderByMap[entities.ArticleOrderingIdASC])
+	}
+	if !keyset {
+		return q, nil
+	}
+	return q, columns
+}
+
+func encodeSearch(filter entities.ArticleFilter) *postgres.Search {
+	if filter.Search == nil {
+		return nil
+	}
+	search := &postgres.Search{Lang: postgres.SearchLang, Query: *filter.Search, Fields: searchFields}
+	if filter.Language != nil {
+		search.Lang = *filter.Language
+	}
+	return search
+}
+
+// encodeFilter - conditions of the filter except the search, List and Count
+// share them.
+func encodeFilter(q sq.SelectBuilder, filter entities.ArticleFilter) sq.SelectBuilder {
+	if filter.IsDeleted != nil {
+		if *filter.IsDeleted {
+			q = q.Where(sq.NotEq{"articles.deleted_at": nil})
+		} else {
+			q = q.Where(sq.Eq{"articles.deleted_at": nil})
+		}
+	}
+	if len(filter.IDs) > 0 {
+		q = q.Where(sq.Eq{"articles.id": filter.IDs})
+	}
+	if filter.CreatedAfter != nil {
+		q = q.Where(sq.Gt{"articles.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		q = q.Where(sq.Lt{"articles.created_at": *filter.CreatedBefore})
+	}
+	if filter.UpdatedAfter != nil {
+		q = q.Where(sq.Gt{"articles.updated_at": *filter.UpdatedAfter})
 	}
-	return columns
+	if filter.UpdatedBefore != nil {
+		q = q.Where(sq.Lt{"articles.updated_at": *filter.UpdatedBefore})
+	}
+	if filter.IsPublished != nil {
+		q = q.Where(sq.Eq{"articles.is_published": *filter.IsPublished})
+	}
+	if len(filter.AuthorIds) > 0 {
+		q = q.Where(sq.Eq{"articles.author_id": filter.AuthorIds})
+	}
+	return q
 }
 
 type ArticleDTO struct {
@@ -64,13 +146,24 @@ type ArticleDTO struct {
 	Subtitle    string     `db:"subtitle"`
 	Body        string     `db:"body"`
 	IsPublished bool       `db:"is_published"`
+	AuthorId    string     `db:"author_id"`
+}
+
+// Table - table of the repository with the columns it uses.
+var Table = postgres.NewTable("public.articles", ArticleDTO{})
+
+// ArticleListItemDTO - article of a list with the headline of the search.
+type ArticleListItemDTO struct {
+	ArticleDTO
+	Headline *string `db:"headline"`
 }
-type ArticleListDTO []ArticleDTO
+type ArticleListDTO []ArticleListItemDTO
 
 func (list ArticleListDTO) toEntities() []entities.Article {
 	items := make([]entities.Article, len(list))
 	for i := range list {
 		items[i] = list[i].toEntity()
+		items[i].Headline = list[i].Headline
 	}
 	return items
 }
@@ -84,6 +177,7 @@ func NewArticleDTOFromEntity(entity entities.Article) ArticleDTO {
 		Subtitle:    entity.Subtitle,
 		Body:        entity.Body,
 		IsPublished: entity.IsPublished,
+		AuthorId:    entity.AuthorId,
 	}
 	return dto
 }
@@ -97,18 +191,19 @@ func (dto ArticleDTO) toEntity() entities.Article {
 		Subtitle:    dto.Subtitle,
 		Body:        dto.Body,
 		IsPublished: dto.IsPublished,
+		AuthorId:    dto.AuthorId,
 	}
 	return entity
 }
-func (r *ArticleRepository) Create(ctx context.Context, tx dtx.TX, entity entities.Article) error {
+func (r *ArticleRepository) Create(ctx context.Context, entity entities.Article) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewArticleDTOFromEntity(entity)
 	q := sq.Insert("public.articles").
-		Columns("id", "created_at", "updated_at", "deleted_at", "title", "subtitle", "body", "is_published").
-		Values(dto.ID, dto.CreatedAt, dto.UpdatedAt, dto.DeletedAt, dto.Title, dto.Subtitle, dto.Body, dto.IsPublished)
+		Columns("id", "created_at", "updated_at", "deleted_at", "title", "subtitle", "body", "is_published", "author_id").
+		Values(dto.ID, dto.CreatedAt, dto.UpdatedAt, dto.DeletedAt, dto.Title, dto.Subtitle, dto.Body, dto.IsPublished, dto.AuthorId)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if _, err := tx.GetSQLTx().ExecContext(ctx, query, args...); err != nil {
+	if _, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return e
 	}
@@ -118,12 +213,31 @@ func (r *ArticleRepository) Get(ctx context.Context, id uuid.UUID) (entities.Art
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := &ArticleDTO{}
-	q := sq.Select("articles.id", "articles.created_at", "articles.updated_at", "articles.deleted_at", "articles.title", "articles.subtitle", "articles.body", "articles.is_published").
+	q := sq.Select("articles.id", "articles.created_at", "articles.updated_at", "articles.deleted_at", "articles.title", "articles.subtitle", "articles.body", "articles.is_published", "articles.author_id").
 		From("public.articles").
 		Where(sq.Eq{"id": id}).
 		Limit(1)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.GetContext(ctx, dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("article_id", id.String())
+		return entities.Article{}, e
+	}
+	return dto.toEntity(), nil
+}
+
+// GetForUpdate - the article within the transaction of the context, locked against
+// concurrent changes until the transaction ends.
+func (r *ArticleRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (entities.Article, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	dto := &ArticleDTO{}
+	q := sq.Select("articles.id", "articles.created_at", "articles.updated_at", "articles.deleted_at", "articles.title", "articles.subtitle", "articles.body", "articles.is_published", "articles.author_id").
+		From("public.articles").
+		Where(sq.Eq{"id": id}).
+		Limit(1).
+		Suffix("FOR UPDATE")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).GetContext(ctx, dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err).WithParam("article_id", id.String())
 		return entities.Article{}, e
 	}
@@ -133,7 +247,7 @@ func (r *ArticleRepository) Get(ctx context.Context, id uuid.UUID) (entities.Art
 func (r *ArticleRepository) List(
 	ctx context.Context,
 	filter entities.ArticleFilter,
-) ([]entities.Article, error) {
+) ([]entities.Article, *string, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	var dto ArticleListDTO
@@ -141,38 +255,42 @@ func (r *ArticleRepository) List(
 	if filter.PageSize == nil {
 		filter.PageSize = pointer.Of(pageSize)
 	}
-	q := sq.Select("articles.id", "articles.created_at", "articles.updated_at", "articles.deleted_at", "articles.title", "articles.subtitle", "articles.body", "articles.is_published").
+	q := sq.Select("articles.id", "articles.created_at", "articles.updated_at", "articles.deleted_at", "articles.title", "articles.subtitle", "articles.body", "articles.is_published", "articles.author_id").
 		From("public.articles").
 		Limit(pageSize)
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"articles.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"articles.deleted_at": nil})
-		}
+	q = encodeFilter(q, filter)
+	search := encodeSearch(filter)
+	if search != nil {
+		q = q.Column(sq.Alias(search.Headline(), "headline")).Where(search)
 	}
-	if filter.Search != nil {
-		q = q.Where(
-			postgres.Search{
-				Lang:   "english",
-				Query:  *filter.Search,
-				Fields: []string{"title", "subtitle", "body"},
-			},
-		)
-	}
-	if filter.PageNumber != nil && *filter.PageNumber > 1 {
+	q, orderBy := encodeOrderBy(q, filter.OrderBy, search)
+	if filter.Cursor != nil {
+		values, err := r.cursors.Decode(*filter.Cursor, orderBy)
+		if err != nil {
+			return nil, nil, err
+		}
+		q = q.Where(postgres.Keyset{OrderBy: orderBy, Values: values, Nullable: nullableColumns})
+	} else if filter.PageNumber != nil && *filter.PageNumber > 1 {
 		q = q.Offset((*filter.PageNumber - 1) * *filter.PageSize)
 	}
-	q = q.Limit(*filter.PageSize)
-	if len(filter.OrderBy) > 0 {
-		q = q.OrderBy(encodeOrderBy(filter.OrderBy)...)
-	}
+	q = q.Limit(*filter.PageSize + 1)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.SelectContext(ctx, &dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).SelectContext(ctx, &dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
-		return nil, e
+		return nil, nil, e
 	}
-	return dto.toEntities(), nil
+	var nextCursor *string
+	if uint64(len(dto)) > *filter.PageSize {
+		dto = dto[:*filter.PageSize]
+		if len(dto) > 0 && orderBy != nil {
+			cursor, err := r.cursors.Encode(orderBy, postgres.KeysetValues(dto[len(dto)-1], orderBy))
+			if err != nil {
+				return nil, nil, err
+			}
+			nextCursor = pointer.Of(cursor)
+		}
+	}
+	return dto.toEntities(), nextCursor, nil
 }
 
 func (r *ArticleRepository) Count(
@@ -182,31 +300,19 @@ func (r *ArticleRepository) Count(
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Select("count(id)").From("public.articles")
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"articles.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"articles.deleted_at": nil})
-		}
-	}
-	if filter.Search != nil {
-		q = q.Where(
-			postgres.Search{
-				Lang:   "english",
-				Query:  *filter.Search,
-				Fields: []string{"title", "subtitle", "body"},
-			},
-		)
+	q = encodeFilter(q, filter)
+	if search := encodeSearch(filter); search != nil {
+		q = q.Where(search)
 	}
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
 	var count uint64
-	if err := r.readDB.GetContext(ctx, &count, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, &count, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return 0, e
 	}
 	return count, nil
 }
-func (r *ArticleRepository) Update(ctx context.Context, tx dtx.TX, entity entities.Article) error {
+func (r *ArticleRepository) Update(ctx context.Context, entity entities.Article) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewArticleDTOFromEntity(entity)
@@ -221,7 +327,7 @@ func (r *ArticleRepository) Update(ctx context.Context, tx dtx.TX, entity entiti
 		q = q.Set("is_published", dto.IsPublished)
 	}
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("article_id", fmt.Sprint(entity.ID))
 		return e
@@ -236,12 +342,12 @@ func (r *ArticleRepository) Update(ctx context.Context, tx dtx.TX, entity entiti
 	}
 	return nil
 }
-func (r *ArticleRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID) error {
+func (r *ArticleRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Delete("public.articles").Where(sq.Eq{"id": id})
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("article_id", fmt.Sprint(id))
 		return e
@@ -257,3 +363,26 @@ func (r *ArticleRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID)
 	}
 	return nil
 }
+
+// ListDeleted - the oldest articles soft deleted before the time, locked until the
+// transaction ends and skipped by the concurrent purges.
+func (r *ArticleRepository) ListDeleted(
+	ctx context.Context,
+	deletedBefore time.Time,
+	limit uint64,
+) ([]entities.Article, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto ArticleListDTO
+	q := sq.Select("articles.id", "articles.created_at", "articles.updated_at", "articles.deleted_at", "articles.title", "articles.subtitle", "articles.body", "articles.is_published", "articles.author_id").
+		From("public.articles").
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at ASC", "id ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		return nil, errs.FromPostgresError(err)
+	}
+	return dto.toEntities(), nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jaswdr/faker"
@@ -26,9 +27,15 @@ func TestNewArticleRepository(t *testing.T) {
 		return
 	}
 	defer mockDB.Close()
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	type args struct {
 		writeDB database
 		readDB  database
+		cursors cursorCodec
 		logger  logger
 	}
 	tests := []struct {
@@ -43,17 +50,19 @@ func TestNewArticleRepository(t *testing.T) {
 			args: args{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 			want: &ArticleRepository{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			got := NewArticleRepository(tt.args.readDB, tt.args.writeDB, tt.args.logger)
+			got := NewArticleRepository(tt.args.readDB, tt.args.writeDB, tt.args.cursors, tt.args.logger)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -69,12 +78,16 @@ func TestArticleRepository_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
-	query := "INSERT INTO public.articles (id,created_at,updated_at,deleted_at,title,subtitle,body,is_published) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)"
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "INSERT INTO public.articles (id,created_at,updated_at,deleted_at,title,subtitle,body,is_published,author_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)"
 	article := entities.NewMockArticle(t)
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -82,7 +95,6 @@ func TestArticleRepository_Create(t *testing.T) {
 	}
 	type args struct {
 		ctx     context.Context
-		tx      dtx.TX
 		article entities.Article
 	}
 	tests := []struct {
@@ -105,6 +117,7 @@ func TestArticleRepository_Create(t *testing.T) {
 						article.Subtitle,
 						article.Body,
 						article.IsPublished,
+						article.AuthorId,
 					).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
@@ -115,7 +128,6 @@ func TestArticleRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:     ctx,
-				tx:      mockTX,
 				article: article,
 			},
 			wantErr: nil,
@@ -133,6 +145,7 @@ func TestArticleRepository_Create(t *testing.T) {
 						article.Subtitle,
 						article.Body,
 						article.IsPublished,
+						article.AuthorId,
 					).
 					WillReturnError(errors.New("test error"))
 			},
@@ -143,7 +156,6 @@ func TestArticleRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:     ctx,
-				tx:      mockTX,
 				article: article,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")),
@@ -157,7 +169,7 @@ func TestArticleRepository_Create(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Create(tt.args.ctx, tt.args.tx, tt.args.article)
+			err := r.Create(tt.args.ctx, tt.args.article)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -173,7 +185,7 @@ func TestArticleRepository_Get(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	query := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published FROM public.articles WHERE id = $1 LIMIT 1"
+	query := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published, articles.author_id FROM public.articles WHERE id = $1 LIMIT 1"
 	article := entities.NewMockArticle(t)
 	ctx := context.Background()
 	type fields struct {
@@ -264,6 +276,113 @@ func TestArticleRepository_Get(t *testing.T) {
 	}
 }
 
+func TestArticleRepository_GetForUpdate(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mock.ExpectBegin()
+	mockTX, err := dtx.NewManager(mockDB, &dtx.Config{}).NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published, articles.author_id FROM public.articles WHERE id = $1 LIMIT 1 FOR UPDATE"
+	article := entities.NewMockArticle(t)
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				rows := newArticleRows(t, []entities.Article{article})
+				mock.ExpectQuery(query).WithArgs(article.ID).WillReturnRows(rows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  article.ID,
+			},
+			want:    article,
+			wantErr: nil,
+		},
+		{
+			name: "unexpected behavior",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(article.ID).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  article.ID,
+			},
+			want: entities.Article{},
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("article_id", article.ID.String()),
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(article.ID).WillReturnError(sql.ErrNoRows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  article.ID,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewEntityNotFoundError().WithParam("article_id", article.ID.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &ArticleRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.GetForUpdate(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestArticleRepository_List(t *testing.T) {
 	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
 	if err != nil {
@@ -274,9 +393,14 @@ func TestArticleRepository_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	ctx := context.Background()
 	var articles []entities.Article
-	for i := 0; i < faker.New().IntBetween(2, 20); i++ {
+	for i := 0; i < faker.New().IntBetween(2, 10); i++ {
 		articles = append(articles, entities.NewMockArticle(t))
 	}
 	filter := entities.ArticleFilter{
@@ -285,7 +409,40 @@ func TestArticleRepository_List(t *testing.T) {
 		Search:     nil,
 		OrderBy:    []entities.ArticleOrdering{"id"},
 	}
-	query := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published FROM public.articles ORDER BY articles.id ASC LIMIT 10 OFFSET 10"
+	var page []entities.Article
+	for i := 0; i < 11; i++ {
+		page = append(page, entities.NewMockArticle(t))
+	}
+	nextCursor, err := cursors.Encode([]string{"articles.id ASC"}, []any{page[9].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	cursor, err := cursors.Encode([]string{"articles.id ASC"}, []any{articles[0].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published, articles.author_id FROM public.articles ORDER BY articles.id ASC LIMIT 11 OFFSET 10"
+	cursorQuery := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published, articles.author_id FROM public.articles WHERE ((articles.id > $1)) ORDER BY articles.id ASC LIMIT 11"
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.ArticleFilter{
+		PageSize:      filter.PageSize,
+		OrderBy:       filter.OrderBy,
+		IDs:           []uuid.UUID{articles[0].ID, articles[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		IsPublished:   pointer.Of(true),
+		AuthorIds:     []string{articles[0].AuthorId},
+	}
+	filterQuery := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published, articles.author_id FROM public.articles WHERE articles.id IN ($1,$2) AND articles.created_at > $3 AND articles.created_at < $4 AND articles.is_published = $5 AND articles.author_id IN ($6) ORDER BY articles.id ASC LIMIT 11"
+	searchQuery := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published, articles.author_id, (ts_headline('german', title || ' ' || subtitle || ' ' || body, websearch_to_tsquery('german', $1), 'MaxFragments=2, MaxWords=30, MinWords=10')) AS headline FROM public.articles WHERE to_tsvector('german', title || ' ' || subtitle || ' ' || body) @@ websearch_to_tsquery('german', $2) ORDER BY ts_rank_cd(setweight(to_tsvector('german', title), 'A') || setweight(to_tsvector('german', subtitle), 'B') || setweight(to_tsvector('german', body), 'C'), websearch_to_tsquery('german', $3)) DESC, articles.id ASC LIMIT 11 OFFSET 10"
+	searched := make([]entities.Article, len(articles))
+	for i, article := range articles {
+		article.Headline = pointer.Of("<b>katze</b> im haus")
+		searched[i] = article
+	}
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -296,12 +453,13 @@ func TestArticleRepository_List(t *testing.T) {
 		filter entities.ArticleFilter
 	}
 	tests := []struct {
-		name    string
-		setup   func()
-		fields  fields
-		args    args
-		want    []entities.Article
-		wantErr error
+		name       string
+		setup      func()
+		fields     fields
+		args       args
+		want       []entities.Article
+		wantCursor *string
+		wantErr    error
 	}{
 		{
 			name: "ok",
@@ -321,6 +479,115 @@ func TestArticleRepository_List(t *testing.T) {
 			want:    articles,
 			wantErr: nil,
 		},
+		{
+			name: "search by relevance",
+			setup: func() {
+				mock.ExpectQuery(searchQuery).
+					WithArgs(`"die katze" -hund`, `"die katze" -hund`, `"die katze" -hund`).
+					WillReturnRows(newArticleSearchRows(t, searched))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.ArticleFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					Search:     pointer.Of(`"die katze" -hund`),
+					Language:   pointer.Of("german"),
+					OrderBy: []entities.ArticleOrdering{
+						entities.ArticleOrderingRelevance,
+						entities.ArticleOrderingIdASC,
+					},
+				},
+			},
+			want:    searched,
+			wantErr: nil,
+		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(articles[0].ID, articles[1].ID, createdAfter, createdBefore, true, articles[0].AuthorId).
+					WillReturnRows(newArticleRows(t, articles))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    articles,
+			wantErr: nil,
+		},
+		{
+			name: "next page",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(newArticleRows(t, page))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:       page[:10],
+			wantCursor: pointer.Of(nextCursor),
+			wantErr:    nil,
+		},
+		{
+			name: "cursor",
+			setup: func() {
+				mock.ExpectQuery(cursorQuery).
+					WithArgs(articles[0].ID.String()).
+					WillReturnRows(newArticleRows(t, articles))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.ArticleFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					OrderBy:    filter.OrderBy,
+					Cursor:     pointer.Of(cursor),
+				},
+			},
+			want:    articles,
+			wantErr: nil,
+		},
+		{
+			name:  "invalid cursor",
+			setup: func() {},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.ArticleFilter{
+					PageSize: filter.PageSize,
+					OrderBy:  filter.OrderBy,
+					Cursor:   pointer.Of(cursor + "x"),
+				},
+			},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("cursor", "Invalid cursor."),
+		},
 		{
 			name: "unexpected behavior",
 			setup: func() {
@@ -367,11 +634,13 @@ func TestArticleRepository_List(t *testing.T) {
 			r := &ArticleRepository{
 				writeDB: tt.fields.writeDB,
 				readDB:  tt.fields.readDB,
+				cursors: cursors,
 				logger:  tt.fields.logger,
 			}
-			got, err := r.List(tt.args.ctx, tt.args.filter)
+			got, gotCursor, err := r.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCursor, gotCursor)
 		})
 	}
 }
@@ -386,12 +655,16 @@ func TestArticleRepository_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	article := entities.NewMockArticle(t)
 	query := `UPDATE public.articles SET created_at = $1, updated_at = $2, deleted_at = $3, title = $4, subtitle = $5, body = $6, is_published = $7 WHERE id = $8`
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -399,7 +672,6 @@ func TestArticleRepository_Update(t *testing.T) {
 	}
 	type args struct {
 		ctx     context.Context
-		tx      dtx.TX
 		article entities.Article
 	}
 	tests := []struct {
@@ -432,7 +704,6 @@ func TestArticleRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:     ctx,
-				tx:      mockTX,
 				article: article,
 			},
 			wantErr: nil,
@@ -460,7 +731,6 @@ func TestArticleRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:     ctx,
-				tx:      mockTX,
 				article: article,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("article_id", article.ID.String()),
@@ -488,7 +758,6 @@ func TestArticleRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:     ctx,
-				tx:      mockTX,
 				article: article,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -517,7 +786,6 @@ func TestArticleRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:     ctx,
-				tx:      mockTX,
 				article: article,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -546,7 +814,6 @@ func TestArticleRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:     ctx,
-				tx:      mockTX,
 				article: article,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -561,7 +828,7 @@ func TestArticleRepository_Update(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Update(tt.args.ctx, tt.args.tx, tt.args.article)
+			err := r.Update(tt.args.ctx, tt.args.article)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -577,9 +844,14 @@ func TestArticleRepository_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	article := entities.NewMockArticle(t)
 	type fields struct {
 		writeDB database
@@ -588,7 +860,6 @@ func TestArticleRepository_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		id  uuid.UUID
 	}
 	tests := []struct {
@@ -611,8 +882,7 @@ func TestArticleRepository_Delete(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  article.ID,
 			},
 			wantErr: nil,
@@ -630,8 +900,7 @@ func TestArticleRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  article.ID,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("article_id", article.ID.String()),
@@ -649,8 +918,7 @@ func TestArticleRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  article.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -669,8 +937,7 @@ func TestArticleRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  article.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -685,7 +952,7 @@ func TestArticleRepository_Delete(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Delete(tt.args.ctx, tt.args.tx, tt.args.id)
+			err := r.Delete(tt.args.ctx, tt.args.id)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -701,6 +968,17 @@ func TestArticleRepository_Count(t *testing.T) {
 	query := "SELECT count(id) FROM public.articles"
 	ctx := context.Background()
 	filter := entities.ArticleFilter{}
+	articles := []entities.Article{entities.NewMockArticle(t), entities.NewMockArticle(t)}
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.ArticleFilter{
+		IDs:           []uuid.UUID{articles[0].ID, articles[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		IsPublished:   pointer.Of(true),
+		AuthorIds:     []string{articles[0].AuthorId},
+	}
+	filterQuery := "SELECT count(id) FROM public.articles WHERE articles.id IN ($1,$2) AND articles.created_at > $3 AND articles.created_at < $4 AND articles.is_published = $5 AND articles.author_id IN ($6)"
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -736,6 +1014,25 @@ func TestArticleRepository_Count(t *testing.T) {
 			want:    1,
 			wantErr: nil,
 		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(articles[0].ID, articles[1].ID, createdAfter, createdBefore, true, articles[0].AuthorId).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).
+						AddRow(1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    1,
+			wantErr: nil,
+		},
 		{
 			name: "bad return type",
 			setup: func() {
@@ -807,6 +1104,7 @@ func newArticleRows(t *testing.T, articles []entities.Article) *sqlmock.Rows {
 		"updated_at",
 		"created_at",
 		"deleted_at",
+		"author_id",
 	})
 	for _, article := range articles {
 		rows.AddRow(
@@ -818,7 +1116,135 @@ func newArticleRows(t *testing.T, articles []entities.Article) *sqlmock.Rows {
 			article.UpdatedAt,
 			article.CreatedAt,
 			article.DeletedAt,
+			article.AuthorId,
 		)
 	}
 	return rows
 }
+
+func newArticleSearchRows(t *testing.T, articles []entities.Article) *sqlmock.Rows {
+	t.Helper()
+	rows := sqlmock.NewRows([]string{
+		"id",
+		"title",
+		"subtitle",
+		"body",
+		"is_published",
+		"updated_at",
+		"created_at",
+		"deleted_at",
+		"author_id",
+		"headline",
+	})
+	for _, article := range articles {
+		rows.AddRow(
+			article.ID,
+			article.Title,
+			article.Subtitle,
+			article.Body,
+			article.IsPublished,
+			article.UpdatedAt,
+			article.CreatedAt,
+			article.DeletedAt,
+			article.AuthorId,
+			article.Headline,
+		)
+	}
+	return rows
+}
+
+func TestArticleRepository_ListDeleted(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "SELECT articles.id, articles.created_at, articles.updated_at, articles.deleted_at, articles.title, articles.subtitle, articles.body, articles.is_published, articles.author_id FROM public.articles WHERE deleted_at < $1 ORDER BY deleted_at ASC, id ASC LIMIT 100 FOR UPDATE SKIP LOCKED"
+	deletedBefore := time.Now().UTC()
+	article := entities.NewMockArticle(t)
+	article.DeletedAt = pointer.Of(deletedBefore.Add(-time.Hour))
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx           context.Context
+		deletedBefore time.Time
+		limit         uint64
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnRows(newArticleRows(t, []entities.Article{article}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    []entities.Article{article},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &ArticleRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.ListDeleted(tt.args.ctx, tt.args.deletedBefore, tt.args.limit)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
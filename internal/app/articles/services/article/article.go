@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -32,7 +32,6 @@ func NewArticleService(
 
 func (s *ArticleService) Create(
 	ctx context.Context,
-	tx dtx.TX,
 	create entities.ArticleCreate,
 ) (entities.Article, error) {
 	if err := create.Validate(); err != nil {
@@ -47,8 +46,9 @@ func (s *ArticleService) Create(
 		Subtitle:    create.Subtitle,
 		Body:        create.Body,
 		IsPublished: create.IsPublished,
+		AuthorId:    create.AuthorId,
 	}
-	if err := s.articleRepository.Create(ctx, tx, article); err != nil {
+	if err := s.articleRepository.Create(ctx, article); err != nil {
 		return entities.Article{}, err
 	}
 	return article, nil
@@ -61,33 +61,46 @@ func (s *ArticleService) Get(ctx context.Context, id uuid.UUID) (entities.Articl
 	return article, nil
 }
 
+// GetForUpdate - the article within the transaction of the context, locked against
+// concurrent changes until the transaction ends.
+func (s *ArticleService) GetForUpdate(ctx context.Context, id uuid.UUID) (entities.Article, error) {
+	article, err := s.articleRepository.GetForUpdate(ctx, id)
+	if err != nil {
+		return entities.Article{}, err
+	}
+	return article, nil
+}
+
 func (s *ArticleService) List(
 	ctx context.Context,
 	filter entities.ArticleFilter,
-) ([]entities.Article, uint64, error) {
+) (entities.ArticleList, error) {
 	if err := filter.Validate(); err != nil {
-		return nil, 0, err
+		return entities.ArticleList{}, err
 	}
-	article, err := s.articleRepository.List(ctx, filter)
+	articles, nextCursor, err := s.articleRepository.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.ArticleList{}, err
 	}
-	count, err := s.articleRepository.Count(ctx, filter)
-	if err != nil {
-		return nil, 0, err
+	list := entities.ArticleList{Items: articles, Count: nil, NextCursor: nextCursor}
+	if filter.IncludeCount == nil || *filter.IncludeCount {
+		count, err := s.articleRepository.Count(ctx, filter)
+		if err != nil {
+			return entities.ArticleList{}, err
+		}
+		list.Count = pointer.Of(count)
 	}
-	return article, count, nil
+	return list, nil
 }
 
 func (s *ArticleService) Update(
 	ctx context.Context,
-	tx dtx.TX,
 	update entities.ArticleUpdate,
 ) (entities.Article, error) {
 	if err := update.Validate(); err != nil {
 		return entities.Article{}, err
 	}
-	article, err := s.articleRepository.Get(ctx, update.ID)
+	article, err := s.articleRepository.GetForUpdate(ctx, update.ID)
 	if err != nil {
 		return entities.Article{}, err
 	}
@@ -106,7 +119,7 @@ func (s *ArticleService) Update(
 		}
 	}
 	article.UpdatedAt = s.clock.Now().UTC()
-	if err := s.articleRepository.Update(ctx, tx, article); err != nil {
+	if err := s.articleRepository.Update(ctx, article); err != nil {
 		return entities.Article{}, err
 	}
 	return article, nil
@@ -114,15 +127,58 @@ func (s *ArticleService) Update(
 
 func (s *ArticleService) Delete(
 	ctx context.Context,
-	tx dtx.TX,
 	del entities.ArticleDelete,
 ) (entities.Article, error) {
-	article, err := s.articleRepository.Get(ctx, del.ID)
+	article, err := s.articleRepository.GetForUpdate(ctx, del.ID)
 	if err != nil {
 		return entities.Article{}, err
 	}
 	article.DeletedAt = pointer.Of(s.clock.Now().UTC())
-	if err := s.articleRepository.Update(ctx, tx, article); err != nil {
+	if err := s.articleRepository.Update(ctx, article); err != nil {
+		return entities.Article{}, err
+	}
+	return article, nil
+}
+
+// Purge - hard deletes a batch of the articles soft deleted before the time.
+func (s *ArticleService) Purge(
+	ctx context.Context,
+	purge entities.ArticlePurge,
+) ([]entities.Article, error) {
+	if err := purge.Validate(); err != nil {
+		return nil, err
+	}
+	articles, err := s.articleRepository.ListDeleted(ctx, purge.DeletedBefore, purge.Limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, article := range articles {
+		if err := s.articleRepository.Delete(ctx, article.ID); err != nil {
+			return nil, err
+		}
+	}
+	return articles, nil
+}
+
+// Restore - undoes the soft deletion of the article.
+func (s *ArticleService) Restore(
+	ctx context.Context,
+	restore entities.ArticleRestore,
+) (entities.Article, error) {
+	if err := restore.Validate(); err != nil {
+		return entities.Article{}, err
+	}
+	article, err := s.articleRepository.GetForUpdate(ctx, restore.ID)
+	if err != nil {
+		return entities.Article{}, err
+	}
+	if article.DeletedAt == nil {
+		return entities.Article{}, errs.NewError(errs.ErrorCodeFailedPrecondition, "Article is not deleted.").
+			WithParam("article_id", restore.ID.String())
+	}
+	article.DeletedAt = nil
+	article.UpdatedAt = s.clock.Now().UTC()
+	if err := s.articleRepository.Update(ctx, article); err != nil {
 		return entities.Article{}, err
 	}
 	return article, nil
@@ -7,7 +7,6 @@ import (
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
@@ -138,6 +137,78 @@ func TestArticleService_Get(t *testing.T) {
 	}
 }
 
+func TestArticleService_GetForUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockArticleRepository := NewMockarticleRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	article := entities.NewMockArticle(t)
+	type fields struct {
+		articleRepository articleRepository
+		logger            logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockArticleRepository.EXPECT().GetForUpdate(ctx, article.ID).Return(article, nil)
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  article.ID,
+			},
+			want:    article,
+			wantErr: nil,
+		},
+		{
+			name: "Article not found",
+			setup: func() {
+				mockArticleRepository.EXPECT().
+					GetForUpdate(ctx, article.ID).
+					Return(entities.Article{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  article.ID,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &ArticleService{
+				articleRepository: tt.fields.articleRepository,
+				logger:            tt.fields.logger,
+			}
+			got, err := u.GetForUpdate(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestArticleService_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -149,7 +220,10 @@ func TestArticleService_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		articles = append(articles, entities.NewMockArticle(t))
 	}
+	nextCursor := pointer.Of(faker.New().Lorem().Word())
 	filter := entities.NewMockArticleFilter(t)
+	withoutCount := filter
+	withoutCount.IncludeCount = pointer.Of(false)
 	type fields struct {
 		articleRepository articleRepository
 		logger            logger
@@ -163,14 +237,13 @@ func TestArticleService_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Article
-		want1   uint64
+		want    entities.ArticleList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
-				mockArticleRepository.EXPECT().List(ctx, filter).Return(articles, nil)
+				mockArticleRepository.EXPECT().List(ctx, filter).Return(articles, nextCursor, nil)
 				mockArticleRepository.EXPECT().Count(ctx, filter).Return(count, nil)
 			},
 			fields: fields{
@@ -181,8 +254,23 @@ func TestArticleService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    articles,
-			want1:   count,
+			want:    entities.ArticleList{Items: articles, Count: pointer.Of(count), NextCursor: nextCursor},
+			wantErr: nil,
+		},
+		{
+			name: "without count",
+			setup: func() {
+				mockArticleRepository.EXPECT().List(ctx, withoutCount).Return(articles, nil, nil)
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: withoutCount,
+			},
+			want:    entities.ArticleList{Items: articles, Count: nil, NextCursor: nil},
 			wantErr: nil,
 		},
 		{
@@ -190,7 +278,7 @@ func TestArticleService_List(t *testing.T) {
 			setup: func() {
 				mockArticleRepository.EXPECT().
 					List(ctx, filter).
-					Return([]entities.Article{}, errs.NewUnexpectedBehaviorError("test error"))
+					Return(nil, nil, errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
 				articleRepository: mockArticleRepository,
@@ -200,14 +288,13 @@ func TestArticleService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.ArticleList{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 		{
 			name: "count error",
 			setup: func() {
-				mockArticleRepository.EXPECT().List(ctx, filter).Return(articles, nil)
+				mockArticleRepository.EXPECT().List(ctx, filter).Return(articles, nextCursor, nil)
 				mockArticleRepository.EXPECT().
 					Count(ctx, filter).
 					Return(uint64(0), errs.NewUnexpectedBehaviorError("test error"))
@@ -220,8 +307,7 @@ func TestArticleService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.ArticleList{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 	}
@@ -232,10 +318,9 @@ func TestArticleService_List(t *testing.T) {
 				articleRepository: tt.fields.articleRepository,
 				logger:            tt.fields.logger,
 			}
-			got, got1, err := u.List(tt.args.ctx, tt.args.filter)
+			got, err := u.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
@@ -247,7 +332,6 @@ func TestArticleService_Create(t *testing.T) {
 	mockClock := NewMockclock(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockUUID := NewMockuuidGenerator(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	create := entities.NewMockArticleCreate(t)
 	now := time.Now().UTC()
@@ -259,7 +343,6 @@ func TestArticleService_Create(t *testing.T) {
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		create entities.ArticleCreate
 	}
 	tests := []struct {
@@ -280,13 +363,13 @@ func TestArticleService_Create(t *testing.T) {
 				mockArticleRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Article{
 							ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							Title:       create.Title,
 							Subtitle:    create.Subtitle,
 							Body:        create.Body,
 							IsPublished: create.IsPublished,
+							AuthorId:    create.AuthorId,
 							UpdatedAt:   now,
 							CreatedAt:   now,
 						},
@@ -301,7 +384,6 @@ func TestArticleService_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want: entities.Article{
@@ -310,6 +392,7 @@ func TestArticleService_Create(t *testing.T) {
 				Subtitle:    create.Subtitle,
 				Body:        create.Body,
 				IsPublished: create.IsPublished,
+				AuthorId:    create.AuthorId,
 				UpdatedAt:   now,
 				CreatedAt:   now,
 			},
@@ -325,13 +408,13 @@ func TestArticleService_Create(t *testing.T) {
 				mockArticleRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Article{
 							ID:          uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 							Title:       create.Title,
 							Subtitle:    create.Subtitle,
 							Body:        create.Body,
 							IsPublished: create.IsPublished,
+							AuthorId:    create.AuthorId,
 							UpdatedAt:   now,
 							CreatedAt:   now,
 						},
@@ -346,7 +429,6 @@ func TestArticleService_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want:    entities.Article{},
@@ -364,7 +446,6 @@ func TestArticleService_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: entities.ArticleCreate{},
 			},
 			want: entities.Article{},
@@ -384,7 +465,7 @@ func TestArticleService_Create(t *testing.T) {
 				logger:            tt.fields.logger,
 				uuid:              tt.fields.uuid,
 			}
-			got, err := u.Create(tt.args.ctx, tt.args.tx, tt.args.create)
+			got, err := u.Create(tt.args.ctx, tt.args.create)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
@@ -399,7 +480,6 @@ func TestArticleService_Update(t *testing.T) {
 	ctx := context.Background()
 	article := entities.NewMockArticle(t)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	update := entities.NewMockArticleUpdate(t)
 	now := time.Now().UTC()
 	updatedArticle := entities.Article{
@@ -412,6 +492,7 @@ func TestArticleService_Update(t *testing.T) {
 		Subtitle:    *update.Subtitle,
 		Body:        *update.Body,
 		IsPublished: *update.IsPublished,
+		AuthorId:    article.AuthorId,
 	}
 	type fields struct {
 		articleRepository articleRepository
@@ -420,7 +501,6 @@ func TestArticleService_Update(t *testing.T) {
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		update entities.ArticleUpdate
 	}
 	tests := []struct {
@@ -436,9 +516,9 @@ func TestArticleService_Update(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockArticleRepository.EXPECT().
-					Get(ctx, update.ID).Return(article, nil)
+					GetForUpdate(ctx, update.ID).Return(article, nil)
 				mockArticleRepository.EXPECT().
-					Update(ctx, mockTx, updatedArticle).Return(nil)
+					Update(ctx, updatedArticle).Return(nil)
 			},
 			fields: fields{
 				articleRepository: mockArticleRepository,
@@ -447,7 +527,6 @@ func TestArticleService_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    updatedArticle,
@@ -458,10 +537,10 @@ func TestArticleService_Update(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockArticleRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(article, nil)
 				mockArticleRepository.EXPECT().
-					Update(ctx, mockTx, updatedArticle).
+					Update(ctx, updatedArticle).
 					Return(errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
@@ -471,7 +550,6 @@ func TestArticleService_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Article{},
@@ -481,7 +559,7 @@ func TestArticleService_Update(t *testing.T) {
 			name: "Article not found",
 			setup: func() {
 				mockArticleRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(entities.Article{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
@@ -491,7 +569,6 @@ func TestArticleService_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Article{},
@@ -506,7 +583,7 @@ func TestArticleService_Update(t *testing.T) {
 				clock:             tt.fields.clock,
 				logger:            tt.fields.logger,
 			}
-			got, err := u.Update(tt.args.ctx, tt.args.tx, tt.args.update)
+			got, err := u.Update(tt.args.ctx, tt.args.update)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
@@ -519,7 +596,6 @@ func TestArticleService_Delete(t *testing.T) {
 	mockArticleRepository := NewMockarticleRepository(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	now := time.Now().UTC()
 	article := entities.NewMockArticle(t)
@@ -533,6 +609,7 @@ func TestArticleService_Delete(t *testing.T) {
 		Subtitle:    article.Subtitle,
 		Body:        article.Body,
 		IsPublished: article.IsPublished,
+		AuthorId:    article.AuthorId,
 	}
 	del := entities.NewMockArticleDelete(t)
 	del.ID = article.ID
@@ -543,7 +620,6 @@ func TestArticleService_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		del entities.ArticleDelete
 	}
 	tests := []struct {
@@ -559,10 +635,10 @@ func TestArticleService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockArticleRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(article, nil)
 				mockArticleRepository.EXPECT().
-					Update(ctx, mockTx, deletedArticle).
+					Update(ctx, deletedArticle).
 					Return(nil)
 			},
 			fields: fields{
@@ -572,7 +648,6 @@ func TestArticleService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    deletedArticle,
@@ -583,10 +658,10 @@ func TestArticleService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockArticleRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(article, nil)
 				mockArticleRepository.EXPECT().
-					Update(ctx, mockTx, deletedArticle).
+					Update(ctx, deletedArticle).
 					Return(errs.NewUnexpectedBehaviorError("test error 12"))
 			},
 			fields: fields{
@@ -596,7 +671,6 @@ func TestArticleService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Article{},
@@ -606,7 +680,7 @@ func TestArticleService_Delete(t *testing.T) {
 			name: "Article not found",
 			setup: func() {
 				mockArticleRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(entities.Article{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
@@ -616,7 +690,6 @@ func TestArticleService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Article{},
@@ -631,9 +704,265 @@ func TestArticleService_Delete(t *testing.T) {
 				logger:            tt.fields.logger,
 				clock:             tt.fields.clock,
 			}
-			got, err := u.Delete(tt.args.ctx, tt.args.tx, tt.args.del)
+			got, err := u.Delete(tt.args.ctx, tt.args.del)
 			assert.Equal(t, tt.want, got)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
 }
+
+func TestArticleService_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockArticleRepository := NewMockarticleRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	article := entities.NewMockArticle(t)
+	article.DeletedAt = pointer.Of(now.Add(-time.Hour))
+	restoredArticle := article
+	restoredArticle.DeletedAt = nil
+	restoredArticle.UpdatedAt = now
+	activeArticle := restoredArticle
+	restore := entities.NewMockArticleRestore(t)
+	restore.ID = article.ID
+	type fields struct {
+		articleRepository articleRepository
+		clock             clock
+		logger            logger
+	}
+	type args struct {
+		ctx     context.Context
+		restore entities.ArticleRestore
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockArticleRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(article, nil)
+				mockArticleRepository.EXPECT().
+					Update(ctx, restoredArticle).
+					Return(nil)
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    restoredArticle,
+			wantErr: nil,
+		},
+		{
+			name: "not deleted",
+			setup: func() {
+				mockArticleRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(activeArticle, nil)
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want: entities.Article{},
+			wantErr: errs.NewError(errs.ErrorCodeFailedPrecondition, "Article is not deleted.").
+				WithParam("article_id", restore.ID.String()),
+		},
+		{
+			name: "update error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockArticleRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(article, nil)
+				mockArticleRepository.EXPECT().
+					Update(ctx, restoredArticle).
+					Return(errs.NewUnexpectedBehaviorError("test error 13"))
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error 13"),
+		},
+		{
+			name: "Article not found",
+			setup: func() {
+				mockArticleRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(entities.Article{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &ArticleService{
+				articleRepository: tt.fields.articleRepository,
+				logger:            tt.fields.logger,
+				clock:             tt.fields.clock,
+			}
+			got, err := u.Restore(tt.args.ctx, tt.args.restore)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestArticleService_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockArticleRepository := NewMockarticleRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	purge := entities.NewMockArticlePurge(t)
+	article := entities.NewMockArticle(t)
+	article.DeletedAt = pointer.Of(purge.DeletedBefore.Add(-time.Hour))
+	type fields struct {
+		articleRepository articleRepository
+		clock             clock
+		logger            logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.ArticlePurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockArticleRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return([]entities.Article{article}, nil)
+				mockArticleRepository.EXPECT().Delete(ctx, article.ID).Return(nil)
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Article{article},
+			wantErr: nil,
+		},
+		{
+			name: "list error",
+			setup: func() {
+				mockArticleRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "delete error",
+			setup: func() {
+				mockArticleRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return([]entities.Article{article}, nil)
+				mockArticleRepository.EXPECT().
+					Delete(ctx, article.ID).
+					Return(errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name:  "invalid",
+			setup: func() {},
+			fields: fields{
+				articleRepository: mockArticleRepository,
+				logger:            mockLogger,
+				clock:             mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: entities.ArticlePurge{},
+			},
+			want: nil,
+			wantErr: errs.NewInvalidFormError().WithParams(
+				errs.Param{Key: "deleted_before", Value: "cannot be blank"},
+				errs.Param{Key: "limit", Value: "cannot be blank"},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &ArticleService{
+				articleRepository: tt.fields.articleRepository,
+				logger:            tt.fields.logger,
+				clock:             tt.fields.clock,
+			}
+			got, err := s.Purge(tt.args.ctx, tt.args.purge)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
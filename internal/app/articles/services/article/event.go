@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 )
 
 type ArticleEventService struct {
@@ -18,8 +18,12 @@ func NewArticleEventService(
 ) *ArticleEventService {
 	return &ArticleEventService{articleEventProducer: articleEventProducer, logger: logger}
 }
-func (s *ArticleEventService) Send(ctx context.Context, _ dtx.TX, article entities.Article) error {
-	if err := s.articleEventProducer.Send(ctx, article); err != nil {
+func (s *ArticleEventService) Send(
+	ctx context.Context,
+	eventType events.Type,
+	article entities.Article,
+) error {
+	if err := s.articleEventProducer.Send(ctx, eventType, article); err != nil {
 		return err
 	}
 	return nil
@@ -15,7 +15,7 @@ import (
 	time "time"
 
 	article "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -63,31 +63,31 @@ func (mr *MockarticleRepositoryMockRecorder) Count(arg0, arg1 any) *gomock.Call
 }
 
 // Create mocks base method.
-func (m *MockarticleRepository) Create(arg0 context.Context, arg1 dtx.TX, arg2 article.Article) error {
+func (m *MockarticleRepository) Create(arg0 context.Context, arg1 article.Article) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockarticleRepositoryMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockarticleRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockarticleRepository)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockarticleRepository)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MockarticleRepository) Delete(arg0 context.Context, arg1 dtx.TX, arg2 uuid.UUID) error {
+func (m *MockarticleRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockarticleRepositoryMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockarticleRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockarticleRepository)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockarticleRepository)(nil).Delete), arg0, arg1)
 }
 
 // Get mocks base method.
@@ -105,13 +105,29 @@ func (mr *MockarticleRepositoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockarticleRepository)(nil).Get), arg0, arg1)
 }
 
+// GetForUpdate mocks base method.
+func (m *MockarticleRepository) GetForUpdate(arg0 context.Context, arg1 uuid.UUID) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockarticleRepositoryMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockarticleRepository)(nil).GetForUpdate), arg0, arg1)
+}
+
 // List mocks base method.
-func (m *MockarticleRepository) List(arg0 context.Context, arg1 article.ArticleFilter) ([]article.Article, error) {
+func (m *MockarticleRepository) List(arg0 context.Context, arg1 article.ArticleFilter) ([]article.Article, *string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
 	ret0, _ := ret[0].([]article.Article)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
@@ -120,18 +136,33 @@ func (mr *MockarticleRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockarticleRepository)(nil).List), arg0, arg1)
 }
 
+// ListDeleted mocks base method.
+func (m *MockarticleRepository) ListDeleted(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockarticleRepositoryMockRecorder) ListDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockarticleRepository)(nil).ListDeleted), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
-func (m *MockarticleRepository) Update(arg0 context.Context, arg1 dtx.TX, arg2 article.Article) error {
+func (m *MockarticleRepository) Update(arg0 context.Context, arg1 article.Article) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockarticleRepositoryMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockarticleRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockarticleRepository)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockarticleRepository)(nil).Update), arg0, arg1)
 }
 
 // MockarticleEventProducer is a mock of articleEventProducer interface.
@@ -159,17 +190,17 @@ func (m *MockarticleEventProducer) EXPECT() *MockarticleEventProducerMockRecorde
 }
 
 // Send mocks base method.
-func (m *MockarticleEventProducer) Send(arg0 context.Context, arg1 article.Article) error {
+func (m *MockarticleEventProducer) Send(arg0 context.Context, arg1 events.Type, arg2 article.Article) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0, arg1)
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockarticleEventProducerMockRecorder) Send(arg0, arg1 any) *gomock.Call {
+func (mr *MockarticleEventProducerMockRecorder) Send(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockarticleEventProducer)(nil).Send), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockarticleEventProducer)(nil).Send), arg0, arg1, arg2)
 }
 
 // Mockclock is a mock of clock interface.
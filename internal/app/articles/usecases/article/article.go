@@ -4,8 +4,8 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
@@ -13,6 +13,7 @@ type ArticleUseCase struct {
 	articleService      articleService
 	articleEventService articleEventService
 	dtxManager          dtxManager
+	authorizer          authorizer
 	logger              logger
 }
 
@@ -20,12 +21,14 @@ func NewArticleUseCase(
 	articleService articleService,
 	articleEventService articleEventService,
 	dtxManager dtxManager,
+	authorizer authorizer,
 	logger logger,
 ) *ArticleUseCase {
 	return &ArticleUseCase{
 		articleService:      articleService,
 		articleEventService: articleEventService,
 		dtxManager:          dtxManager,
+		authorizer:          authorizer,
 		logger:              logger,
 	}
 }
@@ -34,26 +37,30 @@ func (u *ArticleUseCase) Create(
 	ctx context.Context,
 	create entities.ArticleCreate,
 ) (entities.Article, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	article, err := u.articleService.Create(ctx, tx, create)
-	if err != nil {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionArticleCreate); err != nil {
 		return entities.Article{}, err
 	}
-	if err := u.articleEventService.Send(ctx, tx, article); err != nil {
-		return entities.Article{}, err
+	if subject, ok := auth.SubjectFromContext(ctx); ok {
+		create.AuthorId = subject
 	}
-	if err := tx.Commit(); err != nil {
+	var article entities.Article
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		article, err = u.articleService.Create(ctx, create)
+		if err != nil {
+			return err
+		}
+		return u.articleEventService.Send(ctx, events.TypeCreated, article)
+	})
+	if err != nil {
 		return entities.Article{}, err
 	}
 	return article, nil
 }
 func (u *ArticleUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Article, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionArticleDetail); err != nil {
+		return entities.Article{}, err
+	}
 	article, err := u.articleService.Get(ctx, id)
 	if err != nil {
 		return entities.Article{}, err
@@ -64,33 +71,40 @@ func (u *ArticleUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Articl
 func (u *ArticleUseCase) List(
 	ctx context.Context,
 	filter entities.ArticleFilter,
-) ([]entities.Article, uint64, error) {
-	articles, count, err := u.articleService.List(ctx, filter)
+) (entities.ArticleList, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionArticleList); err != nil {
+		return entities.ArticleList{}, err
+	}
+	list, err := u.articleService.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.ArticleList{}, err
 	}
-	return articles, count, nil
+	return list, nil
 }
 
 func (u *ArticleUseCase) Update(
 	ctx context.Context,
 	update entities.ArticleUpdate,
 ) (entities.Article, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	article, err := u.articleService.Update(ctx, tx, update)
-	if err != nil {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionArticleUpdate); err != nil {
 		return entities.Article{}, err
 	}
-	if err := u.articleEventService.Send(ctx, tx, article); err != nil {
-		return entities.Article{}, err
-	}
-	if err := tx.Commit(); err != nil {
+	var article entities.Article
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		current, err := u.articleService.GetForUpdate(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeAuthor(ctx, current); err != nil {
+			return err
+		}
+		article, err = u.articleService.Update(ctx, update)
+		if err != nil {
+			return err
+		}
+		return u.articleEventService.Send(ctx, events.TypeUpdated, article)
+	})
+	if err != nil {
 		return entities.Article{}, err
 	}
 	return article, nil
@@ -100,22 +114,90 @@ func (u *ArticleUseCase) Delete(
 	ctx context.Context,
 	del entities.ArticleDelete,
 ) (entities.Article, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
+	if err := u.authorizer.Authorize(ctx, entities.PermissionArticleDelete); err != nil {
+		return entities.Article{}, err
+	}
+	var article entities.Article
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		current, err := u.articleService.GetForUpdate(ctx, del.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeAuthor(ctx, current); err != nil {
+			return err
+		}
+		article, err = u.articleService.Delete(ctx, del)
+		if err != nil {
+			return err
 		}
-	}(tx)
-	article, err := u.articleService.Delete(ctx, tx, del)
+		return u.articleEventService.Send(ctx, events.TypeDeleted, article)
+	})
 	if err != nil {
 		return entities.Article{}, err
 	}
-	if err := u.articleEventService.Send(ctx, tx, article); err != nil {
+	return article, nil
+}
+
+// Restore - undoes the soft deletion of the article.
+func (u *ArticleUseCase) Restore(
+	ctx context.Context,
+	restore entities.ArticleRestore,
+) (entities.Article, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionArticleDelete); err != nil {
 		return entities.Article{}, err
 	}
-	if err := tx.Commit(); err != nil {
+	var article entities.Article
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		deleted, err := u.articleService.GetForUpdate(ctx, restore.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeAuthor(ctx, deleted); err != nil {
+			return err
+		}
+		article, err = u.articleService.Restore(ctx, restore)
+		if err != nil {
+			return err
+		}
+		return u.articleEventService.Send(ctx, events.TypeRestored, article)
+	})
+	if err != nil {
 		return entities.Article{}, err
 	}
 	return article, nil
 }
+
+// authorizeAuthor - the caller is the author of the article or holds the moderate
+// permission. The article is locked by GetForUpdate, so the article can not
+// change between the check and the write.
+func (u *ArticleUseCase) authorizeAuthor(ctx context.Context, article entities.Article) error {
+	if subject, ok := auth.SubjectFromContext(ctx); ok && subject == article.AuthorId {
+		return nil
+	}
+	return u.authorizer.Authorize(ctx, entities.PermissionArticleModerate)
+}
+
+// Purge - hard deletes a batch of the articles soft deleted before the time.
+func (u *ArticleUseCase) Purge(
+	ctx context.Context,
+	purge entities.ArticlePurge,
+) ([]entities.Article, error) {
+	var articles []entities.Article
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		articles, err = u.articleService.Purge(ctx, purge)
+		if err != nil {
+			return err
+		}
+		for _, article := range articles {
+			if err := u.articleEventService.Send(ctx, events.TypePurged, article); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
@@ -7,10 +7,13 @@ import (
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
@@ -20,11 +23,13 @@ func TestNewArticleUseCase(t *testing.T) {
 	mockArticleService := NewMockarticleService(ctrl)
 	mockArticleEventService := NewMockarticleEventService(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	type args struct {
 		articleService      articleService
 		articleEventService articleEventService
 		dtxManager          dtxManager
+		authorizer          authorizer
 		logger              logger
 	}
 	tests := []struct {
@@ -40,12 +45,14 @@ func TestNewArticleUseCase(t *testing.T) {
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			want: &ArticleUseCase{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 		},
@@ -57,6 +64,7 @@ func TestNewArticleUseCase(t *testing.T) {
 				tt.args.articleService,
 				tt.args.articleEventService,
 				tt.args.dtxManager,
+				tt.args.authorizer,
 				tt.args.logger,
 			)
 			assert.Equal(t, tt.want, got)
@@ -71,12 +79,14 @@ func TestArticleUseCase_Get(t *testing.T) {
 	mockArticleEventService := NewMockarticleEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	article := entities.NewMockArticle(t)
 	type fields struct {
 		articleService      articleService
 		articleEventService articleEventService
 		dtxManager          dtxManager
+		authorizer          authorizer
 		logger              logger
 	}
 	type args struct {
@@ -94,6 +104,7 @@ func TestArticleUseCase_Get(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleDetail).Return(nil)
 				mockArticleService.EXPECT().
 					Get(ctx, article.ID).
 					Return(article, nil)
@@ -102,6 +113,7 @@ func TestArticleUseCase_Get(t *testing.T) {
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -114,6 +126,7 @@ func TestArticleUseCase_Get(t *testing.T) {
 		{
 			name: "Article not found",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleDetail).Return(nil)
 				mockArticleService.EXPECT().
 					Get(ctx, article.ID).
 					Return(entities.Article{}, errs.NewEntityNotFoundError())
@@ -122,6 +135,7 @@ func TestArticleUseCase_Get(t *testing.T) {
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -131,6 +145,27 @@ func TestArticleUseCase_Get(t *testing.T) {
 			want:    entities.Article{},
 			wantErr: errs.NewEntityNotFoundError(),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionArticleDetail).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  uuid.UUID(article.ID),
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -139,6 +174,7 @@ func TestArticleUseCase_Get(t *testing.T) {
 				articleService:      tt.fields.articleService,
 				articleEventService: tt.fields.articleEventService,
 				dtxManager:          tt.fields.dtxManager,
+				authorizer:          tt.fields.authorizer,
 				logger:              tt.fields.logger,
 			}
 			got, err := i.Get(tt.args.ctx, tt.args.id)
@@ -156,14 +192,21 @@ func TestArticleUseCase_Create(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	article := entities.NewMockArticle(t)
 	create := entities.NewMockArticleCreate(t)
+	authorCtx := auth.WithSubject(ctx, article.AuthorId)
+	authorTxCtx := dtx.WithTX(authorCtx, mockTx)
+	authored := create
+	authored.AuthorId = article.AuthorId
 	type fields struct {
 		articleService      articleService
 		articleEventService articleEventService
 		dtxManager          dtxManager
+		authorizer          authorizer
 		logger              logger
 	}
 	type args struct {
@@ -181,15 +224,18 @@ func TestArticleUseCase_Create(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockArticleService.EXPECT().Create(ctx, mockTx, create).Return(article, nil)
-				mockArticleEventService.EXPECT().Send(ctx, mockTx, article).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().Create(txCtx, create).Return(article, nil)
+				mockArticleEventService.EXPECT().Send(txCtx, events.TypeCreated, article).Return(nil)
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -199,19 +245,52 @@ func TestArticleUseCase_Create(t *testing.T) {
 			want:    article,
 			wantErr: nil,
 		},
+		{
+			name: "author from subject",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(authorCtx, entities.PermissionArticleCreate).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(authorCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().
+					Create(authorTxCtx, authored).
+					Return(article, nil)
+				mockArticleEventService.EXPECT().
+					Send(authorTxCtx, events.TypeCreated, article).
+					Return(nil)
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:    authorCtx,
+				create: create,
+			},
+			want:    article,
+			wantErr: nil,
+		},
 		{
 			name: "create error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockArticleService.EXPECT().
-					Create(ctx, mockTx, create).
+					Create(txCtx, create).
 					Return(entities.Article{}, errs.NewUnexpectedBehaviorError("c u"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -221,6 +300,27 @@ func TestArticleUseCase_Create(t *testing.T) {
 			want:    entities.Article{},
 			wantErr: errs.NewUnexpectedBehaviorError("c u"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionArticleCreate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -229,6 +329,7 @@ func TestArticleUseCase_Create(t *testing.T) {
 				articleService:      tt.fields.articleService,
 				articleEventService: tt.fields.articleEventService,
 				dtxManager:          tt.fields.dtxManager,
+				authorizer:          tt.fields.authorizer,
 				logger:              tt.fields.logger,
 			}
 			got, err := i.Create(tt.args.ctx, tt.args.create)
@@ -246,14 +347,19 @@ func TestArticleUseCase_Update(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
-	ctx := context.Background()
 	article := entities.NewMockArticle(t)
+	ctx := auth.WithSubject(context.Background(), article.AuthorId)
+	txCtx := dtx.WithTX(ctx, mockTx)
+	otherCtx := auth.WithSubject(context.Background(), uuid.NewUUID().String())
+	otherTxCtx := dtx.WithTX(otherCtx, mockTx)
 	update := entities.NewMockArticleUpdate(t)
 	type fields struct {
 		articleService      articleService
 		articleEventService articleEventService
 		dtxManager          dtxManager
+		authorizer          authorizer
 		logger              logger
 	}
 	type args struct {
@@ -271,15 +377,19 @@ func TestArticleUseCase_Update(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockArticleService.EXPECT().Update(ctx, mockTx, update).Return(article, nil)
-				mockArticleEventService.EXPECT().Send(ctx, mockTx, article).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(txCtx, update.ID).Return(article, nil)
+				mockArticleService.EXPECT().Update(txCtx, update).Return(article, nil)
+				mockArticleEventService.EXPECT().Send(txCtx, events.TypeUpdated, article).Return(nil)
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -289,19 +399,83 @@ func TestArticleUseCase_Update(t *testing.T) {
 			want:    article,
 			wantErr: nil,
 		},
+		{
+			name: "moderator",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionArticleUpdate).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(otherTxCtx, update.ID).Return(article, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionArticleModerate).
+					Return(nil)
+				mockArticleService.EXPECT().Update(otherTxCtx, update).Return(article, nil)
+				mockArticleEventService.EXPECT().
+					Send(otherTxCtx, events.TypeUpdated, article).
+					Return(nil)
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:    otherCtx,
+				update: update,
+			},
+			want:    article,
+			wantErr: nil,
+		},
+		{
+			name: "not the author",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionArticleUpdate).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(otherTxCtx, update.ID).Return(article, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionArticleModerate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:    otherCtx,
+				update: update,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 		{
 			name: "update error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(txCtx, update.ID).Return(article, nil)
 				mockArticleService.EXPECT().
-					Update(ctx, mockTx, update).
+					Update(txCtx, update).
 					Return(entities.Article{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -311,6 +485,27 @@ func TestArticleUseCase_Update(t *testing.T) {
 			want:    entities.Article{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionArticleUpdate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				update: update,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -319,6 +514,7 @@ func TestArticleUseCase_Update(t *testing.T) {
 				articleService:      tt.fields.articleService,
 				articleEventService: tt.fields.articleEventService,
 				dtxManager:          tt.fields.dtxManager,
+				authorizer:          tt.fields.authorizer,
 				logger:              tt.fields.logger,
 			}
 			got, err := i.Update(tt.args.ctx, tt.args.update)
@@ -336,15 +532,20 @@ func TestArticleUseCase_Delete(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
-	ctx := context.Background()
 	article := entities.NewMockArticle(t)
+	ctx := auth.WithSubject(context.Background(), article.AuthorId)
+	txCtx := dtx.WithTX(ctx, mockTx)
+	otherCtx := auth.WithSubject(context.Background(), uuid.NewUUID().String())
+	otherTxCtx := dtx.WithTX(otherCtx, mockTx)
 	del := entities.NewMockArticleDelete(t)
 	del.ID = article.ID
 	type fields struct {
 		articleService      articleService
 		articleEventService articleEventService
 		dtxManager          dtxManager
+		authorizer          authorizer
 		logger              logger
 	}
 	type args struct {
@@ -362,17 +563,21 @@ func TestArticleUseCase_Delete(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(txCtx, del.ID).Return(article, nil)
 				mockArticleService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(article, nil)
-				mockArticleEventService.EXPECT().Send(ctx, mockTx, article).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockArticleEventService.EXPECT().Send(txCtx, events.TypeDeleted, article).Return(nil)
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -382,19 +587,85 @@ func TestArticleUseCase_Delete(t *testing.T) {
 			want:    article,
 			wantErr: nil,
 		},
+		{
+			name: "moderator",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionArticleDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(otherTxCtx, del.ID).Return(article, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionArticleModerate).
+					Return(nil)
+				mockArticleService.EXPECT().
+					Delete(otherTxCtx, del).
+					Return(article, nil)
+				mockArticleEventService.EXPECT().
+					Send(otherTxCtx, events.TypeDeleted, article).
+					Return(nil)
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx: otherCtx,
+				del: del,
+			},
+			want:    article,
+			wantErr: nil,
+		},
+		{
+			name: "not the author",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionArticleDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(otherTxCtx, del.ID).Return(article, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionArticleModerate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx: otherCtx,
+				del: del,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 		{
 			name: "delete error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(txCtx, del.ID).Return(article, nil)
 				mockArticleService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(entities.Article{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
@@ -404,6 +675,27 @@ func TestArticleUseCase_Delete(t *testing.T) {
 			want:    entities.Article{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionArticleDelete).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -412,6 +704,7 @@ func TestArticleUseCase_Delete(t *testing.T) {
 				articleService:      tt.fields.articleService,
 				articleEventService: tt.fields.articleEventService,
 				dtxManager:          tt.fields.dtxManager,
+				authorizer:          tt.fields.authorizer,
 				logger:              tt.fields.logger,
 			}
 			got, err := i.Delete(tt.args.ctx, tt.args.del)
@@ -421,6 +714,196 @@ func TestArticleUseCase_Delete(t *testing.T) {
 	}
 }
 
+func TestArticleUseCase_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockArticleService := NewMockarticleService(ctrl)
+	mockArticleEventService := NewMockarticleEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	article := entities.NewMockArticle(t)
+	ctx := auth.WithSubject(context.Background(), article.AuthorId)
+	txCtx := dtx.WithTX(ctx, mockTx)
+	otherCtx := auth.WithSubject(context.Background(), uuid.NewUUID().String())
+	otherTxCtx := dtx.WithTX(otherCtx, mockTx)
+	restore := entities.NewMockArticleRestore(t)
+	restore.ID = article.ID
+	type fields struct {
+		articleService      articleService
+		articleEventService articleEventService
+		dtxManager          dtxManager
+		authorizer          authorizer
+		logger              logger
+	}
+	type args struct {
+		ctx     context.Context
+		restore entities.ArticleRestore
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(txCtx, restore.ID).Return(article, nil)
+				mockArticleService.EXPECT().
+					Restore(txCtx, restore).
+					Return(article, nil)
+				mockArticleEventService.EXPECT().Send(txCtx, events.TypeRestored, article).Return(nil)
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    article,
+			wantErr: nil,
+		},
+		{
+			name: "moderator",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionArticleDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(otherTxCtx, restore.ID).Return(article, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionArticleModerate).
+					Return(nil)
+				mockArticleService.EXPECT().
+					Restore(otherTxCtx, restore).
+					Return(article, nil)
+				mockArticleEventService.EXPECT().
+					Send(otherTxCtx, events.TypeRestored, article).
+					Return(nil)
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:     otherCtx,
+				restore: restore,
+			},
+			want:    article,
+			wantErr: nil,
+		},
+		{
+			name: "not the author",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionArticleDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(otherTxCtx, restore.ID).Return(article, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionArticleModerate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:     otherCtx,
+				restore: restore,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+		{
+			name: "restore error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().GetForUpdate(txCtx, restore.ID).Return(article, nil)
+				mockArticleService.EXPECT().
+					Restore(txCtx, restore).
+					Return(entities.Article{}, errs.NewUnexpectedBehaviorError("r 2"))
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewUnexpectedBehaviorError("r 2"),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionArticleDelete).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Article{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := &ArticleUseCase{
+				articleService:      tt.fields.articleService,
+				articleEventService: tt.fields.articleEventService,
+				dtxManager:          tt.fields.dtxManager,
+				authorizer:          tt.fields.authorizer,
+				logger:              tt.fields.logger,
+			}
+			got, err := i.Restore(tt.args.ctx, tt.args.restore)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestArticleUseCase_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -428,6 +911,7 @@ func TestArticleUseCase_List(t *testing.T) {
 	mockArticleEventService := NewMockarticleEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	filter := entities.NewMockArticleFilter(t)
 	count := faker.New().UInt64Between(2, 20)
@@ -435,10 +919,12 @@ func TestArticleUseCase_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		articles = append(articles, entities.NewMockArticle(t))
 	}
+	list := entities.ArticleList{Items: articles, Count: pointer.Of(count), NextCursor: nil}
 	type fields struct {
 		articleService      articleService
 		articleEventService articleEventService
 		dtxManager          dtxManager
+		authorizer          authorizer
 		logger              logger
 	}
 	type args struct {
@@ -450,52 +936,201 @@ func TestArticleUseCase_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Article
-		want1   uint64
+		want    entities.ArticleList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleList).Return(nil)
 				mockArticleService.EXPECT().
 					List(ctx, filter).
-					Return(articles, count, nil)
+					Return(list, nil)
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    articles,
-			want1:   count,
+			want:    list,
 			wantErr: nil,
 		},
 		{
 			name: "list error",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionArticleList).Return(nil)
 				mockArticleService.EXPECT().
 					List(ctx, filter).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("l e"))
+					Return(entities.ArticleList{}, errs.NewUnexpectedBehaviorError("l e"))
 			},
 			fields: fields{
 				articleService:      mockArticleService,
 				articleEventService: mockArticleEventService,
 				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
 				logger:              mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.ArticleList{},
 			wantErr: errs.NewUnexpectedBehaviorError("l e"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionArticleList).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.ArticleList{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := &ArticleUseCase{
+				articleService:      tt.fields.articleService,
+				articleEventService: tt.fields.articleEventService,
+				dtxManager:          tt.fields.dtxManager,
+				authorizer:          tt.fields.authorizer,
+				logger:              tt.fields.logger,
+			}
+			got, err := i.List(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestArticleUseCase_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockArticleService := NewMockarticleService(ctrl)
+	mockArticleEventService := NewMockarticleEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
+	article := entities.NewMockArticle(t)
+	purge := entities.NewMockArticlePurge(t)
+	type fields struct {
+		articleService      articleService
+		articleEventService articleEventService
+		dtxManager          dtxManager
+		authorizer          authorizer
+		logger              logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.ArticlePurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Article
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().
+					Purge(txCtx, purge).
+					Return([]entities.Article{article}, nil)
+				mockArticleEventService.EXPECT().Send(txCtx, events.TypePurged, article).Return(nil)
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Article{article},
+			wantErr: nil,
+		},
+		{
+			name: "purge error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().
+					Purge(txCtx, purge).
+					Return(nil, errs.NewUnexpectedBehaviorError("p 1"))
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 1"),
+		},
+		{
+			name: "event error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockArticleService.EXPECT().
+					Purge(txCtx, purge).
+					Return([]entities.Article{article}, nil)
+				mockArticleEventService.EXPECT().
+					Send(txCtx, events.TypePurged, article).
+					Return(errs.NewUnexpectedBehaviorError("p 2"))
+			},
+			fields: fields{
+				articleService:      mockArticleService,
+				articleEventService: mockArticleEventService,
+				dtxManager:          mockDtxManager,
+				authorizer:          mockAuthorizer,
+				logger:              mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 2"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -504,12 +1139,12 @@ func TestArticleUseCase_List(t *testing.T) {
 				articleService:      tt.fields.articleService,
 				articleEventService: tt.fields.articleEventService,
 				dtxManager:          tt.fields.dtxManager,
+				authorizer:          tt.fields.authorizer,
 				logger:              tt.fields.logger,
 			}
-			got, got1, err := i.List(tt.args.ctx, tt.args.filter)
+			got, err := i.Purge(tt.args.ctx, tt.args.purge)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
@@ -3,26 +3,34 @@ package usecases
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type articleService interface {
-	Create(context.Context, dtx.TX, entities.ArticleCreate) (entities.Article, error)
+	Create(context.Context, entities.ArticleCreate) (entities.Article, error)
 	Get(context.Context, uuid.UUID) (entities.Article, error)
-	List(context.Context, entities.ArticleFilter) ([]entities.Article, uint64, error)
-	Update(context.Context, dtx.TX, entities.ArticleUpdate) (entities.Article, error)
-	Delete(context.Context, dtx.TX, entities.ArticleDelete) (entities.Article, error)
+	GetForUpdate(context.Context, uuid.UUID) (entities.Article, error)
+	List(context.Context, entities.ArticleFilter) (entities.ArticleList, error)
+	Update(context.Context, entities.ArticleUpdate) (entities.Article, error)
+	Delete(context.Context, entities.ArticleDelete) (entities.Article, error)
+	Restore(context.Context, entities.ArticleRestore) (entities.Article, error)
+	Purge(context.Context, entities.ArticlePurge) ([]entities.Article, error)
 }
 type articleEventService interface {
-	Send(context.Context, dtx.TX, entities.Article) error
+	Send(context.Context, events.Type, entities.Article) error
 }
 type logger interface {
 	log.Logger
 }
 type dtxManager interface {
-	NewTx() dtx.TX
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type authorizer interface {
+	Authorize(context.Context, authz.Permission) error
 }
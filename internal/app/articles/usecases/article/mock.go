@@ -11,10 +11,12 @@ package usecases
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
 
 	article "github.com/mikalai-mitsin/example/internal/app/articles/entities/article"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	authz "github.com/mikalai-mitsin/example/internal/pkg/authz"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -47,33 +49,33 @@ func (m *MockarticleService) EXPECT() *MockarticleServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockarticleService) Create(arg0 context.Context, arg1 dtx.TX, arg2 article.ArticleCreate) (article.Article, error) {
+func (m *MockarticleService) Create(arg0 context.Context, arg1 article.ArticleCreate) (article.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(article.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockarticleServiceMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockarticleServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockarticleService)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockarticleService)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MockarticleService) Delete(arg0 context.Context, arg1 dtx.TX, arg2 article.ArticleDelete) (article.Article, error) {
+func (m *MockarticleService) Delete(arg0 context.Context, arg1 article.ArticleDelete) (article.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(article.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockarticleServiceMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockarticleServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockarticleService)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockarticleService)(nil).Delete), arg0, arg1)
 }
 
 // Get mocks base method.
@@ -91,14 +93,28 @@ func (mr *MockarticleServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockarticleService)(nil).Get), arg0, arg1)
 }
 
+// GetForUpdate mocks base method.
+func (m *MockarticleService) GetForUpdate(arg0 context.Context, arg1 uuid.UUID) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockarticleServiceMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockarticleService)(nil).GetForUpdate), arg0, arg1)
+}
+
 // List mocks base method.
-func (m *MockarticleService) List(arg0 context.Context, arg1 article.ArticleFilter) ([]article.Article, uint64, error) {
+func (m *MockarticleService) List(arg0 context.Context, arg1 article.ArticleFilter) (article.ArticleList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]article.Article)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(article.ArticleList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -107,19 +123,49 @@ func (mr *MockarticleServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockarticleService)(nil).List), arg0, arg1)
 }
 
+// Purge mocks base method.
+func (m *MockarticleService) Purge(arg0 context.Context, arg1 article.ArticlePurge) ([]article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].([]article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockarticleServiceMockRecorder) Purge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockarticleService)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockarticleService) Restore(arg0 context.Context, arg1 article.ArticleRestore) (article.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(article.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockarticleServiceMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockarticleService)(nil).Restore), arg0, arg1)
+}
+
 // Update mocks base method.
-func (m *MockarticleService) Update(arg0 context.Context, arg1 dtx.TX, arg2 article.ArticleUpdate) (article.Article, error) {
+func (m *MockarticleService) Update(arg0 context.Context, arg1 article.ArticleUpdate) (article.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(article.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockarticleServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockarticleServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockarticleService)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockarticleService)(nil).Update), arg0, arg1)
 }
 
 // MockarticleEventService is a mock of articleEventService interface.
@@ -147,7 +193,7 @@ func (m *MockarticleEventService) EXPECT() *MockarticleEventServiceMockRecorder
 }
 
 // Send mocks base method.
-func (m *MockarticleEventService) Send(arg0 context.Context, arg1 dtx.TX, arg2 article.Article) error {
+func (m *MockarticleEventService) Send(arg0 context.Context, arg1 events.Type, arg2 article.Article) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -430,16 +476,54 @@ func (m *MockdtxManager) EXPECT() *MockdtxManagerMockRecorder {
 	return m.recorder
 }
 
-// NewTx mocks base method.
-func (m *MockdtxManager) NewTx() dtx.TX {
+// RunInTx mocks base method.
+func (m *MockdtxManager) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, opts, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockdtxManagerMockRecorder) RunInTx(ctx, opts, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockdtxManager)(nil).RunInTx), ctx, opts, fn)
+}
+
+// Mockauthorizer is a mock of authorizer interface.
+type Mockauthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorizerMockRecorder is the mock recorder for Mockauthorizer.
+type MockauthorizerMockRecorder struct {
+	mock *Mockauthorizer
+}
+
+// NewMockauthorizer creates a new mock instance.
+func NewMockauthorizer(ctrl *gomock.Controller) *Mockauthorizer {
+	mock := &Mockauthorizer{ctrl: ctrl}
+	mock.recorder = &MockauthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockauthorizer) EXPECT() *MockauthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *Mockauthorizer) Authorize(arg0 context.Context, arg1 authz.Permission) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewTx")
-	ret0, _ := ret[0].(dtx.TX)
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// NewTx indicates an expected call of NewTx.
-func (mr *MockdtxManagerMockRecorder) NewTx() *gomock.Call {
+// Authorize indicates an expected call of Authorize.
+func (mr *MockauthorizerMockRecorder) Authorize(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTx", reflect.TypeOf((*MockdtxManager)(nil).NewTx))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*Mockauthorizer)(nil).Authorize), arg0, arg1)
 }
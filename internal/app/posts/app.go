@@ -1,7 +1,12 @@
 package posts
 
 import (
-	"github.com/jmoiron/sqlx"
+	"context"
+	"time"
+
+	likeEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
+	postEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
+	tagEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
 	likeGrpcHandlers "github.com/mikalai-mitsin/example/internal/app/posts/handlers/grpc/like"
 	postGrpcHandlers "github.com/mikalai-mitsin/example/internal/app/posts/handlers/grpc/post"
 	tagGrpcHandlers "github.com/mikalai-mitsin/example/internal/app/posts/handlers/grpc/tag"
@@ -23,21 +28,26 @@ import (
 	likeUseCases "github.com/mikalai-mitsin/example/internal/app/posts/usecases/like"
 	postUseCases "github.com/mikalai-mitsin/example/internal/app/posts/usecases/post"
 	tagUseCases "github.com/mikalai-mitsin/example/internal/app/posts/usecases/tag"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
 	"github.com/mikalai-mitsin/example/internal/pkg/clock"
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/grpc"
 	"github.com/mikalai-mitsin/example/internal/pkg/http"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/outbox"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/purge"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type App struct {
-	readDB            *sqlx.DB
-	writeDB           *sqlx.DB
+	readDB            postgres.Database
+	writeDB           postgres.Database
 	dtxManager        *dtx.Manager
 	logger            log.Logger
-	kafkaProducer     *kafka.Producer
+	outbox            *outbox.Outbox
 	postRepository    *postPostgresRepositories.PostRepository
 	postService       *postServices.PostService
 	postUseCase       *postUseCases.PostUseCase
@@ -65,43 +75,116 @@ type App struct {
 }
 
 func NewApp(
-	readDB, writeDB *sqlx.DB,
+	readDB, writeDB postgres.Database,
+	cursors *postgres.CursorCodec,
 	dtxManager *dtx.Manager,
 	logger log.Logger,
 	clock *clock.Clock,
 	uuidGenerator *uuid.UUIDv7Generator,
-	kafkaProducer *kafka.Producer,
+	eventOutbox *outbox.Outbox,
+	authorizer *authz.Authorizer,
+	authenticator *auth.Authenticator,
 ) *App {
-	postRepository := postPostgresRepositories.NewPostRepository(readDB, writeDB, logger)
+	postRepository := postPostgresRepositories.NewPostRepository(readDB, writeDB, cursors, logger)
 	postService := postServices.NewPostService(postRepository, clock, logger, uuidGenerator)
-	postEventProducer := postKafkaRepositories.NewPostEventProducer(kafkaProducer, logger)
+	postEventProducer := postKafkaRepositories.NewPostEventProducer(
+		eventOutbox,
+		clock,
+		logger,
+		uuidGenerator,
+	)
 	postEventService := postServices.NewPostEventService(postEventProducer, logger)
-	postUseCase := postUseCases.NewPostUseCase(postService, postEventService, dtxManager, logger)
-	httpPostHandler := postHttpHandlers.NewPostHandler(postUseCase, logger)
-	kafkaPostHandler := postKafkaHandlers.NewPostHandler(postUseCase, logger)
-	grpcPostHandler := postGrpcHandlers.NewPostServiceServer(postUseCase, logger)
-	tagRepository := tagPostgresRepositories.NewTagRepository(readDB, writeDB, logger)
-	tagService := tagServices.NewTagService(tagRepository, clock, logger, uuidGenerator)
-	tagEventProducer := tagKafkaRepositories.NewTagEventProducer(kafkaProducer, logger)
+	tagRepository := tagPostgresRepositories.NewTagRepository(readDB, writeDB, cursors, logger)
+	tagService := tagServices.NewTagService(
+		tagRepository,
+		postRepository,
+		clock,
+		logger,
+		uuidGenerator,
+	)
+	tagEventProducer := tagKafkaRepositories.NewTagEventProducer(
+		eventOutbox,
+		clock,
+		logger,
+		uuidGenerator,
+	)
 	tagEventService := tagServices.NewTagEventService(tagEventProducer, logger)
-	tagUseCase := tagUseCases.NewTagUseCase(tagService, tagEventService, dtxManager, logger)
+	tagUseCase := tagUseCases.NewTagUseCase(
+		tagService,
+		tagEventService,
+		dtxManager,
+		authorizer,
+		logger,
+	)
 	httpTagHandler := tagHttpHandlers.NewTagHandler(tagUseCase, logger)
-	kafkaTagHandler := tagKafkaHandlers.NewTagHandler(tagUseCase, logger)
+	kafkaTagHandler := tagKafkaHandlers.NewTagHandler(
+		tagUseCase,
+		authenticator,
+		dtxManager,
+		eventOutbox,
+		clock,
+		logger,
+	)
 	grpcTagHandler := tagGrpcHandlers.NewTagServiceServer(tagUseCase, logger)
-	likeRepository := likePostgresRepositories.NewLikeRepository(readDB, writeDB, logger)
-	likeService := likeServices.NewLikeService(likeRepository, clock, logger, uuidGenerator)
-	likeEventProducer := likeKafkaRepositories.NewLikeEventProducer(kafkaProducer, logger)
+	likeRepository := likePostgresRepositories.NewLikeRepository(readDB, writeDB, cursors, logger)
+	likeService := likeServices.NewLikeService(
+		likeRepository,
+		postRepository,
+		clock,
+		logger,
+		uuidGenerator,
+	)
+	likeEventProducer := likeKafkaRepositories.NewLikeEventProducer(
+		eventOutbox,
+		clock,
+		logger,
+		uuidGenerator,
+	)
 	likeEventService := likeServices.NewLikeEventService(likeEventProducer, logger)
-	likeUseCase := likeUseCases.NewLikeUseCase(likeService, likeEventService, dtxManager, logger)
+	likeUseCase := likeUseCases.NewLikeUseCase(
+		likeService,
+		likeEventService,
+		dtxManager,
+		authorizer,
+		logger,
+	)
 	httpLikeHandler := likeHttpHandlers.NewLikeHandler(likeUseCase, logger)
-	kafkaLikeHandler := likeKafkaHandlers.NewLikeHandler(likeUseCase, logger)
+	kafkaLikeHandler := likeKafkaHandlers.NewLikeHandler(
+		likeUseCase,
+		authenticator,
+		dtxManager,
+		eventOutbox,
+		clock,
+		logger,
+	)
 	grpcLikeHandler := likeGrpcHandlers.NewLikeServiceServer(likeUseCase, logger)
+	postUseCase := postUseCases.NewPostUseCase(
+		postService,
+		postEventService,
+		tagService,
+		tagEventService,
+		likeService,
+		likeEventService,
+		dtxManager,
+		authorizer,
+		logger,
+	)
+	httpPostHandler := postHttpHandlers.NewPostHandler(postUseCase, logger)
+	kafkaPostHandler := postKafkaHandlers.NewPostHandler(
+		postUseCase,
+		authenticator,
+		dtxManager,
+		eventOutbox,
+		clock,
+		logger,
+	)
+	grpcPostHandler := postGrpcHandlers.NewPostServiceServer(postUseCase, logger)
 	return &App{
 		readDB:            readDB,
 		writeDB:           writeDB,
 		dtxManager:        dtxManager,
 		logger:            logger,
-		kafkaProducer:     kafkaProducer,
+		outbox:            eventOutbox,
 		postRepository:    postRepository,
 		postService:       postService,
 		postUseCase:       postUseCase,
@@ -164,3 +247,38 @@ func (a *App) RegisterKafka(consumer *kafka.Consumer) error {
 	}
 	return nil
 }
+
+// RegisterPurge - purges of the soft deleted records of the app entities.
+func (a *App) RegisterPurge(worker *purge.Worker) error {
+	worker.Register("post", func(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error) {
+		posts, err := a.postUseCase.Purge(
+			ctx,
+			postEntities.PostPurge{DeletedBefore: deletedBefore, Limit: limit},
+		)
+		return len(posts), err
+	})
+	worker.Register("tag", func(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error) {
+		tags, err := a.tagUseCase.Purge(
+			ctx,
+			tagEntities.TagPurge{DeletedBefore: deletedBefore, Limit: limit},
+		)
+		return len(tags), err
+	})
+	worker.Register("like", func(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error) {
+		likes, err := a.likeUseCase.Purge(
+			ctx,
+			likeEntities.LikePurge{DeletedBefore: deletedBefore, Limit: limit},
+		)
+		return len(likes), err
+	})
+	return nil
+}
+
+// Tables - tables of the app repositories.
+func Tables() []postgres.Table {
+	return []postgres.Table{
+		postPostgresRepositories.Table,
+		tagPostgresRepositories.Table,
+		likePostgresRepositories.Table,
+	}
+}
@@ -4,10 +4,24 @@ import (
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
+// Permissions of the like actions.
+const (
+	PermissionLikeList   authz.Permission = "like_list"
+	PermissionLikeDetail authz.Permission = "like_detail"
+	PermissionLikeCreate authz.Permission = "like_create"
+	PermissionLikeUpdate authz.Permission = "like_update"
+	PermissionLikeDelete authz.Permission = "like_delete"
+	// PermissionLikeToggle - likes and unlikes the posts on behalf of the
+	// subject only.
+	PermissionLikeToggle authz.Permission = "like_toggle"
+)
+
 type Like struct {
 	ID        uuid.UUID  `json:"id"`
 	CreatedAt time.Time  `json:"created_at"`
@@ -62,12 +76,25 @@ const LikeOrderingUpdatedAtASC LikeOrdering = "updated_at"
 const LikeOrderingUpdatedAtDESC LikeOrdering = "-updated_at"
 const LikeOrderingDeletedAtASC LikeOrdering = "deleted_at"
 
+// LikeFilterMaxValues - the most values of a list field of the filter.
+const LikeFilterMaxValues = 100
+
 type LikeFilter struct {
-	PageSize   *uint64        `json:"page_size"`
-	PageNumber *uint64        `json:"page_number"`
-	Search     *string        `json:"search"`
-	OrderBy    []LikeOrdering `json:"order_by"`
-	IsDeleted  *bool          `json:"is_deleted"`
+	PageSize      *uint64        `json:"page_size"`
+	PageNumber    *uint64        `json:"page_number"`
+	Search        *string        `json:"search"`
+	OrderBy       []LikeOrdering `json:"order_by"`
+	IsDeleted     *bool          `json:"is_deleted"`
+	Cursor        *string        `json:"cursor"`
+	IncludeCount  *bool          `json:"include_count"`
+	IDs           []uuid.UUID    `json:"ids"`
+	CreatedAfter  *time.Time     `json:"created_after"`
+	CreatedBefore *time.Time     `json:"created_before"`
+	UpdatedAfter  *time.Time     `json:"updated_after"`
+	UpdatedBefore *time.Time     `json:"updated_before"`
+	PostIds       []uuid.UUID    `json:"post_ids"`
+	UserIds       []uuid.UUID    `json:"user_ids"`
+	Values        []string       `json:"values"`
 }
 
 func (m *LikeFilter) Validate() error {
@@ -78,6 +105,22 @@ func (m *LikeFilter) Validate() error {
 		validation.Field(&m.Search),
 		validation.Field(&m.OrderBy),
 		validation.Field(&m.IsDeleted),
+		validation.Field(&m.Cursor),
+		validation.Field(&m.IncludeCount),
+		validation.Field(&m.IDs, validation.Length(0, LikeFilterMaxValues), validation.Each(uuid.Required)),
+		validation.Field(&m.CreatedAfter),
+		validation.Field(
+			&m.CreatedBefore,
+			validation.When(m.CreatedAfter != nil, validation.Min(pointer.Value(m.CreatedAfter)).Exclusive()),
+		),
+		validation.Field(&m.UpdatedAfter),
+		validation.Field(
+			&m.UpdatedBefore,
+			validation.When(m.UpdatedAfter != nil, validation.Min(pointer.Value(m.UpdatedAfter)).Exclusive()),
+		),
+		validation.Field(&m.PostIds, validation.Length(0, LikeFilterMaxValues), validation.Each(uuid.Required)),
+		validation.Field(&m.UserIds, validation.Length(0, LikeFilterMaxValues), validation.Each(uuid.Required)),
+		validation.Field(&m.Values, validation.Length(0, LikeFilterMaxValues)),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -85,6 +128,14 @@ func (m *LikeFilter) Validate() error {
 	return nil
 }
 
+// LikeList - page of the likes, Count is nil when the filter excludes it and
+// NextCursor is nil on the last page.
+type LikeList struct {
+	Items      []Like  `json:"items"`
+	Count      *uint64 `json:"count"`
+	NextCursor *string `json:"next_cursor"`
+}
+
 type LikeCreate struct {
 	PostId uuid.UUID `json:"post_id"`
 	Value  string    `json:"value"`
@@ -104,20 +155,42 @@ func (m *LikeCreate) Validate() error {
 	return nil
 }
 
+// LikeUpdate - the post and the user of a like never change, a like of another
+// post or user is a new like.
 type LikeUpdate struct {
-	ID     uuid.UUID  `json:"id"`
-	PostId *uuid.UUID `json:"post_id"`
-	Value  *string    `json:"value"`
-	UserId *uuid.UUID `json:"user_id"`
+	ID    uuid.UUID `json:"id"`
+	Value *string   `json:"value"`
 }
 
 func (m *LikeUpdate) Validate() error {
 	err := validation.ValidateStruct(
 		m,
 		validation.Field(&m.ID, validation.Required),
-		validation.Field(&m.PostId),
 		validation.Field(&m.Value),
-		validation.Field(&m.UserId),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+// LikeToggle - likes the post on behalf of the user with the value, or removes
+// the like of the user if Liked is false. A user likes a post at most once, so
+// repeating a toggle changes nothing.
+type LikeToggle struct {
+	PostId uuid.UUID `json:"post_id"`
+	UserId uuid.UUID `json:"user_id"`
+	Liked  bool      `json:"liked"`
+	Value  string    `json:"value"`
+}
+
+func (m *LikeToggle) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.PostId, validation.Required, uuid.Required),
+		validation.Field(&m.UserId, validation.Required, uuid.Required),
+		validation.Field(&m.Liked),
+		validation.Field(&m.Value, validation.When(m.Liked, validation.Required)),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -136,3 +209,21 @@ func (m *LikeDelete) Validate() error {
 	}
 	return nil
 }
+
+// LikePurge - hard deletes a batch of the likes soft deleted before DeletedBefore.
+type LikePurge struct {
+	DeletedBefore time.Time `json:"deleted_before"`
+	Limit         uint64    `json:"limit"`
+}
+
+func (m *LikePurge) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.DeletedBefore, validation.Required),
+		validation.Field(&m.Limit, validation.Required),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
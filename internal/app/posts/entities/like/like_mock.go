@@ -57,13 +57,24 @@ func NewMockLikeCreate(t *testing.T) LikeCreate {
 func NewMockLikeUpdate(t *testing.T) LikeUpdate {
 	t.Helper()
 	return LikeUpdate{
-		ID:     uuid.NewUUID(),
-		PostId: pointer.Of(uuid.NewUUID()),
-		Value:  pointer.Of(faker.New().Lorem().Sentence(15)),
-		UserId: pointer.Of(uuid.NewUUID()),
+		ID:    uuid.NewUUID(),
+		Value: pointer.Of(faker.New().Lorem().Sentence(15)),
+	}
+}
+func NewMockLikeToggle(t *testing.T) LikeToggle {
+	t.Helper()
+	return LikeToggle{
+		PostId: uuid.NewUUID(),
+		UserId: uuid.NewUUID(),
+		Liked:  true,
+		Value:  faker.New().Lorem().Sentence(15),
 	}
 }
 func NewMockLikeDelete(t *testing.T) LikeDelete {
 	t.Helper()
 	return LikeDelete{ID: uuid.NewUUID()}
 }
+func NewMockLikePurge(t *testing.T) LikePurge {
+	t.Helper()
+	return LikePurge{DeletedBefore: time.Now().UTC(), Limit: 100}
+}
@@ -4,16 +4,30 @@ import (
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
+// Permissions of the post actions, the restore requires the delete one and the
+// moderate one allows to update, delete and restore the posts of other authors.
+const (
+	PermissionPostList     authz.Permission = "post_list"
+	PermissionPostDetail   authz.Permission = "post_detail"
+	PermissionPostCreate   authz.Permission = "post_create"
+	PermissionPostUpdate   authz.Permission = "post_update"
+	PermissionPostDelete   authz.Permission = "post_delete"
+	PermissionPostModerate authz.Permission = "post_moderate"
+)
+
 type Post struct {
 	ID        uuid.UUID  `json:"id"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
 	DeletedAt *time.Time `json:"deleted_at"`
 	Body      string     `json:"body"`
+	AuthorId  string     `json:"author_id"`
 }
 
 func (m *Post) Validate() error {
@@ -24,6 +38,7 @@ func (m *Post) Validate() error {
 		validation.Field(&m.UpdatedAt, validation.Required),
 		validation.Field(&m.DeletedAt),
 		validation.Field(&m.Body, validation.Required),
+		validation.Field(&m.AuthorId),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -54,12 +69,23 @@ const PostOrderingBodyDESC PostOrdering = "-body"
 const PostOrderingIdASC PostOrdering = "id"
 const PostOrderingIdDESC PostOrdering = "-id"
 
+// PostFilterMaxValues - the most values of a list field of the filter.
+const PostFilterMaxValues = 100
+
 type PostFilter struct {
-	PageSize   *uint64        `json:"page_size"`
-	PageNumber *uint64        `json:"page_number"`
-	Search     *string        `json:"search"`
-	OrderBy    []PostOrdering `json:"order_by"`
-	IsDeleted  *bool          `json:"is_deleted"`
+	PageSize      *uint64        `json:"page_size"`
+	PageNumber    *uint64        `json:"page_number"`
+	Search        *string        `json:"search"`
+	OrderBy       []PostOrdering `json:"order_by"`
+	IsDeleted     *bool          `json:"is_deleted"`
+	Cursor        *string        `json:"cursor"`
+	IncludeCount  *bool          `json:"include_count"`
+	IDs           []uuid.UUID    `json:"ids"`
+	CreatedAfter  *time.Time     `json:"created_after"`
+	CreatedBefore *time.Time     `json:"created_before"`
+	UpdatedAfter  *time.Time     `json:"updated_after"`
+	UpdatedBefore *time.Time     `json:"updated_before"`
+	AuthorIds     []string       `json:"author_ids"`
 }
 
 func (m *PostFilter) Validate() error {
@@ -70,6 +96,24 @@ func (m *PostFilter) Validate() error {
 		validation.Field(&m.Search),
 		validation.Field(&m.OrderBy),
 		validation.Field(&m.IsDeleted),
+		validation.Field(&m.Cursor),
+		validation.Field(&m.IncludeCount),
+		validation.Field(&m.IDs, validation.Length(0, PostFilterMaxValues), validation.Each(uuid.Required)),
+		validation.Field(&m.CreatedAfter),
+		validation.Field(
+			&m.CreatedBefore,
+			validation.When(m.CreatedAfter != nil, validation.Min(pointer.Value(m.CreatedAfter)).Exclusive()),
+		),
+		validation.Field(&m.UpdatedAfter),
+		validation.Field(
+			&m.UpdatedBefore,
+			validation.When(m.UpdatedAfter != nil, validation.Min(pointer.Value(m.UpdatedAfter)).Exclusive()),
+		),
+		validation.Field(
+			&m.AuthorIds,
+			validation.Length(0, PostFilterMaxValues),
+			validation.Each(validation.Required),
+		),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -77,12 +121,27 @@ func (m *PostFilter) Validate() error {
 	return nil
 }
 
+// PostList - page of the posts, Count is nil when the filter excludes it and
+// NextCursor is nil on the last page.
+type PostList struct {
+	Items      []Post  `json:"items"`
+	Count      *uint64 `json:"count"`
+	NextCursor *string `json:"next_cursor"`
+}
+
+// PostCreate - AuthorId is the authenticated subject creating the post, empty
+// for the trusted callers.
 type PostCreate struct {
-	Body string `json:"body"`
+	Body     string `json:"body"`
+	AuthorId string `json:"author_id"`
 }
 
 func (m *PostCreate) Validate() error {
-	err := validation.ValidateStruct(m, validation.Field(&m.Body, validation.Required))
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.Body, validation.Required),
+		validation.Field(&m.AuthorId),
+	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
 	}
@@ -117,3 +176,34 @@ func (m *PostDelete) Validate() error {
 	}
 	return nil
 }
+
+// PostRestore - undoes the soft deletion of the post.
+type PostRestore struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func (m *PostRestore) Validate() error {
+	err := validation.ValidateStruct(m, validation.Field(&m.ID, validation.Required))
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+// PostPurge - hard deletes a batch of the posts soft deleted before DeletedBefore.
+type PostPurge struct {
+	DeletedBefore time.Time `json:"deleted_before"`
+	Limit         uint64    `json:"limit"`
+}
+
+func (m *PostPurge) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.DeletedBefore, validation.Required),
+		validation.Field(&m.Limit, validation.Required),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ func NewMockPost(t *testing.T) Post {
 		UpdatedAt: faker.New().Time().Time(time.Now()),
 		DeletedAt: pointer.Of(faker.New().Time().Time(time.Now())),
 		Body:      faker.New().Lorem().Sentence(15),
+		AuthorId:  uuid.NewUUID().String(),
 	}
 }
 func NewMockPostFilter(t *testing.T) PostFilter {
@@ -42,7 +43,7 @@ func NewMockPostFilter(t *testing.T) PostFilter {
 }
 func NewMockPostCreate(t *testing.T) PostCreate {
 	t.Helper()
-	return PostCreate{Body: faker.New().Lorem().Sentence(15)}
+	return PostCreate{Body: faker.New().Lorem().Sentence(15), AuthorId: uuid.NewUUID().String()}
 }
 func NewMockPostUpdate(t *testing.T) PostUpdate {
 	t.Helper()
@@ -52,3 +53,11 @@ func NewMockPostDelete(t *testing.T) PostDelete {
 	t.Helper()
 	return PostDelete{ID: uuid.NewUUID()}
 }
+func NewMockPostRestore(t *testing.T) PostRestore {
+	t.Helper()
+	return PostRestore{ID: uuid.NewUUID()}
+}
+func NewMockPostPurge(t *testing.T) PostPurge {
+	t.Helper()
+	return PostPurge{DeletedBefore: time.Now().UTC(), Limit: 100}
+}
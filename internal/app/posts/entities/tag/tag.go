@@ -4,10 +4,21 @@ import (
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
+// Permissions of the tag actions.
+const (
+	PermissionTagList   authz.Permission = "tag_list"
+	PermissionTagDetail authz.Permission = "tag_detail"
+	PermissionTagCreate authz.Permission = "tag_create"
+	PermissionTagUpdate authz.Permission = "tag_update"
+	PermissionTagDelete authz.Permission = "tag_delete"
+)
+
 type Tag struct {
 	ID        uuid.UUID  `json:"id"`
 	CreatedAt time.Time  `json:"created_at"`
@@ -58,12 +69,24 @@ const TagOrderingUpdatedAtASC TagOrdering = "updated_at"
 const TagOrderingUpdatedAtDESC TagOrdering = "-updated_at"
 const TagOrderingDeletedAtDESC TagOrdering = "-deleted_at"
 
+// TagFilterMaxValues - the most values of a list field of the filter.
+const TagFilterMaxValues = 100
+
 type TagFilter struct {
-	PageSize   *uint64       `json:"page_size"`
-	PageNumber *uint64       `json:"page_number"`
-	Search     *string       `json:"search"`
-	OrderBy    []TagOrdering `json:"order_by"`
-	IsDeleted  *bool         `json:"is_deleted"`
+	PageSize      *uint64       `json:"page_size"`
+	PageNumber    *uint64       `json:"page_number"`
+	Search        *string       `json:"search"`
+	OrderBy       []TagOrdering `json:"order_by"`
+	IsDeleted     *bool         `json:"is_deleted"`
+	Cursor        *string       `json:"cursor"`
+	IncludeCount  *bool         `json:"include_count"`
+	IDs           []uuid.UUID   `json:"ids"`
+	CreatedAfter  *time.Time    `json:"created_after"`
+	CreatedBefore *time.Time    `json:"created_before"`
+	UpdatedAfter  *time.Time    `json:"updated_after"`
+	UpdatedBefore *time.Time    `json:"updated_before"`
+	PostIds       []uuid.UUID   `json:"post_ids"`
+	Values        []string      `json:"values"`
 }
 
 func (m *TagFilter) Validate() error {
@@ -74,6 +97,21 @@ func (m *TagFilter) Validate() error {
 		validation.Field(&m.Search),
 		validation.Field(&m.OrderBy),
 		validation.Field(&m.IsDeleted),
+		validation.Field(&m.Cursor),
+		validation.Field(&m.IncludeCount),
+		validation.Field(&m.IDs, validation.Length(0, TagFilterMaxValues), validation.Each(uuid.Required)),
+		validation.Field(&m.CreatedAfter),
+		validation.Field(
+			&m.CreatedBefore,
+			validation.When(m.CreatedAfter != nil, validation.Min(pointer.Value(m.CreatedAfter)).Exclusive()),
+		),
+		validation.Field(&m.UpdatedAfter),
+		validation.Field(
+			&m.UpdatedBefore,
+			validation.When(m.UpdatedAfter != nil, validation.Min(pointer.Value(m.UpdatedAfter)).Exclusive()),
+		),
+		validation.Field(&m.PostIds, validation.Length(0, TagFilterMaxValues), validation.Each(uuid.Required)),
+		validation.Field(&m.Values, validation.Length(0, TagFilterMaxValues)),
 	)
 	if err != nil {
 		return errs.NewFromValidationError(err)
@@ -81,6 +119,14 @@ func (m *TagFilter) Validate() error {
 	return nil
 }
 
+// TagList - page of the tags, Count is nil when the filter excludes it and
+// NextCursor is nil on the last page.
+type TagList struct {
+	Items      []Tag   `json:"items"`
+	Count      *uint64 `json:"count"`
+	NextCursor *string `json:"next_cursor"`
+}
+
 type TagCreate struct {
 	PostId uuid.UUID `json:"post_id"`
 	Value  string    `json:"value"`
@@ -128,3 +174,53 @@ func (m *TagDelete) Validate() error {
 	}
 	return nil
 }
+
+const (
+	// TagSuggestLimit - number of the suggestions when the limit is not set.
+	TagSuggestLimit uint64 = 10
+	// TagSuggestMaxLimit - maximum number of the suggestions.
+	TagSuggestMaxLimit uint64 = 50
+)
+
+// TagSuggest - typed prefix of the tag value, zero Limit is TagSuggestLimit.
+type TagSuggest struct {
+	Prefix string `json:"prefix"`
+	Limit  uint64 `json:"limit"`
+}
+
+func (m *TagSuggest) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.Prefix, validation.Required),
+		validation.Field(&m.Limit, validation.Max(TagSuggestMaxLimit)),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+// TagSuggestion - distinct tag value matching a typed prefix.
+type TagSuggestion struct {
+	Value      string  `json:"value"`
+	Similarity float64 `json:"similarity"`
+	Count      uint64  `json:"count"`
+}
+
+// TagPurge - hard deletes a batch of the tags soft deleted before DeletedBefore.
+type TagPurge struct {
+	DeletedBefore time.Time `json:"deleted_before"`
+	Limit         uint64    `json:"limit"`
+}
+
+func (m *TagPurge) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.DeletedBefore, validation.Required),
+		validation.Field(&m.Limit, validation.Required),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
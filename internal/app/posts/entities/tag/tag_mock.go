@@ -55,7 +55,26 @@ func NewMockTagUpdate(t *testing.T) TagUpdate {
 		Value:  pointer.Of(faker.New().Lorem().Sentence(15)),
 	}
 }
+func NewMockTagSuggest(t *testing.T) TagSuggest {
+	t.Helper()
+	return TagSuggest{
+		Prefix: faker.New().Lorem().Word(),
+		Limit:  faker.New().UInt64Between(1, TagSuggestMaxLimit),
+	}
+}
 func NewMockTagDelete(t *testing.T) TagDelete {
 	t.Helper()
 	return TagDelete{ID: uuid.NewUUID()}
 }
+func NewMockTagSuggestion(t *testing.T) TagSuggestion {
+	t.Helper()
+	return TagSuggestion{
+		Value:      faker.New().Lorem().Word(),
+		Similarity: faker.New().Float64(2, 0, 1),
+		Count:      faker.New().UInt64Between(1, 100),
+	}
+}
+func NewMockTagPurge(t *testing.T) TagPurge {
+	t.Helper()
+	return TagPurge{DeletedBefore: time.Now().UTC(), Limit: 100}
+}
@@ -19,11 +19,13 @@ func encodeLikeCreate(input *examplepb.LikeCreate) entities.LikeCreate {
 }
 func encodeLikeFilter(input *examplepb.LikeFilter) entities.LikeFilter {
 	filter := entities.LikeFilter{
-		PageSize:   nil,
-		PageNumber: nil,
-		IsDeleted:  nil,
-		OrderBy:    []entities.LikeOrdering{},
-		Search:     nil,
+		PageSize:     nil,
+		PageNumber:   nil,
+		IsDeleted:    nil,
+		OrderBy:      []entities.LikeOrdering{},
+		Search:       nil,
+		Cursor:       nil,
+		IncludeCount: nil,
 	}
 	if input.GetPageSize() != nil {
 		filter.PageSize = pointer.Of(input.GetPageSize().GetValue())
@@ -34,24 +36,60 @@ func encodeLikeFilter(input *examplepb.LikeFilter) entities.LikeFilter {
 	if input.GetIsDeleted() != nil {
 		filter.IsDeleted = pointer.Of(input.GetIsDeleted().GetValue())
 	}
+	if input.GetSearch() != nil {
+		filter.Search = pointer.Of(input.GetSearch().GetValue())
+	}
+	if input.GetCursor() != nil {
+		filter.Cursor = pointer.Of(input.GetCursor().GetValue())
+	}
+	if input.GetIncludeCount() != nil {
+		filter.IncludeCount = pointer.Of(input.GetIncludeCount().GetValue())
+	}
 	for _, orderBy := range input.GetOrderBy() {
 		filter.OrderBy = append(filter.OrderBy, entities.LikeOrdering(orderBy))
 	}
+	for _, id := range input.GetIds() {
+		filter.IDs = append(filter.IDs, uuid.MustParse(id))
+	}
+	if input.GetCreatedAfter() != nil {
+		filter.CreatedAfter = pointer.Of(input.GetCreatedAfter().AsTime())
+	}
+	if input.GetCreatedBefore() != nil {
+		filter.CreatedBefore = pointer.Of(input.GetCreatedBefore().AsTime())
+	}
+	if input.GetUpdatedAfter() != nil {
+		filter.UpdatedAfter = pointer.Of(input.GetUpdatedAfter().AsTime())
+	}
+	if input.GetUpdatedBefore() != nil {
+		filter.UpdatedBefore = pointer.Of(input.GetUpdatedBefore().AsTime())
+	}
+	for _, postId := range input.GetPostIds() {
+		filter.PostIds = append(filter.PostIds, uuid.MustParse(postId))
+	}
+	for _, userId := range input.GetUserIds() {
+		filter.UserIds = append(filter.UserIds, uuid.MustParse(userId))
+	}
+	filter.Values = input.GetValues()
 	return filter
 }
 func encodeLikeUpdate(input *examplepb.LikeUpdate) entities.LikeUpdate {
 	update := entities.LikeUpdate{ID: uuid.MustParse(input.GetId())}
-	if input.GetPostId() != nil {
-		update.PostId = pointer.Of(uuid.MustParse(input.GetPostId().GetValue()))
-	}
 	if input.GetValue() != nil {
 		update.Value = pointer.Of(string(input.GetValue().GetValue()))
 	}
-	if input.GetUserId() != nil {
-		update.UserId = pointer.Of(uuid.MustParse(input.GetUserId().GetValue()))
-	}
 	return update
 }
+func encodeLikeToggle(input *examplepb.LikeToggle) entities.LikeToggle {
+	toggle := entities.LikeToggle{
+		PostId: uuid.MustParse(input.GetPostId()),
+		Liked:  true,
+		Value:  input.GetValue(),
+	}
+	if input.GetLiked() != nil {
+		toggle.Liked = input.GetLiked().GetValue()
+	}
+	return toggle
+}
 func encodeLikeDelete(input *examplepb.LikeDelete) entities.LikeDelete {
 	del := entities.LikeDelete{ID: uuid.MustParse(input.GetId())}
 	return del
@@ -71,19 +109,27 @@ func decodeLike(like entities.Like) *examplepb.Like {
 	}
 	return response
 }
-func decodeListLike(items []entities.Like, count uint64) *examplepb.ListLike {
-	response := &examplepb.ListLike{Items: make([]*examplepb.Like, 0, len(items)), Count: count}
-	for _, like := range items {
+func decodeListLike(list entities.LikeList) *examplepb.ListLike {
+	response := &examplepb.ListLike{
+		Items:      make([]*examplepb.Like, 0, len(list.Items)),
+		Count:      0,
+		NextCursor: nil,
+	}
+	for _, like := range list.Items {
 		response.Items = append(response.Items, decodeLike(like))
 	}
+	if list.Count != nil {
+		response.Count = *list.Count
+	}
+	if list.NextCursor != nil {
+		response.NextCursor = wrapperspb.String(*list.NextCursor)
+	}
 	return response
 }
 func decodeLikeUpdate(update entities.LikeUpdate) *examplepb.LikeUpdate {
 	result := &examplepb.LikeUpdate{
-		Id:     string(update.ID.String()),
-		PostId: wrapperspb.String(update.PostId.String()),
-		Value:  wrapperspb.String(*update.Value),
-		UserId: wrapperspb.String(update.UserId.String()),
+		Id:    string(update.ID.String()),
+		Value: wrapperspb.String(*update.Value),
 	}
 	return result
 }
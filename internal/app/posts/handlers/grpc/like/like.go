@@ -44,11 +44,11 @@ func (s *LikeServiceServer) List(
 	ctx context.Context,
 	filter *examplepb.LikeFilter,
 ) (*examplepb.ListLike, error) {
-	items, count, err := s.likeUseCase.List(ctx, encodeLikeFilter(filter))
+	list, err := s.likeUseCase.List(ctx, encodeLikeFilter(filter))
 	if err != nil {
 		return nil, err
 	}
-	return decodeListLike(items, count), nil
+	return decodeListLike(list), nil
 }
 
 func (s *LikeServiceServer) Update(
@@ -62,6 +62,21 @@ func (s *LikeServiceServer) Update(
 	return decodeLike(item), nil
 }
 
+// Toggle - an empty like when the caller does not like the post.
+func (s *LikeServiceServer) Toggle(
+	ctx context.Context,
+	input *examplepb.LikeToggle,
+) (*examplepb.Like, error) {
+	like, err := s.likeUseCase.Toggle(ctx, encodeLikeToggle(input))
+	if err != nil {
+		return nil, err
+	}
+	if like.ID.IsEmpty() || like.DeletedAt != nil {
+		return &examplepb.Like{}, nil
+	}
+	return decodeLike(like), nil
+}
+
 func (s *LikeServiceServer) Delete(
 	ctx context.Context,
 	input *examplepb.LikeDelete,
@@ -6,10 +6,12 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 
 	"testing"
+	"time"
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -308,8 +310,9 @@ func TestLikeServiceServer_List(t *testing.T) {
 	filter := entities.NewMockLikeFilter(t)
 	count := faker.New().UInt64Between(2, 20)
 	response := &examplepb.ListLike{
-		Items: make([]*examplepb.Like, 0, int(count)),
-		Count: count,
+		Items:      make([]*examplepb.Like, 0, int(count)),
+		Count:      count,
+		NextCursor: wrapperspb.String("next"),
 	}
 	likes := make([]entities.Like, 0, int(count))
 	type fields struct {
@@ -332,7 +335,7 @@ func TestLikeServiceServer_List(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockLikeUseCase.EXPECT().List(ctx, gomock.Any()).Return(likes, count, nil).Times(1)
+				mockLikeUseCase.EXPECT().List(ctx, gomock.Any()).Return(entities.LikeList{Items: likes, Count: pointer.Of(count), NextCursor: pointer.Of("next")}, nil).Times(1)
 			},
 			fields: fields{
 				UnimplementedLikeServiceServer: examplepb.UnimplementedLikeServiceServer{},
@@ -356,7 +359,7 @@ func TestLikeServiceServer_List(t *testing.T) {
 				mockLikeUseCase.
 					EXPECT().
 					List(ctx, gomock.Any()).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("i error")).
+					Return(entities.LikeList{}, errs.NewUnexpectedBehaviorError("i error")).
 					Times(1)
 			},
 			fields: fields{
@@ -467,6 +470,109 @@ func TestLikeServiceServer_Update(t *testing.T) {
 	}
 }
 
+func TestLikeServiceServer_Toggle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeUseCase := NewMocklikeUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	like := entities.NewMockLike(t)
+	like.DeletedAt = nil
+	unliked := entities.NewMockLike(t)
+	postId := uuid.NewUUID()
+	type fields struct {
+		UnimplementedLikeServiceServer examplepb.UnimplementedLikeServiceServer
+		likeUseCase                    likeUseCase
+		logger                         logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.LikeToggle
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.Like
+		wantErr error
+	}{
+		{
+			name: "liked",
+			setup: func() {
+				mockLikeUseCase.EXPECT().
+					Toggle(ctx, entities.LikeToggle{PostId: postId, Liked: true, Value: "heart"}).
+					Return(like, nil)
+			},
+			fields: fields{
+				UnimplementedLikeServiceServer: examplepb.UnimplementedLikeServiceServer{},
+				likeUseCase:                    mockLikeUseCase,
+				logger:                         mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.LikeToggle{PostId: postId.String(), Value: "heart"},
+			},
+			want:    decodeLike(like),
+			wantErr: nil,
+		},
+		{
+			name: "unliked",
+			setup: func() {
+				mockLikeUseCase.EXPECT().
+					Toggle(ctx, entities.LikeToggle{PostId: postId, Liked: false}).
+					Return(unliked, nil)
+			},
+			fields: fields{
+				UnimplementedLikeServiceServer: examplepb.UnimplementedLikeServiceServer{},
+				likeUseCase:                    mockLikeUseCase,
+				logger:                         mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				input: &examplepb.LikeToggle{
+					PostId: postId.String(),
+					Liked:  wrapperspb.Bool(false),
+				},
+			},
+			want:    &examplepb.Like{},
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockLikeUseCase.EXPECT().
+					Toggle(ctx, gomock.Any()).
+					Return(entities.Like{}, errs.NewUnexpectedBehaviorError("i error"))
+			},
+			fields: fields{
+				UnimplementedLikeServiceServer: examplepb.UnimplementedLikeServiceServer{},
+				likeUseCase:                    mockLikeUseCase,
+				logger:                         mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.LikeToggle{PostId: postId.String(), Value: "heart"},
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("i error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := LikeServiceServer{
+				UnimplementedLikeServiceServer: tt.fields.UnimplementedLikeServiceServer,
+				likeUseCase:                    tt.fields.likeUseCase,
+				logger:                         tt.fields.logger,
+			}
+			got, err := s.Toggle(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_decodeLike(t *testing.T) {
 	like := entities.NewMockLike(t)
 	result := &examplepb.Like{
@@ -517,6 +623,7 @@ func Test_encodeLikeFilter(t *testing.T) {
 				input: &examplepb.LikeFilter{
 					PageNumber: wrapperspb.UInt64(2),
 					PageSize:   wrapperspb.UInt64(5),
+					Search:     wrapperspb.String("my name is"),
 					OrderBy:    []string{"created_at", "id"},
 				},
 			},
@@ -524,6 +631,45 @@ func Test_encodeLikeFilter(t *testing.T) {
 				PageSize:   pointer.Of(uint64(5)),
 				PageNumber: pointer.Of(uint64(2)),
 				OrderBy:    []entities.LikeOrdering{"created_at", "id"},
+				Search:     pointer.Of("my name is"),
+			},
+		},
+		{
+			name: "with cursor",
+			args: args{
+				input: &examplepb.LikeFilter{
+					PageSize:     wrapperspb.UInt64(5),
+					Cursor:       wrapperspb.String("cursor"),
+					IncludeCount: wrapperspb.Bool(false),
+				},
+			},
+			want: entities.LikeFilter{
+				PageSize:     pointer.Of(uint64(5)),
+				OrderBy:      []entities.LikeOrdering{},
+				Cursor:       pointer.Of("cursor"),
+				IncludeCount: pointer.Of(false),
+			},
+		},
+		{
+			name: "with fields",
+			args: args{
+				input: &examplepb.LikeFilter{
+					Ids:           []string{"0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c"},
+					CreatedAfter:  timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					UpdatedBefore: timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+					PostIds:       []string{"0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d"},
+					UserIds:       []string{"0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8e"},
+					Values:        []string{"up"},
+				},
+			},
+			want: entities.LikeFilter{
+				OrderBy:       []entities.LikeOrdering{},
+				IDs:           []uuid.UUID{uuid.MustParse("0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c")},
+				CreatedAfter:  pointer.Of(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedBefore: pointer.Of(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+				PostIds:       []uuid.UUID{uuid.MustParse("0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d")},
+				UserIds:       []uuid.UUID{uuid.MustParse("0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8e")},
+				Values:        []string{"up"},
 			},
 		},
 	}
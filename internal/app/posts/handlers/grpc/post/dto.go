@@ -15,11 +15,13 @@ func encodePostCreate(input *examplepb.PostCreate) entities.PostCreate {
 }
 func encodePostFilter(input *examplepb.PostFilter) entities.PostFilter {
 	filter := entities.PostFilter{
-		PageSize:   nil,
-		PageNumber: nil,
-		IsDeleted:  nil,
-		OrderBy:    []entities.PostOrdering{},
-		Search:     nil,
+		PageSize:     nil,
+		PageNumber:   nil,
+		IsDeleted:    nil,
+		OrderBy:      []entities.PostOrdering{},
+		Search:       nil,
+		Cursor:       nil,
+		IncludeCount: nil,
 	}
 	if input.GetPageSize() != nil {
 		filter.PageSize = pointer.Of(input.GetPageSize().GetValue())
@@ -30,9 +32,34 @@ func encodePostFilter(input *examplepb.PostFilter) entities.PostFilter {
 	if input.GetIsDeleted() != nil {
 		filter.IsDeleted = pointer.Of(input.GetIsDeleted().GetValue())
 	}
+	if input.GetSearch() != nil {
+		filter.Search = pointer.Of(input.GetSearch().GetValue())
+	}
+	if input.GetCursor() != nil {
+		filter.Cursor = pointer.Of(input.GetCursor().GetValue())
+	}
+	if input.GetIncludeCount() != nil {
+		filter.IncludeCount = pointer.Of(input.GetIncludeCount().GetValue())
+	}
 	for _, orderBy := range input.GetOrderBy() {
 		filter.OrderBy = append(filter.OrderBy, entities.PostOrdering(orderBy))
 	}
+	for _, id := range input.GetIds() {
+		filter.IDs = append(filter.IDs, uuid.MustParse(id))
+	}
+	if input.GetCreatedAfter() != nil {
+		filter.CreatedAfter = pointer.Of(input.GetCreatedAfter().AsTime())
+	}
+	if input.GetCreatedBefore() != nil {
+		filter.CreatedBefore = pointer.Of(input.GetCreatedBefore().AsTime())
+	}
+	if input.GetUpdatedAfter() != nil {
+		filter.UpdatedAfter = pointer.Of(input.GetUpdatedAfter().AsTime())
+	}
+	if input.GetUpdatedBefore() != nil {
+		filter.UpdatedBefore = pointer.Of(input.GetUpdatedBefore().AsTime())
+	}
+	filter.AuthorIds = append(filter.AuthorIds, input.GetAuthorIds()...)
 	return filter
 }
 func encodePostUpdate(input *examplepb.PostUpdate) entities.PostUpdate {
@@ -46,6 +73,10 @@ func encodePostDelete(input *examplepb.PostDelete) entities.PostDelete {
 	del := entities.PostDelete{ID: uuid.MustParse(input.GetId())}
 	return del
 }
+func encodePostRestore(input *examplepb.PostRestore) entities.PostRestore {
+	restore := entities.PostRestore{ID: uuid.MustParse(input.GetId())}
+	return restore
+}
 func decodePost(post entities.Post) *examplepb.Post {
 	response := &examplepb.Post{
 		Id:        post.ID.String(),
@@ -53,17 +84,28 @@ func decodePost(post entities.Post) *examplepb.Post {
 		UpdatedAt: timestamppb.New(post.UpdatedAt),
 		DeletedAt: nil,
 		Body:      post.Body,
+		AuthorId:  post.AuthorId,
 	}
 	if post.DeletedAt != nil {
 		response.DeletedAt = timestamppb.New(*post.DeletedAt)
 	}
 	return response
 }
-func decodeListPost(items []entities.Post, count uint64) *examplepb.ListPost {
-	response := &examplepb.ListPost{Items: make([]*examplepb.Post, 0, len(items)), Count: count}
-	for _, post := range items {
+func decodeListPost(list entities.PostList) *examplepb.ListPost {
+	response := &examplepb.ListPost{
+		Items:      make([]*examplepb.Post, 0, len(list.Items)),
+		Count:      0,
+		NextCursor: nil,
+	}
+	for _, post := range list.Items {
 		response.Items = append(response.Items, decodePost(post))
 	}
+	if list.Count != nil {
+		response.Count = *list.Count
+	}
+	if list.NextCursor != nil {
+		response.NextCursor = wrapperspb.String(*list.NextCursor)
+	}
 	return response
 }
 func decodePostUpdate(update entities.PostUpdate) *examplepb.PostUpdate {
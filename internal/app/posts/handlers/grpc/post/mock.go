@@ -91,13 +91,12 @@ func (mr *MockpostUseCaseMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockpostUseCase) List(arg0 context.Context, arg1 post.PostFilter) ([]post.Post, uint64, error) {
+func (m *MockpostUseCase) List(arg0 context.Context, arg1 post.PostFilter) (post.PostList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]post.Post)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(post.PostList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -106,6 +105,21 @@ func (mr *MockpostUseCaseMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpostUseCase)(nil).List), arg0, arg1)
 }
 
+// Restore mocks base method.
+func (m *MockpostUseCase) Restore(arg0 context.Context, arg1 post.PostRestore) (post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockpostUseCaseMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockpostUseCase)(nil).Restore), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockpostUseCase) Update(arg0 context.Context, arg1 post.PostUpdate) (post.Post, error) {
 	m.ctrl.T.Helper()
@@ -44,11 +44,11 @@ func (s *PostServiceServer) List(
 	ctx context.Context,
 	filter *examplepb.PostFilter,
 ) (*examplepb.ListPost, error) {
-	items, count, err := s.postUseCase.List(ctx, encodePostFilter(filter))
+	list, err := s.postUseCase.List(ctx, encodePostFilter(filter))
 	if err != nil {
 		return nil, err
 	}
-	return decodeListPost(items, count), nil
+	return decodeListPost(list), nil
 }
 
 func (s *PostServiceServer) Update(
@@ -72,6 +72,16 @@ func (s *PostServiceServer) Delete(
 	}
 	return decodePost(post), nil
 }
+func (s *PostServiceServer) Restore(
+	ctx context.Context,
+	input *examplepb.PostRestore,
+) (*examplepb.Post, error) {
+	post, err := s.postUseCase.Restore(ctx, encodePostRestore(input))
+	if err != nil {
+		return nil, err
+	}
+	return decodePost(post), nil
+}
 func (s *PostServiceServer) RegisterGRPC(grpcServer *grpc.Server) error {
 	grpcServer.AddHandler(&examplepb.PostService_ServiceDesc, s)
 	return nil
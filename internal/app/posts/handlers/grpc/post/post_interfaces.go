@@ -12,9 +12,10 @@ import (
 type postUseCase interface {
 	Create(context.Context, entities.PostCreate) (entities.Post, error)
 	Get(context.Context, uuid.UUID) (entities.Post, error)
-	List(context.Context, entities.PostFilter) ([]entities.Post, uint64, error)
+	List(context.Context, entities.PostFilter) (entities.PostList, error)
 	Update(context.Context, entities.PostUpdate) (entities.Post, error)
 	Delete(context.Context, entities.PostDelete) (entities.Post, error)
+	Restore(context.Context, entities.PostRestore) (entities.Post, error)
 }
 type logger interface {
 	log.Logger
@@ -6,10 +6,12 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 
 	"testing"
+	"time"
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -219,6 +221,92 @@ func TestPostServiceServer_Delete(t *testing.T) {
 	}
 }
 
+func TestPostServiceServer_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostUseCase := NewMockpostUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	post := entities.NewMockPost(t)
+	restore := entities.NewMockPostRestore(t)
+	restore.ID = post.ID
+	type fields struct {
+		UnimplementedPostServiceServer examplepb.UnimplementedPostServiceServer
+		postUseCase                    postUseCase
+		logger                         logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.PostRestore
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockPostUseCase.EXPECT().Restore(ctx, restore).Return(post, nil)
+			},
+			fields: fields{
+				UnimplementedPostServiceServer: examplepb.UnimplementedPostServiceServer{},
+				postUseCase:                    mockPostUseCase,
+				logger:                         mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				input: &examplepb.PostRestore{
+					Id: post.ID.String(),
+				},
+			},
+			want:    decodePost(post),
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockPostUseCase.EXPECT().Restore(ctx, restore).
+					Return(entities.Post{}, errs.NewUnexpectedBehaviorError("i error")).
+					Times(1)
+			},
+			fields: fields{
+				UnimplementedPostServiceServer: examplepb.UnimplementedPostServiceServer{},
+				postUseCase:                    mockPostUseCase,
+				logger:                         mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				input: &examplepb.PostRestore{
+					Id: post.ID.String(),
+				},
+			},
+			want: nil,
+			wantErr: &errs.Error{
+				Code:    13,
+				Message: "Unexpected behavior.",
+				Params:  errs.Params{{Key: "details", Value: "i error"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := PostServiceServer{
+				UnimplementedPostServiceServer: tt.fields.UnimplementedPostServiceServer,
+				postUseCase:                    tt.fields.postUseCase,
+				logger:                         tt.fields.logger,
+			}
+			got, err := s.Restore(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestPostServiceServer_Get(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -308,8 +396,9 @@ func TestPostServiceServer_List(t *testing.T) {
 	filter := entities.NewMockPostFilter(t)
 	count := faker.New().UInt64Between(2, 20)
 	response := &examplepb.ListPost{
-		Items: make([]*examplepb.Post, 0, int(count)),
-		Count: count,
+		Items:      make([]*examplepb.Post, 0, int(count)),
+		Count:      count,
+		NextCursor: wrapperspb.String("next"),
 	}
 	posts := make([]entities.Post, 0, int(count))
 	type fields struct {
@@ -332,7 +421,7 @@ func TestPostServiceServer_List(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockPostUseCase.EXPECT().List(ctx, gomock.Any()).Return(posts, count, nil).Times(1)
+				mockPostUseCase.EXPECT().List(ctx, gomock.Any()).Return(entities.PostList{Items: posts, Count: pointer.Of(count), NextCursor: pointer.Of("next")}, nil).Times(1)
 			},
 			fields: fields{
 				UnimplementedPostServiceServer: examplepb.UnimplementedPostServiceServer{},
@@ -356,7 +445,7 @@ func TestPostServiceServer_List(t *testing.T) {
 				mockPostUseCase.
 					EXPECT().
 					List(ctx, gomock.Any()).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("i error")).
+					Return(entities.PostList{}, errs.NewUnexpectedBehaviorError("i error")).
 					Times(1)
 			},
 			fields: fields{
@@ -475,6 +564,7 @@ func Test_decodePost(t *testing.T) {
 		CreatedAt: timestamppb.New(post.CreatedAt),
 		DeletedAt: timestamppb.New(*post.DeletedAt),
 		Body:      string(post.Body),
+		AuthorId:  post.AuthorId,
 	}
 	type args struct {
 		post entities.Post
@@ -515,6 +605,7 @@ func Test_encodePostFilter(t *testing.T) {
 				input: &examplepb.PostFilter{
 					PageNumber: wrapperspb.UInt64(2),
 					PageSize:   wrapperspb.UInt64(5),
+					Search:     wrapperspb.String("my name is"),
 					OrderBy:    []string{"created_at", "id"},
 				},
 			},
@@ -522,6 +613,41 @@ func Test_encodePostFilter(t *testing.T) {
 				PageSize:   pointer.Of(uint64(5)),
 				PageNumber: pointer.Of(uint64(2)),
 				OrderBy:    []entities.PostOrdering{"created_at", "id"},
+				Search:     pointer.Of("my name is"),
+			},
+		},
+		{
+			name: "with cursor",
+			args: args{
+				input: &examplepb.PostFilter{
+					PageSize:     wrapperspb.UInt64(5),
+					Cursor:       wrapperspb.String("cursor"),
+					IncludeCount: wrapperspb.Bool(false),
+				},
+			},
+			want: entities.PostFilter{
+				PageSize:     pointer.Of(uint64(5)),
+				OrderBy:      []entities.PostOrdering{},
+				Cursor:       pointer.Of("cursor"),
+				IncludeCount: pointer.Of(false),
+			},
+		},
+		{
+			name: "with fields",
+			args: args{
+				input: &examplepb.PostFilter{
+					Ids:           []string{"0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c"},
+					CreatedAfter:  timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					UpdatedBefore: timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+					AuthorIds:     []string{"author"},
+				},
+			},
+			want: entities.PostFilter{
+				OrderBy:       []entities.PostOrdering{},
+				IDs:           []uuid.UUID{uuid.MustParse("0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c")},
+				CreatedAfter:  pointer.Of(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedBefore: pointer.Of(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+				AuthorIds:     []string{"author"},
 			},
 		},
 	}
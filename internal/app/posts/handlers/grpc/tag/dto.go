@@ -15,11 +15,13 @@ func encodeTagCreate(input *examplepb.TagCreate) entities.TagCreate {
 }
 func encodeTagFilter(input *examplepb.TagFilter) entities.TagFilter {
 	filter := entities.TagFilter{
-		PageSize:   nil,
-		PageNumber: nil,
-		IsDeleted:  nil,
-		OrderBy:    []entities.TagOrdering{},
-		Search:     nil,
+		PageSize:     nil,
+		PageNumber:   nil,
+		IsDeleted:    nil,
+		OrderBy:      []entities.TagOrdering{},
+		Search:       nil,
+		Cursor:       nil,
+		IncludeCount: nil,
 	}
 	if input.GetPageSize() != nil {
 		filter.PageSize = pointer.Of(input.GetPageSize().GetValue())
@@ -30,9 +32,37 @@ func encodeTagFilter(input *examplepb.TagFilter) entities.TagFilter {
 	if input.GetIsDeleted() != nil {
 		filter.IsDeleted = pointer.Of(input.GetIsDeleted().GetValue())
 	}
+	if input.GetSearch() != nil {
+		filter.Search = pointer.Of(input.GetSearch().GetValue())
+	}
+	if input.GetCursor() != nil {
+		filter.Cursor = pointer.Of(input.GetCursor().GetValue())
+	}
+	if input.GetIncludeCount() != nil {
+		filter.IncludeCount = pointer.Of(input.GetIncludeCount().GetValue())
+	}
 	for _, orderBy := range input.GetOrderBy() {
 		filter.OrderBy = append(filter.OrderBy, entities.TagOrdering(orderBy))
 	}
+	for _, id := range input.GetIds() {
+		filter.IDs = append(filter.IDs, uuid.MustParse(id))
+	}
+	if input.GetCreatedAfter() != nil {
+		filter.CreatedAfter = pointer.Of(input.GetCreatedAfter().AsTime())
+	}
+	if input.GetCreatedBefore() != nil {
+		filter.CreatedBefore = pointer.Of(input.GetCreatedBefore().AsTime())
+	}
+	if input.GetUpdatedAfter() != nil {
+		filter.UpdatedAfter = pointer.Of(input.GetUpdatedAfter().AsTime())
+	}
+	if input.GetUpdatedBefore() != nil {
+		filter.UpdatedBefore = pointer.Of(input.GetUpdatedBefore().AsTime())
+	}
+	for _, postId := range input.GetPostIds() {
+		filter.PostIds = append(filter.PostIds, uuid.MustParse(postId))
+	}
+	filter.Values = input.GetValues()
 	return filter
 }
 func encodeTagUpdate(input *examplepb.TagUpdate) entities.TagUpdate {
@@ -49,6 +79,9 @@ func encodeTagDelete(input *examplepb.TagDelete) entities.TagDelete {
 	del := entities.TagDelete{ID: uuid.MustParse(input.GetId())}
 	return del
 }
+func encodeTagSuggest(input *examplepb.TagSuggest) entities.TagSuggest {
+	return entities.TagSuggest{Prefix: input.GetPrefix(), Limit: input.GetLimit().GetValue()}
+}
 func decodeTag(tag entities.Tag) *examplepb.Tag {
 	response := &examplepb.Tag{
 		Id:        tag.ID.String(),
@@ -63,11 +96,21 @@ func decodeTag(tag entities.Tag) *examplepb.Tag {
 	}
 	return response
 }
-func decodeListTag(items []entities.Tag, count uint64) *examplepb.ListTag {
-	response := &examplepb.ListTag{Items: make([]*examplepb.Tag, 0, len(items)), Count: count}
-	for _, tag := range items {
+func decodeListTag(list entities.TagList) *examplepb.ListTag {
+	response := &examplepb.ListTag{
+		Items:      make([]*examplepb.Tag, 0, len(list.Items)),
+		Count:      0,
+		NextCursor: nil,
+	}
+	for _, tag := range list.Items {
 		response.Items = append(response.Items, decodeTag(tag))
 	}
+	if list.Count != nil {
+		response.Count = *list.Count
+	}
+	if list.NextCursor != nil {
+		response.NextCursor = wrapperspb.String(*list.NextCursor)
+	}
 	return response
 }
 func decodeTagUpdate(update entities.TagUpdate) *examplepb.TagUpdate {
@@ -78,3 +121,16 @@ func decodeTagUpdate(update entities.TagUpdate) *examplepb.TagUpdate {
 	}
 	return result
 }
+func decodeListTagSuggestion(items []entities.TagSuggestion) *examplepb.ListTagSuggestion {
+	response := &examplepb.ListTagSuggestion{
+		Items: make([]*examplepb.TagSuggestion, 0, len(items)),
+	}
+	for _, suggestion := range items {
+		response.Items = append(response.Items, &examplepb.TagSuggestion{
+			Value:      suggestion.Value,
+			Similarity: suggestion.Similarity,
+			Count:      suggestion.Count,
+		})
+	}
+	return response
+}
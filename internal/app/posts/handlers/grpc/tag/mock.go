@@ -91,13 +91,12 @@ func (mr *MocktagUseCaseMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MocktagUseCase) List(arg0 context.Context, arg1 tag.TagFilter) ([]tag.Tag, uint64, error) {
+func (m *MocktagUseCase) List(arg0 context.Context, arg1 tag.TagFilter) (tag.TagList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]tag.Tag)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(tag.TagList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -106,6 +105,21 @@ func (mr *MocktagUseCaseMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocktagUseCase)(nil).List), arg0, arg1)
 }
 
+// Suggest mocks base method.
+func (m *MocktagUseCase) Suggest(arg0 context.Context, arg1 tag.TagSuggest) ([]tag.TagSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].([]tag.TagSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MocktagUseCaseMockRecorder) Suggest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MocktagUseCase)(nil).Suggest), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MocktagUseCase) Update(arg0 context.Context, arg1 tag.TagUpdate) (tag.Tag, error) {
 	m.ctrl.T.Helper()
@@ -44,11 +44,22 @@ func (s *TagServiceServer) List(
 	ctx context.Context,
 	filter *examplepb.TagFilter,
 ) (*examplepb.ListTag, error) {
-	items, count, err := s.tagUseCase.List(ctx, encodeTagFilter(filter))
+	list, err := s.tagUseCase.List(ctx, encodeTagFilter(filter))
 	if err != nil {
 		return nil, err
 	}
-	return decodeListTag(items, count), nil
+	return decodeListTag(list), nil
+}
+
+func (s *TagServiceServer) Suggest(
+	ctx context.Context,
+	input *examplepb.TagSuggest,
+) (*examplepb.ListTagSuggestion, error) {
+	items, err := s.tagUseCase.Suggest(ctx, encodeTagSuggest(input))
+	if err != nil {
+		return nil, err
+	}
+	return decodeListTagSuggestion(items), nil
 }
 
 func (s *TagServiceServer) Update(
@@ -12,7 +12,8 @@ import (
 type tagUseCase interface {
 	Create(context.Context, entities.TagCreate) (entities.Tag, error)
 	Get(context.Context, uuid.UUID) (entities.Tag, error)
-	List(context.Context, entities.TagFilter) ([]entities.Tag, uint64, error)
+	List(context.Context, entities.TagFilter) (entities.TagList, error)
+	Suggest(context.Context, entities.TagSuggest) ([]entities.TagSuggestion, error)
 	Update(context.Context, entities.TagUpdate) (entities.Tag, error)
 	Delete(context.Context, entities.TagDelete) (entities.Tag, error)
 }
@@ -6,10 +6,12 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 
 	"testing"
+	"time"
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -308,8 +310,9 @@ func TestTagServiceServer_List(t *testing.T) {
 	filter := entities.NewMockTagFilter(t)
 	count := faker.New().UInt64Between(2, 20)
 	response := &examplepb.ListTag{
-		Items: make([]*examplepb.Tag, 0, int(count)),
-		Count: count,
+		Items:      make([]*examplepb.Tag, 0, int(count)),
+		Count:      count,
+		NextCursor: wrapperspb.String("next"),
 	}
 	tags := make([]entities.Tag, 0, int(count))
 	type fields struct {
@@ -332,7 +335,7 @@ func TestTagServiceServer_List(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockTagUseCase.EXPECT().List(ctx, gomock.Any()).Return(tags, count, nil).Times(1)
+				mockTagUseCase.EXPECT().List(ctx, gomock.Any()).Return(entities.TagList{Items: tags, Count: pointer.Of(count), NextCursor: pointer.Of("next")}, nil).Times(1)
 			},
 			fields: fields{
 				UnimplementedTagServiceServer: examplepb.UnimplementedTagServiceServer{},
@@ -356,7 +359,7 @@ func TestTagServiceServer_List(t *testing.T) {
 				mockTagUseCase.
 					EXPECT().
 					List(ctx, gomock.Any()).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("i error")).
+					Return(entities.TagList{}, errs.NewUnexpectedBehaviorError("i error")).
 					Times(1)
 			},
 			fields: fields{
@@ -391,6 +394,94 @@ func TestTagServiceServer_List(t *testing.T) {
 	}
 }
 
+func TestTagServiceServer_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagUseCase := NewMocktagUseCase(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	suggestion := entities.NewMockTagSuggestion(t)
+	type fields struct {
+		UnimplementedTagServiceServer examplepb.UnimplementedTagServiceServer
+		tagUseCase                    tagUseCase
+		logger                        logger
+	}
+	type args struct {
+		ctx   context.Context
+		input *examplepb.TagSuggest
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    *examplepb.ListTagSuggestion
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockTagUseCase.EXPECT().
+					Suggest(ctx, entities.TagSuggest{Prefix: "postg", Limit: 5}).
+					Return([]entities.TagSuggestion{suggestion}, nil).
+					Times(1)
+			},
+			fields: fields{
+				UnimplementedTagServiceServer: examplepb.UnimplementedTagServiceServer{},
+				tagUseCase:                    mockTagUseCase,
+				logger:                        mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.TagSuggest{Prefix: "postg", Limit: wrapperspb.UInt64(5)},
+			},
+			want: &examplepb.ListTagSuggestion{
+				Items: []*examplepb.TagSuggestion{
+					{
+						Value:      suggestion.Value,
+						Similarity: suggestion.Similarity,
+						Count:      suggestion.Count,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "usecase error",
+			setup: func() {
+				mockTagUseCase.EXPECT().
+					Suggest(ctx, entities.TagSuggest{Prefix: "postg", Limit: 0}).
+					Return(nil, errs.NewUnexpectedBehaviorError("i error")).
+					Times(1)
+			},
+			fields: fields{
+				UnimplementedTagServiceServer: examplepb.UnimplementedTagServiceServer{},
+				tagUseCase:                    mockTagUseCase,
+				logger:                        mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				input: &examplepb.TagSuggest{Prefix: "postg"},
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("i error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := TagServiceServer{
+				UnimplementedTagServiceServer: tt.fields.UnimplementedTagServiceServer,
+				tagUseCase:                    tt.fields.tagUseCase,
+				logger:                        tt.fields.logger,
+			}
+			got, err := s.Suggest(tt.args.ctx, tt.args.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestTagServiceServer_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -516,6 +607,7 @@ func Test_encodeTagFilter(t *testing.T) {
 				input: &examplepb.TagFilter{
 					PageNumber: wrapperspb.UInt64(2),
 					PageSize:   wrapperspb.UInt64(5),
+					Search:     wrapperspb.String("my name is"),
 					OrderBy:    []string{"created_at", "id"},
 				},
 			},
@@ -523,6 +615,43 @@ func Test_encodeTagFilter(t *testing.T) {
 				PageSize:   pointer.Of(uint64(5)),
 				PageNumber: pointer.Of(uint64(2)),
 				OrderBy:    []entities.TagOrdering{"created_at", "id"},
+				Search:     pointer.Of("my name is"),
+			},
+		},
+		{
+			name: "with cursor",
+			args: args{
+				input: &examplepb.TagFilter{
+					PageSize:     wrapperspb.UInt64(5),
+					Cursor:       wrapperspb.String("cursor"),
+					IncludeCount: wrapperspb.Bool(false),
+				},
+			},
+			want: entities.TagFilter{
+				PageSize:     pointer.Of(uint64(5)),
+				OrderBy:      []entities.TagOrdering{},
+				Cursor:       pointer.Of("cursor"),
+				IncludeCount: pointer.Of(false),
+			},
+		},
+		{
+			name: "with fields",
+			args: args{
+				input: &examplepb.TagFilter{
+					Ids:           []string{"0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c"},
+					CreatedAfter:  timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					UpdatedBefore: timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+					PostIds:       []string{"0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d"},
+					Values:        []string{"go"},
+				},
+			},
+			want: entities.TagFilter{
+				OrderBy:       []entities.TagOrdering{},
+				IDs:           []uuid.UUID{uuid.MustParse("0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8c")},
+				CreatedAfter:  pointer.Of(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UpdatedBefore: pointer.Of(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+				PostIds:       []uuid.UUID{uuid.MustParse("0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d")},
+				Values:        []string{"go"},
 			},
 		},
 	}
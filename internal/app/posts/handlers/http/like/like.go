@@ -24,12 +24,14 @@ func NewLikeHandler(likeUseCase likeUseCase, logger logger) *LikeHandler {
 // @Summary Create like
 // @Tags like
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param form body LikeCreateDTO true "Create like request"
 // @Success 201 {object} LikeDTO "Created like"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/likes/ [POST]
@@ -63,12 +65,14 @@ func (h *LikeHandler) Create(w http.ResponseWriter, r *http.Request) {
 // @Summary Get like by id
 // @Tags like
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} LikeDTO "Requested like"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/likes/{id} [GET]
@@ -93,12 +97,14 @@ func (h *LikeHandler) Get(w http.ResponseWriter, r *http.Request) {
 // @Summary List of likes
 // @Tags like
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param filter query LikeFilterDTO true "Filter of likes"
 // @Success 200 {object} LikeListDTO "Filtered list of likes"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/likes/ [GET]
@@ -113,12 +119,12 @@ func (h *LikeHandler) List(w http.ResponseWriter, r *http.Request) {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	likes, count, err := h.likeUseCase.List(r.Context(), filter)
+	list, err := h.likeUseCase.List(r.Context(), filter)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	response, err := NewLikeListDto(likes, count)
+	response, err := NewLikeListDto(list)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
@@ -132,6 +138,7 @@ func (h *LikeHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Summary Update like
 // @Tags like
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
@@ -139,6 +146,7 @@ func (h *LikeHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} LikeDTO "Updated like"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/likes/{id} [PATCH]
@@ -167,17 +175,67 @@ func (h *LikeHandler) Update(w http.ResponseWriter, r *http.Request) {
 	render.JSON(w, r, response)
 }
 
+// Toggle - likes the post on behalf of the caller or, with "liked": false,
+// removes the like. Repeating the request changes nothing.
+//
+// @Summary Like or unlike post
+// @Tags like
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param post_id path string true "Post UUID"
+// @Param form body LikeToggleDTO true "Toggle like request"
+// @Success 200 {object} LikeDTO "Like of the caller"
+// @Success 204 "Post is not liked by the caller"
+// @Failure 400 {object} errs.Error "Invalid request body or validation error"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 404 {object} errs.Error "Not found"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/posts/posts/{post_id}/likes/me [PUT]
+func (h *LikeHandler) Toggle(w http.ResponseWriter, r *http.Request) {
+	toggleDTO, err := NewLikeToggleDTO(r)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	toggle, err := toggleDTO.toEntity()
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	like, err := h.likeUseCase.Toggle(r.Context(), toggle)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	if like.ID.IsEmpty() || like.DeletedAt != nil {
+		render.NoContent(w, r)
+		return
+	}
+	response, err := NewLikeDTO(like)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
+
 // Delete
 //
 // @Summary Delete like by id
 // @Tags like
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} LikeDTO "Updated like"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/likes/{id} [DELETE]
@@ -216,7 +274,15 @@ func (h *LikeHandler) router() chi.Router {
 	})
 	return router
 }
+
+// postRouter - likes of the caller nested under the post.
+func (h *LikeHandler) postRouter() chi.Router {
+	router := chi.NewRouter()
+	router.Put("/me", h.Toggle)
+	return router
+}
 func (h *LikeHandler) RegisterHTTP(httpServer *httpServer.Server) error {
 	httpServer.Mount("/api/v1/posts/likes", h.router())
+	httpServer.Mount("/api/v1/posts/posts/{post_id}/likes", h.postRouter())
 	return nil
 }
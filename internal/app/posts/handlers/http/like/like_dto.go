@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/render"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	httpServer "github.com/mikalai-mitsin/example/internal/pkg/http"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -38,13 +39,18 @@ func NewLikeDTO(entity entities.Like) (LikeDTO, error) {
 }
 
 type LikeListDTO struct {
-	Items []LikeDTO `json:"items"`
-	Count uint64    `json:"count"`
+	Items      []LikeDTO `json:"items"`
+	Count      *uint64   `json:"count,omitempty"`
+	NextCursor *string   `json:"next_cursor,omitempty"`
 }
 
-func NewLikeListDto(likes []entities.Like, count uint64) (LikeListDTO, error) {
-	response := LikeListDTO{Items: make([]LikeDTO, len(likes)), Count: count}
-	for i, like := range likes {
+func NewLikeListDto(list entities.LikeList) (LikeListDTO, error) {
+	response := LikeListDTO{
+		Items:      make([]LikeDTO, len(list.Items)),
+		Count:      list.Count,
+		NextCursor: list.NextCursor,
+	}
+	for i, like := range list.Items {
 		dto, err := NewLikeDTO(like)
 		if err != nil {
 			return LikeListDTO{}, err
@@ -55,14 +61,33 @@ func NewLikeListDto(likes []entities.Like, count uint64) (LikeListDTO, error) {
 }
 
 type LikeFilterDTO struct {
-	PageSize   *uint64  `json:"page_size"`
-	PageNumber *uint64  `json:"page_number"`
-	OrderBy    []string `json:"order_by"`
-	IsDeleted  *bool    `json:"is_deleted"`
+	PageSize      *uint64     `json:"page_size"`
+	PageNumber    *uint64     `json:"page_number"`
+	OrderBy       []string    `json:"order_by"`
+	IsDeleted     *bool       `json:"is_deleted"`
+	Search        *string     `json:"search"`
+	Cursor        *string     `json:"cursor"`
+	IncludeCount  *bool       `json:"include_count"`
+	IDs           []uuid.UUID `json:"id"`
+	CreatedAfter  *time.Time  `json:"created_after"`
+	CreatedBefore *time.Time  `json:"created_before"`
+	UpdatedAfter  *time.Time  `json:"updated_after"`
+	UpdatedBefore *time.Time  `json:"updated_before"`
+	PostIds       []uuid.UUID `json:"post_id"`
+	UserIds       []uuid.UUID `json:"user_id"`
+	Values        []string    `json:"value"`
 }
 
 func NewLikeFilterDTO(r *http.Request) (LikeFilterDTO, error) {
-	filter := LikeFilterDTO{PageSize: nil, PageNumber: nil, OrderBy: nil, IsDeleted: nil}
+	filter := LikeFilterDTO{
+		PageSize:     nil,
+		PageNumber:   nil,
+		OrderBy:      nil,
+		IsDeleted:    nil,
+		Search:       nil,
+		Cursor:       nil,
+		IncludeCount: nil,
+	}
 	if r.URL.Query().Has("page_size") {
 		pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size"))
 		if err != nil {
@@ -93,14 +118,63 @@ func NewLikeFilterDTO(r *http.Request) (LikeFilterDTO, error) {
 	if r.URL.Query().Has("order_by") {
 		filter.OrderBy = strings.Split(r.URL.Query().Get("order_by"), ",")
 	}
+	if r.URL.Query().Has("search") {
+		filter.Search = pointer.Of(r.URL.Query().Get("search"))
+	}
+	if r.URL.Query().Has("cursor") {
+		filter.Cursor = pointer.Of(r.URL.Query().Get("cursor"))
+	}
+	if r.URL.Query().Has("include_count") {
+		includeCount, err := strconv.ParseBool(r.URL.Query().Get("include_count"))
+		if err != nil {
+			return LikeFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("include_count", "Invalid include_count.").
+				WithCause(err)
+		}
+		filter.IncludeCount = pointer.Of(includeCount)
+	}
+	var err error
+	if filter.IDs, err = httpServer.ParseUUIDs(r, "id"); err != nil {
+		return LikeFilterDTO{}, err
+	}
+	if filter.CreatedAfter, err = httpServer.ParseTime(r, "created_after"); err != nil {
+		return LikeFilterDTO{}, err
+	}
+	if filter.CreatedBefore, err = httpServer.ParseTime(r, "created_before"); err != nil {
+		return LikeFilterDTO{}, err
+	}
+	if filter.UpdatedAfter, err = httpServer.ParseTime(r, "updated_after"); err != nil {
+		return LikeFilterDTO{}, err
+	}
+	if filter.UpdatedBefore, err = httpServer.ParseTime(r, "updated_before"); err != nil {
+		return LikeFilterDTO{}, err
+	}
+	if filter.PostIds, err = httpServer.ParseUUIDs(r, "post_id"); err != nil {
+		return LikeFilterDTO{}, err
+	}
+	if filter.UserIds, err = httpServer.ParseUUIDs(r, "user_id"); err != nil {
+		return LikeFilterDTO{}, err
+	}
+	filter.Values = r.URL.Query()["value"]
 	return filter, nil
 }
 func (dto LikeFilterDTO) toEntity() (entities.LikeFilter, error) {
 	filter := entities.LikeFilter{
-		PageSize:   dto.PageSize,
-		PageNumber: dto.PageNumber,
-		IsDeleted:  dto.IsDeleted,
-		OrderBy:    []entities.LikeOrdering{},
+		PageSize:      dto.PageSize,
+		PageNumber:    dto.PageNumber,
+		IsDeleted:     dto.IsDeleted,
+		OrderBy:       []entities.LikeOrdering{},
+		Search:        dto.Search,
+		Cursor:        dto.Cursor,
+		IncludeCount:  dto.IncludeCount,
+		IDs:           dto.IDs,
+		CreatedAfter:  dto.CreatedAfter,
+		CreatedBefore: dto.CreatedBefore,
+		UpdatedAfter:  dto.UpdatedAfter,
+		UpdatedBefore: dto.UpdatedBefore,
+		PostIds:       dto.PostIds,
+		UserIds:       dto.UserIds,
+		Values:        dto.Values,
 	}
 	for _, orderBy := range dto.OrderBy {
 		filter.OrderBy = append(filter.OrderBy, entities.LikeOrdering(orderBy))
@@ -109,10 +183,8 @@ func (dto LikeFilterDTO) toEntity() (entities.LikeFilter, error) {
 }
 
 type LikeUpdateDTO struct {
-	ID     uuid.UUID  `json:"id"`
-	PostId *uuid.UUID `json:"post_id"`
-	Value  *string    `json:"value"`
-	UserId *uuid.UUID `json:"user_id"`
+	ID    uuid.UUID `json:"id"`
+	Value *string   `json:"value"`
 }
 
 func NewLikeUpdateDTO(r *http.Request) (LikeUpdateDTO, error) {
@@ -125,10 +197,8 @@ func NewLikeUpdateDTO(r *http.Request) (LikeUpdateDTO, error) {
 }
 func (dto LikeUpdateDTO) toEntity() (entities.LikeUpdate, error) {
 	update := entities.LikeUpdate{
-		ID:     dto.ID,
-		PostId: dto.PostId,
-		Value:  dto.Value,
-		UserId: dto.UserId,
+		ID:    dto.ID,
+		Value: dto.Value,
 	}
 	return update, nil
 }
@@ -151,6 +221,30 @@ func (dto LikeCreateDTO) toEntity() (entities.LikeCreate, error) {
 	return create, nil
 }
 
+// LikeToggleDTO - the post is liked unless Liked is false.
+type LikeToggleDTO struct {
+	PostId uuid.UUID `json:"post_id"`
+	Liked  *bool     `json:"liked"`
+	Value  string    `json:"value"`
+}
+
+func NewLikeToggleDTO(r *http.Request) (LikeToggleDTO, error) {
+	toggle := LikeToggleDTO{}
+	if err := render.DecodeJSON(r.Body, &toggle); err != nil {
+		return LikeToggleDTO{}, err
+	}
+	toggle.PostId = uuid.MustParse(chi.URLParam(r, "post_id"))
+	return toggle, nil
+}
+func (dto LikeToggleDTO) toEntity() (entities.LikeToggle, error) {
+	toggle := entities.LikeToggle{
+		PostId: dto.PostId,
+		Liked:  dto.Liked == nil || *dto.Liked,
+		Value:  dto.Value,
+	}
+	return toggle, nil
+}
+
 type LikeDeleteDTO struct {
 	ID uuid.UUID `json:"id"`
 }
@@ -12,8 +12,9 @@ import (
 type likeUseCase interface {
 	Create(context.Context, entities.LikeCreate) (entities.Like, error)
 	Get(context.Context, uuid.UUID) (entities.Like, error)
-	List(context.Context, entities.LikeFilter) ([]entities.Like, uint64, error)
+	List(context.Context, entities.LikeFilter) (entities.LikeList, error)
 	Update(context.Context, entities.LikeUpdate) (entities.Like, error)
+	Toggle(context.Context, entities.LikeToggle) (entities.Like, error)
 	Delete(context.Context, entities.LikeDelete) (entities.Like, error)
 }
 type logger interface {
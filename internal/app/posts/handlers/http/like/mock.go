@@ -91,13 +91,12 @@ func (mr *MocklikeUseCaseMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MocklikeUseCase) List(arg0 context.Context, arg1 like.LikeFilter) ([]like.Like, uint64, error) {
+func (m *MocklikeUseCase) List(arg0 context.Context, arg1 like.LikeFilter) (like.LikeList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]like.Like)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(like.LikeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -106,6 +105,21 @@ func (mr *MocklikeUseCaseMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocklikeUseCase)(nil).List), arg0, arg1)
 }
 
+// Toggle mocks base method.
+func (m *MocklikeUseCase) Toggle(arg0 context.Context, arg1 like.LikeToggle) (like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Toggle", arg0, arg1)
+	ret0, _ := ret[0].(like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Toggle indicates an expected call of Toggle.
+func (mr *MocklikeUseCaseMockRecorder) Toggle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Toggle", reflect.TypeOf((*MocklikeUseCase)(nil).Toggle), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MocklikeUseCase) Update(arg0 context.Context, arg1 like.LikeUpdate) (like.Like, error) {
 	m.ctrl.T.Helper()
@@ -24,12 +24,14 @@ func NewPostHandler(postUseCase postUseCase, logger logger) *PostHandler {
 // @Summary Create post
 // @Tags post
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param form body PostCreateDTO true "Create post request"
 // @Success 201 {object} PostDTO "Created post"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/posts/ [POST]
@@ -63,12 +65,14 @@ func (h *PostHandler) Create(w http.ResponseWriter, r *http.Request) {
 // @Summary Get post by id
 // @Tags post
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} PostDTO "Requested post"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/posts/{id} [GET]
@@ -93,12 +97,14 @@ func (h *PostHandler) Get(w http.ResponseWriter, r *http.Request) {
 // @Summary List of posts
 // @Tags post
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param filter query PostFilterDTO true "Filter of posts"
 // @Success 200 {object} PostListDTO "Filtered list of posts"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/posts/ [GET]
@@ -113,12 +119,12 @@ func (h *PostHandler) List(w http.ResponseWriter, r *http.Request) {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	posts, count, err := h.postUseCase.List(r.Context(), filter)
+	list, err := h.postUseCase.List(r.Context(), filter)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	response, err := NewPostListDto(posts, count)
+	response, err := NewPostListDto(list)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
@@ -132,6 +138,7 @@ func (h *PostHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Summary Update post
 // @Tags post
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
@@ -139,6 +146,7 @@ func (h *PostHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} PostDTO "Updated post"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/posts/{id} [PATCH]
@@ -172,12 +180,14 @@ func (h *PostHandler) Update(w http.ResponseWriter, r *http.Request) {
 // @Summary Delete post by id
 // @Tags post
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} PostDTO "Updated post"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/posts/{id} [DELETE]
@@ -205,6 +215,47 @@ func (h *PostHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, response)
 }
+
+// Restore
+//
+// @Summary Restore deleted post by id
+// @Tags post
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "UUID"
+// @Success 200 {object} PostDTO "Restored post"
+// @Failure 400 {object} errs.Error "Invalid request or post is not deleted"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 404 {object} errs.Error "Not found"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/posts/posts/{id}/restore [POST]
+func (h *PostHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	restoreDTO, err := NewPostRestoreDTO(r)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	restore, err := restoreDTO.toEntity()
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	post, err := h.postUseCase.Restore(r.Context(), restore)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	response, err := NewPostDTO(post)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
 func (h *PostHandler) router() chi.Router {
 	router := chi.NewRouter()
 	router.Route("/", func(g chi.Router) {
@@ -213,6 +264,7 @@ func (h *PostHandler) router() chi.Router {
 		g.Get("/{id}", h.Get)
 		g.Patch("/{id}", h.Update)
 		g.Delete("/{id}", h.Delete)
+		g.Post("/{id}/restore", h.Restore)
 	})
 	return router
 }
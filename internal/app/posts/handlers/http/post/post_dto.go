@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/render"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	httpServer "github.com/mikalai-mitsin/example/internal/pkg/http"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -20,6 +21,7 @@ type PostDTO struct {
 	CreatedAt time.Time  `json:"created_at"`
 	DeletedAt *time.Time `json:"deleted_at"`
 	Body      string     `json:"body"`
+	AuthorId  string     `json:"author_id"`
 }
 
 func NewPostDTO(entity entities.Post) (PostDTO, error) {
@@ -29,18 +31,24 @@ func NewPostDTO(entity entities.Post) (PostDTO, error) {
 		UpdatedAt: entity.UpdatedAt,
 		DeletedAt: entity.DeletedAt,
 		Body:      entity.Body,
+		AuthorId:  entity.AuthorId,
 	}
 	return dto, nil
 }
 
 type PostListDTO struct {
-	Items []PostDTO `json:"items"`
-	Count uint64    `json:"count"`
+	Items      []PostDTO `json:"items"`
+	Count      *uint64   `json:"count,omitempty"`
+	NextCursor *string   `json:"next_cursor,omitempty"`
 }
 
-func NewPostListDto(posts []entities.Post, count uint64) (PostListDTO, error) {
-	response := PostListDTO{Items: make([]PostDTO, len(posts)), Count: count}
-	for i, post := range posts {
+func NewPostListDto(list entities.PostList) (PostListDTO, error) {
+	response := PostListDTO{
+		Items:      make([]PostDTO, len(list.Items)),
+		Count:      list.Count,
+		NextCursor: list.NextCursor,
+	}
+	for i, post := range list.Items {
 		dto, err := NewPostDTO(post)
 		if err != nil {
 			return PostListDTO{}, err
@@ -51,14 +59,31 @@ func NewPostListDto(posts []entities.Post, count uint64) (PostListDTO, error) {
 }
 
 type PostFilterDTO struct {
-	PageSize   *uint64  `json:"page_size"`
-	PageNumber *uint64  `json:"page_number"`
-	OrderBy    []string `json:"order_by"`
-	IsDeleted  *bool    `json:"is_deleted"`
+	PageSize      *uint64     `json:"page_size"`
+	PageNumber    *uint64     `json:"page_number"`
+	OrderBy       []string    `json:"order_by"`
+	IsDeleted     *bool       `json:"is_deleted"`
+	Search        *string     `json:"search"`
+	Cursor        *string     `json:"cursor"`
+	IncludeCount  *bool       `json:"include_count"`
+	IDs           []uuid.UUID `json:"id"`
+	CreatedAfter  *time.Time  `json:"created_after"`
+	CreatedBefore *time.Time  `json:"created_before"`
+	UpdatedAfter  *time.Time  `json:"updated_after"`
+	UpdatedBefore *time.Time  `json:"updated_before"`
+	AuthorIds     []string    `json:"author_id"`
 }
 
 func NewPostFilterDTO(r *http.Request) (PostFilterDTO, error) {
-	filter := PostFilterDTO{PageSize: nil, PageNumber: nil, OrderBy: nil, IsDeleted: nil}
+	filter := PostFilterDTO{
+		PageSize:     nil,
+		PageNumber:   nil,
+		OrderBy:      nil,
+		IsDeleted:    nil,
+		Search:       nil,
+		Cursor:       nil,
+		IncludeCount: nil,
+	}
 	if r.URL.Query().Has("page_size") {
 		pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size"))
 		if err != nil {
@@ -89,14 +114,55 @@ func NewPostFilterDTO(r *http.Request) (PostFilterDTO, error) {
 	if r.URL.Query().Has("order_by") {
 		filter.OrderBy = strings.Split(r.URL.Query().Get("order_by"), ",")
 	}
+	if r.URL.Query().Has("search") {
+		filter.Search = pointer.Of(r.URL.Query().Get("search"))
+	}
+	if r.URL.Query().Has("cursor") {
+		filter.Cursor = pointer.Of(r.URL.Query().Get("cursor"))
+	}
+	if r.URL.Query().Has("include_count") {
+		includeCount, err := strconv.ParseBool(r.URL.Query().Get("include_count"))
+		if err != nil {
+			return PostFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("include_count", "Invalid include_count.").
+				WithCause(err)
+		}
+		filter.IncludeCount = pointer.Of(includeCount)
+	}
+	var err error
+	if filter.IDs, err = httpServer.ParseUUIDs(r, "id"); err != nil {
+		return PostFilterDTO{}, err
+	}
+	if filter.CreatedAfter, err = httpServer.ParseTime(r, "created_after"); err != nil {
+		return PostFilterDTO{}, err
+	}
+	if filter.CreatedBefore, err = httpServer.ParseTime(r, "created_before"); err != nil {
+		return PostFilterDTO{}, err
+	}
+	if filter.UpdatedAfter, err = httpServer.ParseTime(r, "updated_after"); err != nil {
+		return PostFilterDTO{}, err
+	}
+	if filter.UpdatedBefore, err = httpServer.ParseTime(r, "updated_before"); err != nil {
+		return PostFilterDTO{}, err
+	}
+	filter.AuthorIds = r.URL.Query()["author_id"]
 	return filter, nil
 }
 func (dto PostFilterDTO) toEntity() (entities.PostFilter, error) {
 	filter := entities.PostFilter{
-		PageSize:   dto.PageSize,
-		PageNumber: dto.PageNumber,
-		IsDeleted:  dto.IsDeleted,
-		OrderBy:    []entities.PostOrdering{},
+		PageSize:      dto.PageSize,
+		PageNumber:    dto.PageNumber,
+		IsDeleted:     dto.IsDeleted,
+		OrderBy:       []entities.PostOrdering{},
+		Search:        dto.Search,
+		Cursor:        dto.Cursor,
+		IncludeCount:  dto.IncludeCount,
+		IDs:           dto.IDs,
+		CreatedAfter:  dto.CreatedAfter,
+		CreatedBefore: dto.CreatedBefore,
+		UpdatedAfter:  dto.UpdatedAfter,
+		UpdatedBefore: dto.UpdatedBefore,
+		AuthorIds:     dto.AuthorIds,
 	}
 	for _, orderBy := range dto.OrderBy {
 		filter.OrderBy = append(filter.OrderBy, entities.PostOrdering(orderBy))
@@ -154,3 +220,16 @@ func (dto PostDeleteDTO) toEntity() (entities.PostDelete, error) {
 	del := entities.PostDelete{ID: dto.ID}
 	return del, nil
 }
+
+type PostRestoreDTO struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func NewPostRestoreDTO(r *http.Request) (PostRestoreDTO, error) {
+	restore := PostRestoreDTO{ID: uuid.MustParse(chi.URLParam(r, "id"))}
+	return restore, nil
+}
+func (dto PostRestoreDTO) toEntity() (entities.PostRestore, error) {
+	restore := entities.PostRestore{ID: dto.ID}
+	return restore, nil
+}
@@ -24,12 +24,14 @@ func NewTagHandler(tagUseCase tagUseCase, logger logger) *TagHandler {
 // @Summary Create tag
 // @Tags tag
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param form body TagCreateDTO true "Create tag request"
 // @Success 201 {object} TagDTO "Created tag"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/tags/ [POST]
@@ -63,12 +65,14 @@ func (h *TagHandler) Create(w http.ResponseWriter, r *http.Request) {
 // @Summary Get tag by id
 // @Tags tag
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} TagDTO "Requested tag"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/tags/{id} [GET]
@@ -93,12 +97,14 @@ func (h *TagHandler) Get(w http.ResponseWriter, r *http.Request) {
 // @Summary List of tags
 // @Tags tag
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param filter query TagFilterDTO true "Filter of tags"
 // @Success 200 {object} TagListDTO "Filtered list of tags"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/tags/ [GET]
@@ -113,12 +119,53 @@ func (h *TagHandler) List(w http.ResponseWriter, r *http.Request) {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	tags, count, err := h.tagUseCase.List(r.Context(), filter)
+	list, err := h.tagUseCase.List(r.Context(), filter)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
 	}
-	response, err := NewTagListDto(tags, count)
+	response, err := NewTagListDto(list)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
+
+// Suggest
+//
+// @Summary Suggest tag values
+// @Tags tag
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param suggest query TagSuggestDTO true "Typed prefix of the tag"
+// @Success 200 {object} TagSuggestionListDTO "Tag values, the most similar and used first"
+// @Failure 400 {object} errs.Error "Invalid request body or validation error"
+// @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
+// @Failure 404 {object} errs.Error "Not found"
+// @Failure 500 {object} errs.Error "Internal server error"
+// @Router /api/v1/posts/tags/suggest [GET]
+func (h *TagHandler) Suggest(w http.ResponseWriter, r *http.Request) {
+	suggestDTO, err := NewTagSuggestDTO(r)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	suggest, err := suggestDTO.toEntity()
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	suggestions, err := h.tagUseCase.Suggest(r.Context(), suggest)
+	if err != nil {
+		errs.RenderToHTTPResponse(err, w, r)
+		return
+	}
+	response, err := NewTagSuggestionListDTO(suggestions)
 	if err != nil {
 		errs.RenderToHTTPResponse(err, w, r)
 		return
@@ -132,6 +179,7 @@ func (h *TagHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Summary Update tag
 // @Tags tag
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
@@ -139,6 +187,7 @@ func (h *TagHandler) List(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} TagDTO "Updated tag"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/tags/{id} [PATCH]
@@ -172,12 +221,14 @@ func (h *TagHandler) Update(w http.ResponseWriter, r *http.Request) {
 // @Summary Delete tag by id
 // @Tags tag
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Accept json
 // @Produce json
 // @Param id path string true "UUID"
 // @Success 200 {object} TagDTO "Updated tag"
 // @Failure 400 {object} errs.Error "Invalid request body or validation error"
 // @Failure 401 {object} errs.Error "Unauthorized"
+// @Failure 403 {object} errs.Error "Permission denied"
 // @Failure 404 {object} errs.Error "Not found"
 // @Failure 500 {object} errs.Error "Internal server error"
 // @Router /api/v1/posts/tags/{id} [DELETE]
@@ -210,6 +261,7 @@ func (h *TagHandler) router() chi.Router {
 	router.Route("/", func(g chi.Router) {
 		g.Post("/", h.Create)
 		g.Get("/", h.List)
+		g.Get("/suggest", h.Suggest)
 		g.Get("/{id}", h.Get)
 		g.Patch("/{id}", h.Update)
 		g.Delete("/{id}", h.Delete)
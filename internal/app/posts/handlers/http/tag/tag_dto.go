@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/render"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	httpServer "github.com/mikalai-mitsin/example/internal/pkg/http"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -36,13 +37,18 @@ func NewTagDTO(entity entities.Tag) (TagDTO, error) {
 }
 
 type TagListDTO struct {
-	Items []TagDTO `json:"items"`
-	Count uint64   `json:"count"`
+	Items      []TagDTO `json:"items"`
+	Count      *uint64  `json:"count,omitempty"`
+	NextCursor *string  `json:"next_cursor,omitempty"`
 }
 
-func NewTagListDto(tags []entities.Tag, count uint64) (TagListDTO, error) {
-	response := TagListDTO{Items: make([]TagDTO, len(tags)), Count: count}
-	for i, tag := range tags {
+func NewTagListDto(list entities.TagList) (TagListDTO, error) {
+	response := TagListDTO{
+		Items:      make([]TagDTO, len(list.Items)),
+		Count:      list.Count,
+		NextCursor: list.NextCursor,
+	}
+	for i, tag := range list.Items {
 		dto, err := NewTagDTO(tag)
 		if err != nil {
 			return TagListDTO{}, err
@@ -53,14 +59,32 @@ func NewTagListDto(tags []entities.Tag, count uint64) (TagListDTO, error) {
 }
 
 type TagFilterDTO struct {
-	PageSize   *uint64  `json:"page_size"`
-	PageNumber *uint64  `json:"page_number"`
-	OrderBy    []string `json:"order_by"`
-	IsDeleted  *bool    `json:"is_deleted"`
+	PageSize      *uint64     `json:"page_size"`
+	PageNumber    *uint64     `json:"page_number"`
+	OrderBy       []string    `json:"order_by"`
+	IsDeleted     *bool       `json:"is_deleted"`
+	Search        *string     `json:"search"`
+	Cursor        *string     `json:"cursor"`
+	IncludeCount  *bool       `json:"include_count"`
+	IDs           []uuid.UUID `json:"id"`
+	CreatedAfter  *time.Time  `json:"created_after"`
+	CreatedBefore *time.Time  `json:"created_before"`
+	UpdatedAfter  *time.Time  `json:"updated_after"`
+	UpdatedBefore *time.Time  `json:"updated_before"`
+	PostIds       []uuid.UUID `json:"post_id"`
+	Values        []string    `json:"value"`
 }
 
 func NewTagFilterDTO(r *http.Request) (TagFilterDTO, error) {
-	filter := TagFilterDTO{PageSize: nil, PageNumber: nil, OrderBy: nil, IsDeleted: nil}
+	filter := TagFilterDTO{
+		PageSize:     nil,
+		PageNumber:   nil,
+		OrderBy:      nil,
+		IsDeleted:    nil,
+		Search:       nil,
+		Cursor:       nil,
+		IncludeCount: nil,
+	}
 	if r.URL.Query().Has("page_size") {
 		pageSize, err := strconv.Atoi(r.URL.Query().Get("page_size"))
 		if err != nil {
@@ -91,14 +115,59 @@ func NewTagFilterDTO(r *http.Request) (TagFilterDTO, error) {
 	if r.URL.Query().Has("order_by") {
 		filter.OrderBy = strings.Split(r.URL.Query().Get("order_by"), ",")
 	}
+	if r.URL.Query().Has("search") {
+		filter.Search = pointer.Of(r.URL.Query().Get("search"))
+	}
+	if r.URL.Query().Has("cursor") {
+		filter.Cursor = pointer.Of(r.URL.Query().Get("cursor"))
+	}
+	if r.URL.Query().Has("include_count") {
+		includeCount, err := strconv.ParseBool(r.URL.Query().Get("include_count"))
+		if err != nil {
+			return TagFilterDTO{}, errs.NewInvalidFormError().
+				WithParam("include_count", "Invalid include_count.").
+				WithCause(err)
+		}
+		filter.IncludeCount = pointer.Of(includeCount)
+	}
+	var err error
+	if filter.IDs, err = httpServer.ParseUUIDs(r, "id"); err != nil {
+		return TagFilterDTO{}, err
+	}
+	if filter.CreatedAfter, err = httpServer.ParseTime(r, "created_after"); err != nil {
+		return TagFilterDTO{}, err
+	}
+	if filter.CreatedBefore, err = httpServer.ParseTime(r, "created_before"); err != nil {
+		return TagFilterDTO{}, err
+	}
+	if filter.UpdatedAfter, err = httpServer.ParseTime(r, "updated_after"); err != nil {
+		return TagFilterDTO{}, err
+	}
+	if filter.UpdatedBefore, err = httpServer.ParseTime(r, "updated_before"); err != nil {
+		return TagFilterDTO{}, err
+	}
+	if filter.PostIds, err = httpServer.ParseUUIDs(r, "post_id"); err != nil {
+		return TagFilterDTO{}, err
+	}
+	filter.Values = r.URL.Query()["value"]
 	return filter, nil
 }
 func (dto TagFilterDTO) toEntity() (entities.TagFilter, error) {
 	filter := entities.TagFilter{
-		PageSize:   dto.PageSize,
-		PageNumber: dto.PageNumber,
-		IsDeleted:  dto.IsDeleted,
-		OrderBy:    []entities.TagOrdering{},
+		PageSize:      dto.PageSize,
+		PageNumber:    dto.PageNumber,
+		IsDeleted:     dto.IsDeleted,
+		OrderBy:       []entities.TagOrdering{},
+		Search:        dto.Search,
+		Cursor:        dto.Cursor,
+		IncludeCount:  dto.IncludeCount,
+		IDs:           dto.IDs,
+		CreatedAfter:  dto.CreatedAfter,
+		CreatedBefore: dto.CreatedBefore,
+		UpdatedAfter:  dto.UpdatedAfter,
+		UpdatedBefore: dto.UpdatedBefore,
+		PostIds:       dto.PostIds,
+		Values:        dto.Values,
 	}
 	for _, orderBy := range dto.OrderBy {
 		filter.OrderBy = append(filter.OrderBy, entities.TagOrdering(orderBy))
@@ -158,3 +227,48 @@ func (dto TagDeleteDTO) toEntity() (entities.TagDelete, error) {
 	del := entities.TagDelete{ID: dto.ID}
 	return del, nil
 }
+
+type TagSuggestDTO struct {
+	Prefix string `json:"prefix"`
+	Limit  uint64 `json:"limit"`
+}
+
+func NewTagSuggestDTO(r *http.Request) (TagSuggestDTO, error) {
+	suggest := TagSuggestDTO{Prefix: r.URL.Query().Get("prefix"), Limit: 0}
+	if r.URL.Query().Has("limit") {
+		limit, err := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+		if err != nil {
+			return TagSuggestDTO{}, errs.NewInvalidFormError().
+				WithParam("limit", "Invalid limit.").
+				WithCause(err)
+		}
+		suggest.Limit = limit
+	}
+	return suggest, nil
+}
+func (dto TagSuggestDTO) toEntity() (entities.TagSuggest, error) {
+	suggest := entities.TagSuggest{Prefix: dto.Prefix, Limit: dto.Limit}
+	return suggest, nil
+}
+
+type TagSuggestionDTO struct {
+	Value      string  `json:"value"`
+	Similarity float64 `json:"similarity"`
+	Count      uint64  `json:"count"`
+}
+
+type TagSuggestionListDTO struct {
+	Items []TagSuggestionDTO `json:"items"`
+}
+
+func NewTagSuggestionListDTO(suggestions []entities.TagSuggestion) (TagSuggestionListDTO, error) {
+	response := TagSuggestionListDTO{Items: make([]TagSuggestionDTO, len(suggestions))}
+	for i, suggestion := range suggestions {
+		response.Items[i] = TagSuggestionDTO{
+			Value:      suggestion.Value,
+			Similarity: suggestion.Similarity,
+			Count:      suggestion.Count,
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func encodeLikeCreate(input *examplepb.LikeCreate) (entities.LikeCreate, error) {
+	postId, err := kafka.ParseCommandUUID("post_id", input.GetPostId())
+	if err != nil {
+		return entities.LikeCreate{}, err
+	}
+	userId, err := kafka.ParseCommandUUID("user_id", input.GetUserId())
+	if err != nil {
+		return entities.LikeCreate{}, err
+	}
+	create := entities.LikeCreate{PostId: postId, Value: input.GetValue(), UserId: userId}
+	return create, nil
+}
+func encodeLikeUpdate(input *examplepb.LikeUpdate) (entities.LikeUpdate, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.LikeUpdate{}, err
+	}
+	update := entities.LikeUpdate{ID: id}
+	if input.GetValue() != nil {
+		update.Value = pointer.Of(string(input.GetValue().GetValue()))
+	}
+	return update, nil
+}
+func encodeLikeDelete(input *examplepb.LikeDelete) (entities.LikeDelete, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.LikeDelete{}, err
+	}
+	del := entities.LikeDelete{ID: id}
+	return del, nil
+}
+func decodeLike(like entities.Like) *examplepb.Like {
+	response := &examplepb.Like{
+		Id:        like.ID.String(),
+		CreatedAt: timestamppb.New(like.CreatedAt),
+		UpdatedAt: timestamppb.New(like.UpdatedAt),
+		DeletedAt: nil,
+		PostId:    like.PostId.String(),
+		Value:     like.Value,
+		UserId:    like.UserId.String(),
+	}
+	if like.DeletedAt != nil {
+		response.DeletedAt = timestamppb.New(*like.DeletedAt)
+	}
+	return response
+}
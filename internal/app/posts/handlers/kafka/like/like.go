@@ -4,36 +4,109 @@ import (
 	"context"
 
 	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	topicName = "example.posts.like.v1"
-	groupID   = "example.posts.like"
+	topicName        = "example.posts.like.commands.v1"
+	resultsTopicName = "example.posts.like.results.v1"
+	groupID          = "example.posts.like"
 )
 
 type LikeHandler struct {
 	likeUseCase likeUseCase
-	logger      logger
+	commands    *kafka.CommandHandler
 }
 
-func NewLikeHandler(likeUseCase likeUseCase, logger logger) *LikeHandler {
-	return &LikeHandler{likeUseCase: likeUseCase, logger: logger}
+func NewLikeHandler(
+	likeUseCase likeUseCase,
+	authenticator authenticator,
+	dtxManager dtxManager,
+	outbox outbox,
+	clock clock,
+	logger logger,
+) *LikeHandler {
+	return &LikeHandler{
+		likeUseCase: likeUseCase,
+		commands: kafka.NewCommandHandler(
+			resultsTopicName,
+			authenticator,
+			dtxManager,
+			outbox,
+			clock,
+			logger,
+		),
+	}
 }
+
+// Handle - executes a command and publishes its result for the caller.
 func (h *LikeHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
-	logger := h.logger.WithContext(ctx)
-	logger.Info(
-		"received message",
-		log.String("topic", msg.Topic),
-		log.Int32("partition", msg.Partition),
-		log.Int64("offset", msg.Offset),
-		log.String("key", string(msg.Key)),
-		log.String("value", string(msg.Value)),
-	)
-	return nil
+	return h.commands.Handle(ctx, msg, h.execute)
+}
+
+func (h *LikeHandler) execute(ctx context.Context, command *examplepb.Command) (proto.Message, error) {
+	switch {
+	case command.GetPayload().MessageIs(&examplepb.LikeCreate{}):
+		input := &examplepb.LikeCreate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		create, err := encodeLikeCreate(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := create.Validate(); err != nil {
+			return nil, err
+		}
+		like, err := h.likeUseCase.Create(ctx, create)
+		if err != nil {
+			return nil, err
+		}
+		return decodeLike(like), nil
+	case command.GetPayload().MessageIs(&examplepb.LikeUpdate{}):
+		input := &examplepb.LikeUpdate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		update, err := encodeLikeUpdate(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := update.Validate(); err != nil {
+			return nil, err
+		}
+		like, err := h.likeUseCase.Update(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+		return decodeLike(like), nil
+	case command.GetPayload().MessageIs(&examplepb.LikeDelete{}):
+		input := &examplepb.LikeDelete{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		del, err := encodeLikeDelete(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := del.Validate(); err != nil {
+			return nil, err
+		}
+		like, err := h.likeUseCase.Delete(ctx, del)
+		if err != nil {
+			return nil, err
+		}
+		return decodeLike(like), nil
+	default:
+		return nil, errs.NewInvalidFormError().WithParam("payload", "Unknown command.")
+	}
 }
 func (h *LikeHandler) RegisterKafka(consumer *kafka.Consumer) error {
-	consumer.AddHandler(kafka.NewHandler(topicName, groupID, h.Handle))
+	consumer.AddHandler(
+		kafka.NewHandler(topicName, groupID, h.Handle).WithMessageID(kafka.CommandID),
+	)
 	return nil
 }
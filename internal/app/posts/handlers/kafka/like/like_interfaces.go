@@ -3,8 +3,11 @@ package handlers
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -12,10 +15,25 @@ import (
 type likeUseCase interface {
 	Create(context.Context, entities.LikeCreate) (entities.Like, error)
 	Get(context.Context, uuid.UUID) (entities.Like, error)
-	List(context.Context, entities.LikeFilter) ([]entities.Like, uint64, error)
+	List(context.Context, entities.LikeFilter) (entities.LikeList, error)
 	Update(context.Context, entities.LikeUpdate) (entities.Like, error)
 	Delete(context.Context, entities.LikeDelete) (entities.Like, error)
 }
+type authenticator interface {
+	Enabled() bool
+	AuthenticateHeader(ctx context.Context, header string) (context.Context, error)
+}
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type outbox interface {
+	Send(ctx context.Context, msg *kafka.Message) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
 type logger interface {
 	log.Logger
 }
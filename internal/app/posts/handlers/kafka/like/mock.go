@@ -11,9 +11,12 @@ package handlers
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	like "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
+	kafka "github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -91,13 +94,12 @@ func (mr *MocklikeUseCaseMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MocklikeUseCase) List(arg0 context.Context, arg1 like.LikeFilter) ([]like.Like, uint64, error) {
+func (m *MocklikeUseCase) List(arg0 context.Context, arg1 like.LikeFilter) (like.LikeList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]like.Like)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(like.LikeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -121,6 +123,173 @@ func (mr *MocklikeUseCaseMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocklikeUseCase)(nil).Update), arg0, arg1)
 }
 
+// Mockauthenticator is a mock of authenticator interface.
+type Mockauthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthenticatorMockRecorder
+	isgomock struct{}
+}
+
+// MockauthenticatorMockRecorder is the mock recorder for Mockauthenticator.
+type MockauthenticatorMockRecorder struct {
+	mock *Mockauthenticator
+}
+
+// NewMockauthenticator creates a new mock instance.
+func NewMockauthenticator(ctrl *gomock.Controller) *Mockauthenticator {
+	mock := &Mockauthenticator{ctrl: ctrl}
+	mock.recorder = &MockauthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockauthenticator) EXPECT() *MockauthenticatorMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateHeader mocks base method.
+func (m *Mockauthenticator) AuthenticateHeader(ctx context.Context, header string) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateHeader", ctx, header)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateHeader indicates an expected call of AuthenticateHeader.
+func (mr *MockauthenticatorMockRecorder) AuthenticateHeader(ctx, header any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateHeader", reflect.TypeOf((*Mockauthenticator)(nil).AuthenticateHeader), ctx, header)
+}
+
+// Enabled mocks base method.
+func (m *Mockauthenticator) Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockauthenticatorMockRecorder) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*Mockauthenticator)(nil).Enabled))
+}
+
+// MockdtxManager is a mock of dtxManager interface.
+type MockdtxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockdtxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockdtxManagerMockRecorder is the mock recorder for MockdtxManager.
+type MockdtxManagerMockRecorder struct {
+	mock *MockdtxManager
+}
+
+// NewMockdtxManager creates a new mock instance.
+func NewMockdtxManager(ctrl *gomock.Controller) *MockdtxManager {
+	mock := &MockdtxManager{ctrl: ctrl}
+	mock.recorder = &MockdtxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdtxManager) EXPECT() *MockdtxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *MockdtxManager) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, opts, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockdtxManagerMockRecorder) RunInTx(ctx, opts, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockdtxManager)(nil).RunInTx), ctx, opts, fn)
+}
+
+// Mockoutbox is a mock of outbox interface.
+type Mockoutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxMockRecorder is the mock recorder for Mockoutbox.
+type MockoutboxMockRecorder struct {
+	mock *Mockoutbox
+}
+
+// NewMockoutbox creates a new mock instance.
+func NewMockoutbox(ctrl *gomock.Controller) *Mockoutbox {
+	mock := &Mockoutbox{ctrl: ctrl}
+	mock.recorder = &MockoutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockoutbox) EXPECT() *MockoutboxMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mockoutbox) Send(ctx context.Context, msg *kafka.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockoutboxMockRecorder) Send(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mockoutbox)(nil).Send), ctx, msg)
+}
+
+// Mockclock is a mock of clock interface.
+type Mockclock struct {
+	ctrl     *gomock.Controller
+	recorder *MockclockMockRecorder
+	isgomock struct{}
+}
+
+// MockclockMockRecorder is the mock recorder for Mockclock.
+type MockclockMockRecorder struct {
+	mock *Mockclock
+}
+
+// NewMockclock creates a new mock instance.
+func NewMockclock(ctrl *gomock.Controller) *Mockclock {
+	mock := &Mockclock{ctrl: ctrl}
+	mock.recorder = &MockclockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclock) EXPECT() *MockclockMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *Mockclock) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockclockMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*Mockclock)(nil).Now))
+}
+
 // Mocklogger is a mock of logger interface.
 type Mocklogger struct {
 	ctrl     *gomock.Controller
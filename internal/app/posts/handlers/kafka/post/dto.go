@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func encodePostCreate(input *examplepb.PostCreate) entities.PostCreate {
+	create := entities.PostCreate{Body: input.GetBody()}
+	return create
+}
+func encodePostUpdate(input *examplepb.PostUpdate) (entities.PostUpdate, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.PostUpdate{}, err
+	}
+	update := entities.PostUpdate{ID: id}
+	if input.GetBody() != nil {
+		update.Body = pointer.Of(string(input.GetBody().GetValue()))
+	}
+	return update, nil
+}
+func encodePostDelete(input *examplepb.PostDelete) (entities.PostDelete, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.PostDelete{}, err
+	}
+	del := entities.PostDelete{ID: id}
+	return del, nil
+}
+func encodePostRestore(input *examplepb.PostRestore) (entities.PostRestore, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.PostRestore{}, err
+	}
+	restore := entities.PostRestore{ID: id}
+	return restore, nil
+}
+func decodePost(post entities.Post) *examplepb.Post {
+	response := &examplepb.Post{
+		Id:        post.ID.String(),
+		CreatedAt: timestamppb.New(post.CreatedAt),
+		UpdatedAt: timestamppb.New(post.UpdatedAt),
+		DeletedAt: nil,
+		Body:      post.Body,
+		AuthorId:  post.AuthorId,
+	}
+	if post.DeletedAt != nil {
+		response.DeletedAt = timestamppb.New(*post.DeletedAt)
+	}
+	return response
+}
@@ -4,36 +4,123 @@ import (
 	"context"
 
 	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	topicName = "example.posts.post.v1"
-	groupID   = "example.posts.post"
+	topicName        = "example.posts.post.commands.v1"
+	resultsTopicName = "example.posts.post.results.v1"
+	groupID          = "example.posts.post"
 )
 
 type PostHandler struct {
 	postUseCase postUseCase
-	logger      logger
+	commands    *kafka.CommandHandler
 }
 
-func NewPostHandler(postUseCase postUseCase, logger logger) *PostHandler {
-	return &PostHandler{postUseCase: postUseCase, logger: logger}
+func NewPostHandler(
+	postUseCase postUseCase,
+	authenticator authenticator,
+	dtxManager dtxManager,
+	outbox outbox,
+	clock clock,
+	logger logger,
+) *PostHandler {
+	return &PostHandler{
+		postUseCase: postUseCase,
+		commands: kafka.NewCommandHandler(
+			resultsTopicName,
+			authenticator,
+			dtxManager,
+			outbox,
+			clock,
+			logger,
+		),
+	}
 }
+
+// Handle - executes a command and publishes its result for the caller.
 func (h *PostHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
-	logger := h.logger.WithContext(ctx)
-	logger.Info(
-		"received message",
-		log.String("topic", msg.Topic),
-		log.Int32("partition", msg.Partition),
-		log.Int64("offset", msg.Offset),
-		log.String("key", string(msg.Key)),
-		log.String("value", string(msg.Value)),
-	)
-	return nil
+	return h.commands.Handle(ctx, msg, h.execute)
+}
+
+func (h *PostHandler) execute(ctx context.Context, command *examplepb.Command) (proto.Message, error) {
+	switch {
+	case command.GetPayload().MessageIs(&examplepb.PostCreate{}):
+		input := &examplepb.PostCreate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		create := encodePostCreate(input)
+		if err := create.Validate(); err != nil {
+			return nil, err
+		}
+		post, err := h.postUseCase.Create(ctx, create)
+		if err != nil {
+			return nil, err
+		}
+		return decodePost(post), nil
+	case command.GetPayload().MessageIs(&examplepb.PostUpdate{}):
+		input := &examplepb.PostUpdate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		update, err := encodePostUpdate(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := update.Validate(); err != nil {
+			return nil, err
+		}
+		post, err := h.postUseCase.Update(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+		return decodePost(post), nil
+	case command.GetPayload().MessageIs(&examplepb.PostDelete{}):
+		input := &examplepb.PostDelete{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		del, err := encodePostDelete(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := del.Validate(); err != nil {
+			return nil, err
+		}
+		post, err := h.postUseCase.Delete(ctx, del)
+		if err != nil {
+			return nil, err
+		}
+		return decodePost(post), nil
+	case command.GetPayload().MessageIs(&examplepb.PostRestore{}):
+		input := &examplepb.PostRestore{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		restore, err := encodePostRestore(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := restore.Validate(); err != nil {
+			return nil, err
+		}
+		post, err := h.postUseCase.Restore(ctx, restore)
+		if err != nil {
+			return nil, err
+		}
+		return decodePost(post), nil
+	default:
+		return nil, errs.NewInvalidFormError().WithParam("payload", "Unknown command.")
+	}
 }
 func (h *PostHandler) RegisterKafka(consumer *kafka.Consumer) error {
-	consumer.AddHandler(kafka.NewHandler(topicName, groupID, h.Handle))
+	consumer.AddHandler(
+		kafka.NewHandler(topicName, groupID, h.Handle).WithMessageID(kafka.CommandID),
+	)
 	return nil
 }
@@ -3,8 +3,11 @@ package handlers
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -12,9 +15,25 @@ import (
 type postUseCase interface {
 	Create(context.Context, entities.PostCreate) (entities.Post, error)
 	Get(context.Context, uuid.UUID) (entities.Post, error)
-	List(context.Context, entities.PostFilter) ([]entities.Post, uint64, error)
+	List(context.Context, entities.PostFilter) (entities.PostList, error)
 	Update(context.Context, entities.PostUpdate) (entities.Post, error)
 	Delete(context.Context, entities.PostDelete) (entities.Post, error)
+	Restore(context.Context, entities.PostRestore) (entities.Post, error)
+}
+type authenticator interface {
+	Enabled() bool
+	AuthenticateHeader(ctx context.Context, header string) (context.Context, error)
+}
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type outbox interface {
+	Send(ctx context.Context, msg *kafka.Message) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
 }
 type logger interface {
 	log.Logger
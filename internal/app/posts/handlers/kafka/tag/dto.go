@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func encodeTagCreate(input *examplepb.TagCreate) (entities.TagCreate, error) {
+	postId, err := kafka.ParseCommandUUID("post_id", input.GetPostId())
+	if err != nil {
+		return entities.TagCreate{}, err
+	}
+	create := entities.TagCreate{PostId: postId, Value: input.GetValue()}
+	return create, nil
+}
+func encodeTagUpdate(input *examplepb.TagUpdate) (entities.TagUpdate, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.TagUpdate{}, err
+	}
+	update := entities.TagUpdate{ID: id}
+	if input.GetPostId() != nil {
+		postId, err := kafka.ParseCommandUUID("post_id", input.GetPostId().GetValue())
+		if err != nil {
+			return entities.TagUpdate{}, err
+		}
+		update.PostId = pointer.Of(postId)
+	}
+	if input.GetValue() != nil {
+		update.Value = pointer.Of(string(input.GetValue().GetValue()))
+	}
+	return update, nil
+}
+func encodeTagDelete(input *examplepb.TagDelete) (entities.TagDelete, error) {
+	id, err := kafka.ParseCommandUUID("id", input.GetId())
+	if err != nil {
+		return entities.TagDelete{}, err
+	}
+	del := entities.TagDelete{ID: id}
+	return del, nil
+}
+func decodeTag(tag entities.Tag) *examplepb.Tag {
+	response := &examplepb.Tag{
+		Id:        tag.ID.String(),
+		CreatedAt: timestamppb.New(tag.CreatedAt),
+		UpdatedAt: timestamppb.New(tag.UpdatedAt),
+		DeletedAt: nil,
+		PostId:    tag.PostId.String(),
+		Value:     tag.Value,
+	}
+	if tag.DeletedAt != nil {
+		response.DeletedAt = timestamppb.New(*tag.DeletedAt)
+	}
+	return response
+}
@@ -4,36 +4,109 @@ import (
 	"context"
 
 	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	topicName = "example.posts.tag.v1"
-	groupID   = "example.posts.tag"
+	topicName        = "example.posts.tag.commands.v1"
+	resultsTopicName = "example.posts.tag.results.v1"
+	groupID          = "example.posts.tag"
 )
 
 type TagHandler struct {
 	tagUseCase tagUseCase
-	logger     logger
+	commands   *kafka.CommandHandler
 }
 
-func NewTagHandler(tagUseCase tagUseCase, logger logger) *TagHandler {
-	return &TagHandler{tagUseCase: tagUseCase, logger: logger}
+func NewTagHandler(
+	tagUseCase tagUseCase,
+	authenticator authenticator,
+	dtxManager dtxManager,
+	outbox outbox,
+	clock clock,
+	logger logger,
+) *TagHandler {
+	return &TagHandler{
+		tagUseCase: tagUseCase,
+		commands: kafka.NewCommandHandler(
+			resultsTopicName,
+			authenticator,
+			dtxManager,
+			outbox,
+			clock,
+			logger,
+		),
+	}
 }
+
+// Handle - executes a command and publishes its result for the caller.
 func (h *TagHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
-	logger := h.logger.WithContext(ctx)
-	logger.Info(
-		"received message",
-		log.String("topic", msg.Topic),
-		log.Int32("partition", msg.Partition),
-		log.Int64("offset", msg.Offset),
-		log.String("key", string(msg.Key)),
-		log.String("value", string(msg.Value)),
-	)
-	return nil
+	return h.commands.Handle(ctx, msg, h.execute)
+}
+
+func (h *TagHandler) execute(ctx context.Context, command *examplepb.Command) (proto.Message, error) {
+	switch {
+	case command.GetPayload().MessageIs(&examplepb.TagCreate{}):
+		input := &examplepb.TagCreate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		create, err := encodeTagCreate(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := create.Validate(); err != nil {
+			return nil, err
+		}
+		tag, err := h.tagUseCase.Create(ctx, create)
+		if err != nil {
+			return nil, err
+		}
+		return decodeTag(tag), nil
+	case command.GetPayload().MessageIs(&examplepb.TagUpdate{}):
+		input := &examplepb.TagUpdate{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		update, err := encodeTagUpdate(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := update.Validate(); err != nil {
+			return nil, err
+		}
+		tag, err := h.tagUseCase.Update(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+		return decodeTag(tag), nil
+	case command.GetPayload().MessageIs(&examplepb.TagDelete{}):
+		input := &examplepb.TagDelete{}
+		if err := kafka.DecodeCommandPayload(command, input); err != nil {
+			return nil, err
+		}
+		del, err := encodeTagDelete(input)
+		if err != nil {
+			return nil, err
+		}
+		if err := del.Validate(); err != nil {
+			return nil, err
+		}
+		tag, err := h.tagUseCase.Delete(ctx, del)
+		if err != nil {
+			return nil, err
+		}
+		return decodeTag(tag), nil
+	default:
+		return nil, errs.NewInvalidFormError().WithParam("payload", "Unknown command.")
+	}
 }
 func (h *TagHandler) RegisterKafka(consumer *kafka.Consumer) error {
-	consumer.AddHandler(kafka.NewHandler(topicName, groupID, h.Handle))
+	consumer.AddHandler(
+		kafka.NewHandler(topicName, groupID, h.Handle).WithMessageID(kafka.CommandID),
+	)
 	return nil
 }
@@ -3,8 +3,11 @@ package handlers
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -12,10 +15,25 @@ import (
 type tagUseCase interface {
 	Create(context.Context, entities.TagCreate) (entities.Tag, error)
 	Get(context.Context, uuid.UUID) (entities.Tag, error)
-	List(context.Context, entities.TagFilter) ([]entities.Tag, uint64, error)
+	List(context.Context, entities.TagFilter) (entities.TagList, error)
 	Update(context.Context, entities.TagUpdate) (entities.Tag, error)
 	Delete(context.Context, entities.TagDelete) (entities.Tag, error)
 }
+type authenticator interface {
+	Enabled() bool
+	AuthenticateHeader(ctx context.Context, header string) (context.Context, error)
+}
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type outbox interface {
+	Send(ctx context.Context, msg *kafka.Message) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
 type logger interface {
 	log.Logger
 }
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newTestCommand(t *testing.T, payload proto.Message) []byte {
+	data, err := anypb.New(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := proto.Marshal(&examplepb.Command{CommandId: "command", Payload: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func TestTagHandler_Handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagUseCase := NewMocktagUseCase(ctrl)
+	mockAuthenticator := NewMockauthenticator(ctrl)
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockOutbox := NewMockoutbox(ctrl)
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tag := entities.NewMockTag(t)
+	runInTx := func() {
+		mockAuthenticator.EXPECT().Enabled().Return(false)
+		mockDtxManager.EXPECT().
+			RunInTx(ctx, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
+	reply := func(t *testing.T, result proto.Message, err error) {
+		want, replyErr := kafka.NewCommandResult(
+			&examplepb.Command{CommandId: "command", CorrelationId: "command"},
+			now,
+			result,
+			err,
+		)
+		assert.NoError(t, replyErr)
+		mockClock.EXPECT().Now().Return(now)
+		mockOutbox.EXPECT().
+			Send(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, message *kafka.Message) error {
+				assert.Equal(t, resultsTopicName, message.Topic)
+				assert.Equal(t, "command", message.Key)
+				got := &examplepb.CommandResult{}
+				assert.NoError(t, proto.Unmarshal(message.Value, got))
+				assert.True(t, proto.Equal(want, got), "got %v", got)
+				return nil
+			})
+	}
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T)
+		msg     *sarama.ConsumerMessage
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockTagUseCase.EXPECT().
+					Delete(ctx, entities.TagDelete{ID: tag.ID}).
+					Return(tag, nil)
+				reply(t, decodeTag(tag), nil)
+			},
+			msg:     &sarama.ConsumerMessage{Value: newTestCommand(t, &examplepb.TagDelete{Id: tag.ID.String()})},
+			wantErr: nil,
+		},
+		{
+			name: "invalid id",
+			setup: func(t *testing.T) {
+				runInTx()
+				reply(t, nil, errs.NewInvalidFormError().WithParam("id", "Invalid id."))
+			},
+			msg:     &sarama.ConsumerMessage{Value: newTestCommand(t, &examplepb.TagDelete{Id: "invalid"})},
+			wantErr: nil,
+		},
+		{
+			name: "unknown command",
+			setup: func(t *testing.T) {
+				runInTx()
+				reply(t, nil, errs.NewInvalidFormError().WithParam("payload", "Unknown command."))
+			},
+			msg:     &sarama.ConsumerMessage{Value: newTestCommand(t, &examplepb.Tag{})},
+			wantErr: nil,
+		},
+		{
+			name: "failed",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockTagUseCase.EXPECT().
+					Delete(ctx, entities.TagDelete{ID: tag.ID}).
+					Return(entities.Tag{}, errs.NewEntityNotFoundError())
+				reply(t, nil, errs.NewEntityNotFoundError())
+			},
+			msg:     &sarama.ConsumerMessage{Value: newTestCommand(t, &examplepb.TagDelete{Id: tag.ID.String()})},
+			wantErr: nil,
+		},
+		{
+			name: "temporary error",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockTagUseCase.EXPECT().
+					Delete(ctx, entities.TagDelete{ID: tag.ID}).
+					Return(entities.Tag{}, errs.NewUnexpectedBehaviorError("database is down"))
+			},
+			msg:     &sarama.ConsumerMessage{Value: newTestCommand(t, &examplepb.TagDelete{Id: tag.ID.String()})},
+			wantErr: errs.NewUnexpectedBehaviorError("database is down"),
+		},
+		{
+			name:    "decode error",
+			setup:   func(t *testing.T) {},
+			msg:     &sarama.ConsumerMessage{Value: []byte("invalid")},
+			wantErr: errs.NewInvalidFormError().WithParam("value", "Invalid command envelope."),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t)
+			h := NewTagHandler(
+				mockTagUseCase,
+				mockAuthenticator,
+				mockDtxManager,
+				mockOutbox,
+				mockClock,
+				logger,
+			)
+			err := h.Handle(ctx, tt.msg)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
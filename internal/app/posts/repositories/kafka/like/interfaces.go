@@ -3,14 +3,24 @@ package repositories
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"time"
 
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type logger interface {
 	log.Logger
 }
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
+type uuidGenerator interface {
+	NewUUID() uuid.UUID
+}
 type producer interface {
 	Send(ctx context.Context, msg *kafka.Message) error
 }
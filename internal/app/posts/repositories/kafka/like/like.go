@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"google.golang.org/protobuf/proto"
 )
@@ -15,18 +15,36 @@ const (
 
 type LikeEventProducer struct {
 	producer producer
+	clock    clock
 	logger   logger
+	uuid     uuidGenerator
 }
 
 func NewLikeEventProducer(
 	producer producer,
+	clock clock,
 	logger logger,
+	uuid uuidGenerator,
 ) *LikeEventProducer {
-	return &LikeEventProducer{producer: producer, logger: logger}
+	return &LikeEventProducer{producer: producer, clock: clock, logger: logger, uuid: uuid}
 }
 
-func (p *LikeEventProducer) Send(ctx context.Context, like entities.Like) error {
-	data, err := proto.Marshal(decodeLike(like))
+func (p *LikeEventProducer) Send(
+	ctx context.Context,
+	eventType events.Type,
+	like entities.Like,
+) error {
+	event, err := kafka.NewEvent(
+		p.uuid.NewUUID(),
+		eventType,
+		p.clock.Now().UTC(),
+		like.ID,
+		decodeLike(like),
+	)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(event)
 	if err != nil {
 		return err
 	}
@@ -36,7 +54,7 @@ func (p *LikeEventProducer) Send(ctx context.Context, like entities.Like) error
 		Key:   like.ID.String(),
 	}
 	if err := p.producer.Send(ctx, message); err != nil {
-		return errs.FromKafkaError(err)
+		return err
 	}
 	return nil
 }
@@ -2,13 +2,15 @@ package repositories
 
 import (
 	"context"
-	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/proto"
@@ -19,9 +21,13 @@ func TestNewLikeEventProducer(t *testing.T) {
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
 	mockProducer := NewMockproducer(ctrl)
+	mockClock := NewMockclock(ctrl)
+	mockUUID := NewMockuuidGenerator(ctrl)
 	type args struct {
 		producer producer
+		clock    clock
 		logger   logger
+		uuid     uuidGenerator
 	}
 	tests := []struct {
 		name string
@@ -32,17 +38,21 @@ func TestNewLikeEventProducer(t *testing.T) {
 			name: "ok",
 			args: args{
 				producer: mockProducer,
+				clock:    mockClock,
 				logger:   mockLogger,
+				uuid:     mockUUID,
 			},
 			want: &LikeEventProducer{
 				producer: mockProducer,
+				clock:    mockClock,
 				logger:   mockLogger,
+				uuid:     mockUUID,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewLikeEventProducer(tt.args.producer, tt.args.logger); !reflect.DeepEqual(
+			if got := NewLikeEventProducer(tt.args.producer, tt.args.clock, tt.args.logger, tt.args.uuid); !reflect.DeepEqual(
 				got,
 				tt.want,
 			) {
@@ -57,15 +67,32 @@ func TestLikeEventProducer_Send(t *testing.T) {
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
 	mockProducer := NewMockproducer(ctrl)
+	mockClock := NewMockclock(ctrl)
+	mockUUID := NewMockuuidGenerator(ctrl)
 	ctx := context.Background()
 	like := entities.NewMockLike(t)
+	eventID := uuid.NewUUID()
+	now := time.Now().UTC()
+	event, err := kafka.NewEvent(eventID, events.TypeCreated, now, like.ID, decodeLike(like))
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	type fields struct {
 		producer producer
+		clock    clock
 		logger   logger
+		uuid     uuidGenerator
 	}
 	type args struct {
-		ctx  context.Context
-		like entities.Like
+		ctx       context.Context
+		eventType events.Type
+		like      entities.Like
 	}
 	tests := []struct {
 		name    string
@@ -78,14 +105,18 @@ func TestLikeEventProducer_Send(t *testing.T) {
 			name: "ok",
 			fields: fields{
 				producer: mockProducer,
+				clock:    mockClock,
 				logger:   mockLogger,
+				uuid:     mockUUID,
 			},
 			args: args{
-				ctx:  ctx,
-				like: like,
+				ctx:       ctx,
+				eventType: events.TypeCreated,
+				like:      like,
 			},
 			setup: func() {
-				data, _ := proto.Marshal(decodeLike(like))
+				mockUUID.EXPECT().NewUUID().Return(eventID)
+				mockClock.EXPECT().Now().Return(now)
 				mockProducer.EXPECT().Send(gomock.Any(), &kafka.Message{
 					Topic: topicName,
 					Value: data,
@@ -98,21 +129,25 @@ func TestLikeEventProducer_Send(t *testing.T) {
 			name: "send error",
 			fields: fields{
 				producer: mockProducer,
+				clock:    mockClock,
 				logger:   mockLogger,
+				uuid:     mockUUID,
 			},
 			args: args{
-				ctx:  ctx,
-				like: like,
+				ctx:       ctx,
+				eventType: events.TypeCreated,
+				like:      like,
 			},
 			setup: func() {
-				data, _ := proto.Marshal(decodeLike(like))
+				mockUUID.EXPECT().NewUUID().Return(eventID)
+				mockClock.EXPECT().Now().Return(now)
 				mockProducer.EXPECT().Send(gomock.Any(), &kafka.Message{
 					Topic: topicName,
 					Value: data,
 					Key:   like.ID.String(),
-				}).Return(errors.New("test error"))
+				}).Return(errs.NewUnexpectedBehaviorError("test error"))
 			},
-			wantErr: errs.FromKafkaError(errors.New("test error")),
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 	}
 	for _, tt := range tests {
@@ -120,9 +155,11 @@ func TestLikeEventProducer_Send(t *testing.T) {
 			tt.setup()
 			p := &LikeEventProducer{
 				producer: tt.fields.producer,
+				clock:    tt.fields.clock,
 				logger:   tt.fields.logger,
+				uuid:     tt.fields.uuid,
 			}
-			err := p.Send(tt.args.ctx, tt.args.like)
+			err := p.Send(tt.args.ctx, tt.args.eventType, tt.args.like)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -13,6 +13,7 @@ func decodePost(post entities.Post) *examplepb.Post {
 		UpdatedAt: timestamppb.New(post.UpdatedAt),
 		DeletedAt: nil,
 		Body:      post.Body,
+		AuthorId:  post.AuthorId,
 	}
 	if post.DeletedAt != nil {
 		response.DeletedAt = timestamppb.New(*post.DeletedAt)
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"google.golang.org/protobuf/proto"
 )
@@ -15,18 +15,36 @@ const (
 
 type PostEventProducer struct {
 	producer producer
+	clock    clock
 	logger   logger
+	uuid     uuidGenerator
 }
 
 func NewPostEventProducer(
 	producer producer,
+	clock clock,
 	logger logger,
+	uuid uuidGenerator,
 ) *PostEventProducer {
-	return &PostEventProducer{producer: producer, logger: logger}
+	return &PostEventProducer{producer: producer, clock: clock, logger: logger, uuid: uuid}
 }
 
-func (p *PostEventProducer) Send(ctx context.Context, post entities.Post) error {
-	data, err := proto.Marshal(decodePost(post))
+func (p *PostEventProducer) Send(
+	ctx context.Context,
+	eventType events.Type,
+	post entities.Post,
+) error {
+	event, err := kafka.NewEvent(
+		p.uuid.NewUUID(),
+		eventType,
+		p.clock.Now().UTC(),
+		post.ID,
+		decodePost(post),
+	)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(event)
 	if err != nil {
 		return err
 	}
@@ -36,7 +54,7 @@ func (p *PostEventProducer) Send(ctx context.Context, post entities.Post) error
 		Key:   post.ID.String(),
 	}
 	if err := p.producer.Send(ctx, message); err != nil {
-		return errs.FromKafkaError(err)
+		return err
 	}
 	return nil
 }
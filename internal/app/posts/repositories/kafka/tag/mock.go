@@ -12,9 +12,11 @@ package repositories
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	kafka "github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
+	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
 	gomock "go.uber.org/mock/gomock"
 	zap "go.uber.org/zap"
@@ -266,6 +268,82 @@ func (mr *MockloggerMockRecorder) WithContext(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*Mocklogger)(nil).WithContext), ctx)
 }
 
+// Mockclock is a mock of clock interface.
+type Mockclock struct {
+	ctrl     *gomock.Controller
+	recorder *MockclockMockRecorder
+	isgomock struct{}
+}
+
+// MockclockMockRecorder is the mock recorder for Mockclock.
+type MockclockMockRecorder struct {
+	mock *Mockclock
+}
+
+// NewMockclock creates a new mock instance.
+func NewMockclock(ctrl *gomock.Controller) *Mockclock {
+	mock := &Mockclock{ctrl: ctrl}
+	mock.recorder = &MockclockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclock) EXPECT() *MockclockMockRecorder {
+	return m.recorder
+}
+
+// Now mocks base method.
+func (m *Mockclock) Now() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Now")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Now indicates an expected call of Now.
+func (mr *MockclockMockRecorder) Now() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Now", reflect.TypeOf((*Mockclock)(nil).Now))
+}
+
+// MockuuidGenerator is a mock of uuidGenerator interface.
+type MockuuidGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockuuidGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockuuidGeneratorMockRecorder is the mock recorder for MockuuidGenerator.
+type MockuuidGeneratorMockRecorder struct {
+	mock *MockuuidGenerator
+}
+
+// NewMockuuidGenerator creates a new mock instance.
+func NewMockuuidGenerator(ctrl *gomock.Controller) *MockuuidGenerator {
+	mock := &MockuuidGenerator{ctrl: ctrl}
+	mock.recorder = &MockuuidGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuuidGenerator) EXPECT() *MockuuidGeneratorMockRecorder {
+	return m.recorder
+}
+
+// NewUUID mocks base method.
+func (m *MockuuidGenerator) NewUUID() uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewUUID")
+	ret0, _ := ret[0].(uuid.UUID)
+	return ret0
+}
+
+// NewUUID indicates an expected call of NewUUID.
+func (mr *MockuuidGeneratorMockRecorder) NewUUID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewUUID", reflect.TypeOf((*MockuuidGenerator)(nil).NewUUID))
+}
+
 // Mockproducer is a mock of producer interface.
 type Mockproducer struct {
 	ctrl     *gomock.Controller
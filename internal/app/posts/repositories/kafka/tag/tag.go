@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"google.golang.org/protobuf/proto"
 )
@@ -15,18 +15,36 @@ const (
 
 type TagEventProducer struct {
 	producer producer
+	clock    clock
 	logger   logger
+	uuid     uuidGenerator
 }
 
 func NewTagEventProducer(
 	producer producer,
+	clock clock,
 	logger logger,
+	uuid uuidGenerator,
 ) *TagEventProducer {
-	return &TagEventProducer{producer: producer, logger: logger}
+	return &TagEventProducer{producer: producer, clock: clock, logger: logger, uuid: uuid}
 }
 
-func (p *TagEventProducer) Send(ctx context.Context, tag entities.Tag) error {
-	data, err := proto.Marshal(decodeTag(tag))
+func (p *TagEventProducer) Send(
+	ctx context.Context,
+	eventType events.Type,
+	tag entities.Tag,
+) error {
+	event, err := kafka.NewEvent(
+		p.uuid.NewUUID(),
+		eventType,
+		p.clock.Now().UTC(),
+		tag.ID,
+		decodeTag(tag),
+	)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(event)
 	if err != nil {
 		return err
 	}
@@ -36,7 +54,7 @@ func (p *TagEventProducer) Send(ctx context.Context, tag entities.Tag) error {
 		Key:   tag.ID.String(),
 	}
 	if err := p.producer.Send(ctx, message); err != nil {
-		return errs.FromKafkaError(err)
+		return err
 	}
 	return nil
 }
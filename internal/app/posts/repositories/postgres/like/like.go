@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -10,17 +11,24 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type LikeRepository struct {
 	readDB  database
 	writeDB database
+	cursors cursorCodec
 	logger  logger
 }
 
-func NewLikeRepository(readDB database, writeDB database, logger logger) *LikeRepository {
-	return &LikeRepository{readDB: readDB, writeDB: writeDB, logger: logger}
+func NewLikeRepository(
+	readDB database,
+	writeDB database,
+	cursors cursorCodec,
+	logger logger,
+) *LikeRepository {
+	return &LikeRepository{readDB: readDB, writeDB: writeDB, cursors: cursors, logger: logger}
 }
 
 var orderByMap = map[entities.LikeOrdering]string{
@@ -40,18 +48,72 @@ var orderByMap = map[entities.LikeOrdering]string{
 	entities.LikeOrderingCreatedAtASC:  "likes.created_at ASC",
 }
 
+// nullableColumns - columns of the ordering which can be NULL.
+var nullableColumns = []string{"likes.deleted_at"}
+
+// encodeOrderBy - columns of the ordering, the id is added if missing to make
+// the order total for the keyset of the cursor.
 func encodeOrderBy(orderBy []entities.LikeOrdering) []string {
-	columns := make([]string, len(orderBy))
-	for i, item := range orderBy {
+	columns := make([]string, 0, len(orderBy)+1)
+	for _, item := range orderBy {
 		column, exists := orderByMap[item]
 		if !exists {
 			continue
 		}
-		columns[i] = column
+		columns = append(columns, column)
+	}
+	if !slices.Contains(orderBy, entities.LikeOrderingIdASC) &&
+		!slices.Contains(orderBy, entities.LikeOrderingIdDESC) {
+		columns = append(columns, orderByMap[entities.LikeOrderingIdASC])
 	}
 	return columns
 }
 
+// encodeFilter - conditions of the filter, List and Count share them.
+func encodeFilter(q sq.SelectBuilder, filter entities.LikeFilter) sq.SelectBuilder {
+	if filter.IsDeleted != nil {
+		if *filter.IsDeleted {
+			q = q.Where(sq.NotEq{"likes.deleted_at": nil})
+		} else {
+			q = q.Where(sq.Eq{"likes.deleted_at": nil})
+		}
+	}
+	if len(filter.IDs) > 0 {
+		q = q.Where(sq.Eq{"likes.id": filter.IDs})
+	}
+	if filter.CreatedAfter != nil {
+		q = q.Where(sq.Gt{"likes.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		q = q.Where(sq.Lt{"likes.created_at": *filter.CreatedBefore})
+	}
+	if filter.UpdatedAfter != nil {
+		q = q.Where(sq.Gt{"likes.updated_at": *filter.UpdatedAfter})
+	}
+	if filter.UpdatedBefore != nil {
+		q = q.Where(sq.Lt{"likes.updated_at": *filter.UpdatedBefore})
+	}
+	if len(filter.PostIds) > 0 {
+		q = q.Where(sq.Eq{"likes.post_id": filter.PostIds})
+	}
+	if len(filter.UserIds) > 0 {
+		q = q.Where(sq.Eq{"likes.user_id": filter.UserIds})
+	}
+	if len(filter.Values) > 0 {
+		q = q.Where(sq.Eq{"likes.value": filter.Values})
+	}
+	if filter.Search != nil {
+		q = q.Where(
+			postgres.Search{
+				Lang:   postgres.SearchLang,
+				Query:  *filter.Search,
+				Fields: []string{"value"},
+			},
+		)
+	}
+	return q
+}
+
 type LikeDTO struct {
 	ID        uuid.UUID  `db:"id,omitempty"`
 	UpdatedAt time.Time  `db:"updated_at,omitempty"`
@@ -61,6 +123,10 @@ type LikeDTO struct {
 	Value     string     `db:"value"`
 	UserId    uuid.UUID  `db:"user_id"`
 }
+
+// Table - table of the repository with the columns it uses.
+var Table = postgres.NewTable("public.likes", LikeDTO{})
+
 type LikeListDTO []LikeDTO
 
 func (list LikeListDTO) toEntities() []entities.Like {
@@ -94,7 +160,7 @@ func (dto LikeDTO) toEntity() entities.Like {
 	}
 	return entity
 }
-func (r *LikeRepository) Create(ctx context.Context, tx dtx.TX, entity entities.Like) error {
+func (r *LikeRepository) Create(ctx context.Context, entity entities.Like) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewLikeDTOFromEntity(entity)
@@ -102,12 +168,48 @@ func (r *LikeRepository) Create(ctx context.Context, tx dtx.TX, entity entities.
 		Columns("id", "created_at", "updated_at", "deleted_at", "post_id", "value", "user_id").
 		Values(dto.ID, dto.CreatedAt, dto.UpdatedAt, dto.DeletedAt, dto.PostId, dto.Value, dto.UserId)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if _, err := tx.GetSQLTx().ExecContext(ctx, query, args...); err != nil {
+	if _, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return e
 	}
 	return nil
 }
+
+// likeUpsertDTO - the stored like and whether the upsert inserted it.
+type likeUpsertDTO struct {
+	LikeDTO
+	Created bool `db:"created"`
+}
+
+// Upsert - inserts the like of the user or updates the value of the like the
+// user already has, so concurrent likes of the same user converge on one row.
+// Returns the stored like and true if it is inserted, errs.NewAlreadyExistsError()
+// if the like of the user already has the value.
+func (r *LikeRepository) Upsert(ctx context.Context, entity entities.Like) (entities.Like, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	dto := NewLikeDTOFromEntity(entity)
+	q := sq.Insert("public.likes").
+		Columns("id", "created_at", "updated_at", "deleted_at", "post_id", "value", "user_id").
+		Values(dto.ID, dto.CreatedAt, dto.UpdatedAt, dto.DeletedAt, dto.PostId, dto.Value, dto.UserId).
+		Suffix("ON CONFLICT (post_id, user_id) WHERE deleted_at IS NULL DO UPDATE").
+		Suffix("SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at").
+		Suffix("WHERE likes.value <> EXCLUDED.value").
+		Suffix("RETURNING likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id, (xmax = 0) AS created")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	var list []likeUpsertDTO
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &list, query, args...); err != nil {
+		e := errs.FromPostgresError(err)
+		return entities.Like{}, false, e
+	}
+	if len(list) == 0 {
+		e := errs.NewAlreadyExistsError().
+			WithParam("post_id", dto.PostId.String()).
+			WithParam("user_id", dto.UserId.String())
+		return entities.Like{}, false, e
+	}
+	return list[0].toEntity(), list[0].Created, nil
+}
 func (r *LikeRepository) Get(ctx context.Context, id uuid.UUID) (entities.Like, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
@@ -117,17 +219,65 @@ func (r *LikeRepository) Get(ctx context.Context, id uuid.UUID) (entities.Like,
 		Where(sq.Eq{"id": id}).
 		Limit(1)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.GetContext(ctx, dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err).WithParam("like_id", id.String())
 		return entities.Like{}, e
 	}
 	return dto.toEntity(), nil
 }
 
+// GetForUpdate - the like within the transaction of the context, locked against
+// concurrent changes until the transaction ends.
+func (r *LikeRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (entities.Like, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	dto := &LikeDTO{}
+	q := sq.Select("likes.id", "likes.created_at", "likes.updated_at", "likes.deleted_at", "likes.post_id", "likes.value", "likes.user_id").
+		From("public.likes").
+		Where(sq.Eq{"id": id}).
+		Limit(1).
+		Suffix("FOR UPDATE")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).GetContext(ctx, dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("like_id", id.String())
+		return entities.Like{}, e
+	}
+	return dto.toEntity(), nil
+}
+
+// GetByUser - the like of the user on the post which is not deleted, locked
+// until the transaction ends.
+func (r *LikeRepository) GetByUser(
+	ctx context.Context,
+	postId uuid.UUID,
+	userId uuid.UUID,
+) (entities.Like, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto LikeListDTO
+	q := sq.Select("likes.id", "likes.created_at", "likes.updated_at", "likes.deleted_at", "likes.post_id", "likes.value", "likes.user_id").
+		From("public.likes").
+		Where(sq.Eq{"post_id": postId, "user_id": userId, "deleted_at": nil}).
+		Limit(1).
+		Suffix("FOR UPDATE")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("post_id", postId.String())
+		return entities.Like{}, e
+	}
+	if len(dto) == 0 {
+		e := errs.NewEntityNotFoundError().
+			WithParam("post_id", postId.String()).
+			WithParam("user_id", userId.String())
+		return entities.Like{}, e
+	}
+	return dto[0].toEntity(), nil
+}
+
 func (r *LikeRepository) List(
 	ctx context.Context,
 	filter entities.LikeFilter,
-) ([]entities.Like, error) {
+) ([]entities.Like, *string, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	var dto LikeListDTO
@@ -138,47 +288,50 @@ func (r *LikeRepository) List(
 	q := sq.Select("likes.id", "likes.created_at", "likes.updated_at", "likes.deleted_at", "likes.post_id", "likes.value", "likes.user_id").
 		From("public.likes").
 		Limit(pageSize)
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"likes.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"likes.deleted_at": nil})
+	q = encodeFilter(q, filter)
+	orderBy := encodeOrderBy(filter.OrderBy)
+	if filter.Cursor != nil {
+		values, err := r.cursors.Decode(*filter.Cursor, orderBy)
+		if err != nil {
+			return nil, nil, err
 		}
-	}
-	if filter.PageNumber != nil && *filter.PageNumber > 1 {
+		q = q.Where(postgres.Keyset{OrderBy: orderBy, Values: values, Nullable: nullableColumns})
+	} else if filter.PageNumber != nil && *filter.PageNumber > 1 {
 		q = q.Offset((*filter.PageNumber - 1) * *filter.PageSize)
 	}
-	q = q.Limit(*filter.PageSize)
-	if len(filter.OrderBy) > 0 {
-		q = q.OrderBy(encodeOrderBy(filter.OrderBy)...)
-	}
+	q = q.Limit(*filter.PageSize + 1).OrderBy(orderBy...)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.SelectContext(ctx, &dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).SelectContext(ctx, &dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
-		return nil, e
+		return nil, nil, e
 	}
-	return dto.toEntities(), nil
+	var nextCursor *string
+	if uint64(len(dto)) > *filter.PageSize {
+		dto = dto[:*filter.PageSize]
+		if len(dto) > 0 {
+			cursor, err := r.cursors.Encode(orderBy, postgres.KeysetValues(dto[len(dto)-1], orderBy))
+			if err != nil {
+				return nil, nil, err
+			}
+			nextCursor = pointer.Of(cursor)
+		}
+	}
+	return dto.toEntities(), nextCursor, nil
 }
 func (r *LikeRepository) Count(ctx context.Context, filter entities.LikeFilter) (uint64, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Select("count(id)").From("public.likes")
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"likes.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"likes.deleted_at": nil})
-		}
-	}
+	q = encodeFilter(q, filter)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
 	var count uint64
-	if err := r.readDB.GetContext(ctx, &count, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, &count, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return 0, e
 	}
 	return count, nil
 }
-func (r *LikeRepository) Update(ctx context.Context, tx dtx.TX, entity entities.Like) error {
+func (r *LikeRepository) Update(ctx context.Context, entity entities.Like) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewLikeDTOFromEntity(entity)
@@ -192,7 +345,7 @@ func (r *LikeRepository) Update(ctx context.Context, tx dtx.TX, entity entities.
 		q = q.Set("user_id", dto.UserId)
 	}
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("like_id", fmt.Sprint(entity.ID))
 		return e
@@ -207,12 +360,12 @@ func (r *LikeRepository) Update(ctx context.Context, tx dtx.TX, entity entities.
 	}
 	return nil
 }
-func (r *LikeRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID) error {
+func (r *LikeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Delete("public.likes").Where(sq.Eq{"id": id})
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("like_id", fmt.Sprint(id))
 		return e
@@ -228,3 +381,87 @@ func (r *LikeRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID) er
 	}
 	return nil
 }
+
+// ListDeleted - the oldest likes soft deleted before the time, locked until the
+// transaction ends and skipped by the concurrent purges.
+func (r *LikeRepository) ListDeleted(
+	ctx context.Context,
+	deletedBefore time.Time,
+	limit uint64,
+) ([]entities.Like, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto LikeListDTO
+	q := sq.Select("likes.id", "likes.created_at", "likes.updated_at", "likes.deleted_at", "likes.post_id", "likes.value", "likes.user_id").
+		From("public.likes").
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at ASC", "id ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		return nil, errs.FromPostgresError(err)
+	}
+	return dto.toEntities(), nil
+}
+
+// DeleteByPost - soft deletes the likes of the post which are not deleted yet.
+func (r *LikeRepository) DeleteByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+) ([]entities.Like, error) {
+	q := sq.Update("public.likes").
+		Set("deleted_at", deletedAt).
+		Where(sq.Eq{"post_id": postId}).
+		Where(sq.Eq{"deleted_at": nil}).
+		Suffix("RETURNING id, created_at, updated_at, deleted_at, post_id, value, user_id")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	return r.queryByPost(ctx, postId, query, args)
+}
+
+// RestoreByPost - restores the likes of the post deleted at the same time as the
+// post, the ones deleted before stay deleted.
+func (r *LikeRepository) RestoreByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+	updatedAt time.Time,
+) ([]entities.Like, error) {
+	q := sq.Update("public.likes").
+		Set("deleted_at", nil).
+		Set("updated_at", updatedAt).
+		Where(sq.Eq{"post_id": postId}).
+		Where(sq.Eq{"deleted_at": deletedAt}).
+		Suffix("RETURNING id, created_at, updated_at, deleted_at, post_id, value, user_id")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	return r.queryByPost(ctx, postId, query, args)
+}
+
+// PurgeByPost - hard deletes the likes of the post along with it.
+func (r *LikeRepository) PurgeByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+) ([]entities.Like, error) {
+	q := sq.Delete("public.likes").
+		Where(sq.Eq{"post_id": postId}).
+		Suffix("RETURNING id, created_at, updated_at, deleted_at, post_id, value, user_id")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	return r.queryByPost(ctx, postId, query, args)
+}
+
+func (r *LikeRepository) queryByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	query string,
+	args []interface{},
+) ([]entities.Like, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto LikeListDTO
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("post_id", postId.String())
+		return nil, e
+	}
+	return dto.toEntities(), nil
+}
@@ -3,8 +3,10 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jaswdr/faker"
@@ -26,9 +28,15 @@ func TestNewLikeRepository(t *testing.T) {
 		return
 	}
 	defer mockDB.Close()
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	type args struct {
 		writeDB database
 		readDB  database
+		cursors cursorCodec
 		logger  logger
 	}
 	tests := []struct {
@@ -43,17 +51,19 @@ func TestNewLikeRepository(t *testing.T) {
 			args: args{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 			want: &LikeRepository{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			got := NewLikeRepository(tt.args.readDB, tt.args.writeDB, tt.args.logger)
+			got := NewLikeRepository(tt.args.readDB, tt.args.writeDB, tt.args.cursors, tt.args.logger)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -69,12 +79,16 @@ func TestLikeRepository_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	query := "INSERT INTO public.likes (id,created_at,updated_at,deleted_at,post_id,value,user_id) VALUES ($1,$2,$3,$4,$5,$6,$7)"
 	like := entities.NewMockLike(t)
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -82,7 +96,6 @@ func TestLikeRepository_Create(t *testing.T) {
 	}
 	type args struct {
 		ctx  context.Context
-		tx   dtx.TX
 		like entities.Like
 	}
 	tests := []struct {
@@ -114,7 +127,6 @@ func TestLikeRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				like: like,
 			},
 			wantErr: nil,
@@ -141,7 +153,6 @@ func TestLikeRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				like: like,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")),
@@ -155,7 +166,7 @@ func TestLikeRepository_Create(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Create(tt.args.ctx, tt.args.tx, tt.args.like)
+			err := r.Create(tt.args.ctx, tt.args.like)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -260,6 +271,111 @@ func TestLikeRepository_Get(t *testing.T) {
 	}
 }
 
+func TestLikeRepository_GetForUpdate(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mock.ExpectBegin()
+	mockTX, err := dtx.NewManager(mockDB, &dtx.Config{}).NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes WHERE id = $1 LIMIT 1 FOR UPDATE"
+	like := entities.NewMockLike(t)
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				rows := newLikeRows(t, []entities.Like{like})
+				mock.ExpectQuery(query).WithArgs(like.ID).WillReturnRows(rows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  like.ID,
+			},
+			want:    like,
+			wantErr: nil,
+		},
+		{
+			name: "unexpected behavior",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(like.ID).WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  like.ID,
+			},
+			want: entities.Like{},
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("like_id", like.ID.String()),
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(like.ID).WillReturnError(sql.ErrNoRows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  like.ID,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewEntityNotFoundError().WithParam("like_id", like.ID.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &LikeRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.GetForUpdate(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestLikeRepository_List(t *testing.T) {
 	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
 	if err != nil {
@@ -270,9 +386,14 @@ func TestLikeRepository_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	ctx := context.Background()
 	var likes []entities.Like
-	for i := 0; i < faker.New().IntBetween(2, 20); i++ {
+	for i := 0; i < faker.New().IntBetween(2, 10); i++ {
 		likes = append(likes, entities.NewMockLike(t))
 	}
 	filter := entities.LikeFilter{
@@ -281,7 +402,36 @@ func TestLikeRepository_List(t *testing.T) {
 		Search:     nil,
 		OrderBy:    []entities.LikeOrdering{"id"},
 	}
-	query := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes ORDER BY likes.id ASC LIMIT 10 OFFSET 10"
+	var page []entities.Like
+	for i := 0; i < 11; i++ {
+		page = append(page, entities.NewMockLike(t))
+	}
+	nextCursor, err := cursors.Encode([]string{"likes.id ASC"}, []any{page[9].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	cursor, err := cursors.Encode([]string{"likes.id ASC"}, []any{likes[0].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes ORDER BY likes.id ASC LIMIT 11 OFFSET 10"
+	cursorQuery := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes WHERE ((likes.id > $1)) ORDER BY likes.id ASC LIMIT 11"
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.LikeFilter{
+		PageSize:      filter.PageSize,
+		OrderBy:       filter.OrderBy,
+		IDs:           []uuid.UUID{likes[0].ID, likes[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		PostIds:       []uuid.UUID{likes[0].PostId},
+		UserIds:       []uuid.UUID{likes[0].UserId},
+		Values:        []string{likes[0].Value},
+	}
+	filterQuery := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes WHERE likes.id IN ($1,$2) AND likes.created_at > $3 AND likes.created_at < $4 AND likes.post_id IN ($5) AND likes.user_id IN ($6) AND likes.value IN ($7) ORDER BY likes.id ASC LIMIT 11"
+	searchQuery := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes WHERE to_tsvector('english', value) @@ websearch_to_tsquery('english', $1) ORDER BY likes.id ASC LIMIT 11 OFFSET 10"
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -292,12 +442,13 @@ func TestLikeRepository_List(t *testing.T) {
 		filter entities.LikeFilter
 	}
 	tests := []struct {
-		name    string
-		setup   func()
-		fields  fields
-		args    args
-		want    []entities.Like
-		wantErr error
+		name       string
+		setup      func()
+		fields     fields
+		args       args
+		want       []entities.Like
+		wantCursor *string
+		wantErr    error
 	}{
 		{
 			name: "ok",
@@ -317,6 +468,111 @@ func TestLikeRepository_List(t *testing.T) {
 			want:    likes,
 			wantErr: nil,
 		},
+		{
+			name: "search",
+			setup: func() {
+				mock.ExpectQuery(searchQuery).
+					WithArgs("my name is").
+					WillReturnRows(newLikeRows(t, likes))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.LikeFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					Search:     pointer.Of("my name is"),
+					OrderBy:    filter.OrderBy,
+				},
+			},
+			want:    likes,
+			wantErr: nil,
+		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(likes[0].ID, likes[1].ID, createdAfter, createdBefore, likes[0].PostId, likes[0].UserId, likes[0].Value).
+					WillReturnRows(newLikeRows(t, likes))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    likes,
+			wantErr: nil,
+		},
+		{
+			name: "next page",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(newLikeRows(t, page))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:       page[:10],
+			wantCursor: pointer.Of(nextCursor),
+			wantErr:    nil,
+		},
+		{
+			name: "cursor",
+			setup: func() {
+				mock.ExpectQuery(cursorQuery).
+					WithArgs(likes[0].ID.String()).
+					WillReturnRows(newLikeRows(t, likes))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.LikeFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					OrderBy:    filter.OrderBy,
+					Cursor:     pointer.Of(cursor),
+				},
+			},
+			want:    likes,
+			wantErr: nil,
+		},
+		{
+			name:  "invalid cursor",
+			setup: func() {},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.LikeFilter{
+					PageSize: filter.PageSize,
+					OrderBy:  filter.OrderBy,
+					Cursor:   pointer.Of(cursor + "x"),
+				},
+			},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("cursor", "Invalid cursor."),
+		},
 		{
 			name: "unexpected behavior",
 			setup: func() {
@@ -363,11 +619,13 @@ func TestLikeRepository_List(t *testing.T) {
 			r := &LikeRepository{
 				writeDB: tt.fields.writeDB,
 				readDB:  tt.fields.readDB,
+				cursors: cursors,
 				logger:  tt.fields.logger,
 			}
-			got, err := r.List(tt.args.ctx, tt.args.filter)
+			got, gotCursor, err := r.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCursor, gotCursor)
 		})
 	}
 }
@@ -382,12 +640,16 @@ func TestLikeRepository_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	like := entities.NewMockLike(t)
 	query := `UPDATE public.likes SET created_at = $1, updated_at = $2, deleted_at = $3, post_id = $4, value = $5, user_id = $6 WHERE id = $7`
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -395,7 +657,6 @@ func TestLikeRepository_Update(t *testing.T) {
 	}
 	type args struct {
 		ctx  context.Context
-		tx   dtx.TX
 		like entities.Like
 	}
 	tests := []struct {
@@ -427,7 +688,6 @@ func TestLikeRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				like: like,
 			},
 			wantErr: nil,
@@ -454,7 +714,6 @@ func TestLikeRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				like: like,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("like_id", like.ID.String()),
@@ -481,7 +740,6 @@ func TestLikeRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				like: like,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -509,7 +767,6 @@ func TestLikeRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				like: like,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -537,7 +794,6 @@ func TestLikeRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				like: like,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -552,7 +808,7 @@ func TestLikeRepository_Update(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Update(tt.args.ctx, tt.args.tx, tt.args.like)
+			err := r.Update(tt.args.ctx, tt.args.like)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -568,9 +824,14 @@ func TestLikeRepository_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	like := entities.NewMockLike(t)
 	type fields struct {
 		writeDB database
@@ -579,7 +840,6 @@ func TestLikeRepository_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		id  uuid.UUID
 	}
 	tests := []struct {
@@ -602,8 +862,7 @@ func TestLikeRepository_Delete(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  like.ID,
 			},
 			wantErr: nil,
@@ -621,8 +880,7 @@ func TestLikeRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  like.ID,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("like_id", like.ID.String()),
@@ -640,8 +898,7 @@ func TestLikeRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  like.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -660,8 +917,7 @@ func TestLikeRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  like.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -676,7 +932,7 @@ func TestLikeRepository_Delete(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Delete(tt.args.ctx, tt.args.tx, tt.args.id)
+			err := r.Delete(tt.args.ctx, tt.args.id)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -692,6 +948,18 @@ func TestLikeRepository_Count(t *testing.T) {
 	query := "SELECT count(id) FROM public.likes"
 	ctx := context.Background()
 	filter := entities.LikeFilter{}
+	likes := []entities.Like{entities.NewMockLike(t), entities.NewMockLike(t)}
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.LikeFilter{
+		IDs:           []uuid.UUID{likes[0].ID, likes[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		PostIds:       []uuid.UUID{likes[0].PostId},
+		UserIds:       []uuid.UUID{likes[0].UserId},
+		Values:        []string{likes[0].Value},
+	}
+	filterQuery := "SELECT count(id) FROM public.likes WHERE likes.id IN ($1,$2) AND likes.created_at > $3 AND likes.created_at < $4 AND likes.post_id IN ($5) AND likes.user_id IN ($6) AND likes.value IN ($7)"
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -727,6 +995,25 @@ func TestLikeRepository_Count(t *testing.T) {
 			want:    1,
 			wantErr: nil,
 		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(likes[0].ID, likes[1].ID, createdAfter, createdBefore, likes[0].PostId, likes[0].UserId, likes[0].Value).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).
+						AddRow(1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    1,
+			wantErr: nil,
+		},
 		{
 			name: "bad return type",
 			setup: func() {
@@ -811,3 +1098,633 @@ func newLikeRows(t *testing.T, likes []entities.Like) *sqlmock.Rows {
 	}
 	return rows
 }
+
+func TestLikeRepository_DeleteByPost(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "UPDATE public.likes SET deleted_at = $1 WHERE post_id = $2 AND deleted_at IS NULL RETURNING id, created_at, updated_at, deleted_at, post_id, value, user_id"
+	deletedAt := time.Now().UTC()
+	like := entities.NewMockLike(t)
+	like.DeletedAt = pointer.Of(deletedAt)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedAt, like.PostId).
+					WillReturnRows(newLikeRows(t, []entities.Like{like}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedAt, like.PostId).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+			},
+			want: nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", like.PostId.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &LikeRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.DeleteByPost(tt.args.ctx, tt.args.postId, tt.args.deletedAt)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeRepository_RestoreByPost(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "UPDATE public.likes SET deleted_at = $1, updated_at = $2 WHERE post_id = $3 AND deleted_at = $4 RETURNING id, created_at, updated_at, deleted_at, post_id, value, user_id"
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+	updatedAt := time.Now().UTC()
+	like := entities.NewMockLike(t)
+	like.DeletedAt = nil
+	like.UpdatedAt = updatedAt
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+		updatedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(nil, updatedAt, like.PostId, deletedAt).
+					WillReturnRows(newLikeRows(t, []entities.Like{like}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+				updatedAt: updatedAt,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(nil, updatedAt, like.PostId, deletedAt).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+				updatedAt: updatedAt,
+			},
+			want: nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", like.PostId.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &LikeRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.RestoreByPost(
+				tt.args.ctx,
+				tt.args.postId,
+				tt.args.deletedAt,
+				tt.args.updatedAt,
+			)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeRepository_GetByUser(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes WHERE deleted_at IS NULL AND post_id = $1 AND user_id = $2 LIMIT 1 FOR UPDATE"
+	like := entities.NewMockLike(t)
+	like.DeletedAt = nil
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx    context.Context
+		postId uuid.UUID
+		userId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(like.PostId, like.UserId).
+					WillReturnRows(newLikeRows(t, []entities.Like{like}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: like.PostId,
+				userId: like.UserId,
+			},
+			want:    like,
+			wantErr: nil,
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(like.PostId, like.UserId).
+					WillReturnRows(newLikeRows(t, nil))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: like.PostId,
+				userId: like.UserId,
+			},
+			want: entities.Like{},
+			wantErr: errs.NewEntityNotFoundError().
+				WithParam("post_id", like.PostId.String()).
+				WithParam("user_id", like.UserId.String()),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(like.PostId, like.UserId).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: like.PostId,
+				userId: like.UserId,
+			},
+			want: entities.Like{},
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", like.PostId.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &LikeRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.GetByUser(tt.args.ctx, tt.args.postId, tt.args.userId)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeRepository_ListDeleted(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "SELECT likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id FROM public.likes WHERE deleted_at < $1 ORDER BY deleted_at ASC, id ASC LIMIT 100 FOR UPDATE SKIP LOCKED"
+	deletedBefore := time.Now().UTC()
+	like := entities.NewMockLike(t)
+	like.DeletedAt = pointer.Of(deletedBefore.Add(-time.Hour))
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx           context.Context
+		deletedBefore time.Time
+		limit         uint64
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnRows(newLikeRows(t, []entities.Like{like}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &LikeRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.ListDeleted(tt.args.ctx, tt.args.deletedBefore, tt.args.limit)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeRepository_PurgeByPost(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "DELETE FROM public.likes WHERE post_id = $1 RETURNING id, created_at, updated_at, deleted_at, post_id, value, user_id"
+	like := entities.NewMockLike(t)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx    context.Context
+		postId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(like.PostId).
+					WillReturnRows(newLikeRows(t, []entities.Like{like}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: like.PostId,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(like.PostId).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: like.PostId,
+			},
+			want: nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", like.PostId.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &LikeRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.PurgeByPost(tt.args.ctx, tt.args.postId)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeRepository_Upsert(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "INSERT INTO public.likes (id,created_at,updated_at,deleted_at,post_id,value,user_id) VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT (post_id, user_id) WHERE deleted_at IS NULL DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at WHERE likes.value <> EXCLUDED.value RETURNING likes.id, likes.created_at, likes.updated_at, likes.deleted_at, likes.post_id, likes.value, likes.user_id, (xmax = 0) AS created"
+	like := entities.NewMockLike(t)
+	like.DeletedAt = nil
+	stored := like
+	stored.ID = uuid.NewUUID()
+	stored.CreatedAt = like.CreatedAt.Add(-time.Hour)
+	newRows := func(like entities.Like, created bool) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{
+			"id",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+			"post_id",
+			"value",
+			"user_id",
+			"created",
+		}).AddRow(
+			like.ID,
+			like.CreatedAt,
+			like.UpdatedAt,
+			like.DeletedAt,
+			like.PostId,
+			like.Value,
+			like.UserId,
+			created,
+		)
+	}
+	args := []driver.Value{
+		like.ID,
+		like.CreatedAt,
+		like.UpdatedAt,
+		like.DeletedAt,
+		like.PostId,
+		like.Value,
+		like.UserId,
+	}
+	tests := []struct {
+		name        string
+		setup       func()
+		want        entities.Like
+		wantCreated bool
+		wantErr     error
+	}{
+		{
+			name: "created",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnRows(newRows(like, true))
+			},
+			want:        like,
+			wantCreated: true,
+			wantErr:     nil,
+		},
+		{
+			name: "updated",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnRows(newRows(stored, false))
+			},
+			want:        stored,
+			wantCreated: false,
+			wantErr:     nil,
+		},
+		{
+			name: "same value",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnRows(newLikeRows(t, nil))
+			},
+			want:        entities.Like{},
+			wantCreated: false,
+			wantErr: errs.NewAlreadyExistsError().
+				WithParam("post_id", like.PostId.String()).
+				WithParam("user_id", like.UserId.String()),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnError(errors.New("test error"))
+			},
+			want:        entities.Like{},
+			wantCreated: false,
+			wantErr:     errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &LikeRepository{writeDB: mockDB, readDB: mockDB, logger: mockLogger}
+			got, created, err := r.Upsert(ctx, like)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCreated, created)
+		})
+	}
+}
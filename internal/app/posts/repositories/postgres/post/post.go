@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -10,17 +11,24 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type PostRepository struct {
 	readDB  database
 	writeDB database
+	cursors cursorCodec
 	logger  logger
 }
 
-func NewPostRepository(readDB database, writeDB database, logger logger) *PostRepository {
-	return &PostRepository{readDB: readDB, writeDB: writeDB, logger: logger}
+func NewPostRepository(
+	readDB database,
+	writeDB database,
+	cursors cursorCodec,
+	logger logger,
+) *PostRepository {
+	return &PostRepository{readDB: readDB, writeDB: writeDB, cursors: cursors, logger: logger}
 }
 
 var orderByMap = map[entities.PostOrdering]string{
@@ -36,25 +44,78 @@ var orderByMap = map[entities.PostOrdering]string{
 	entities.PostOrderingBodyASC:       "posts.body ASC",
 }
 
+// nullableColumns - columns of the ordering which can be NULL.
+var nullableColumns = []string{"posts.deleted_at"}
+
+// encodeOrderBy - columns of the ordering, the id is added if missing to make
+// the order total for the keyset of the cursor.
 func encodeOrderBy(orderBy []entities.PostOrdering) []string {
-	columns := make([]string, len(orderBy))
-	for i, item := range orderBy {
+	columns := make([]string, 0, len(orderBy)+1)
+	for _, item := range orderBy {
 		column, exists := orderByMap[item]
 		if !exists {
 			continue
 		}
-		columns[i] = column
+		columns = append(columns, column)
+	}
+	if !slices.Contains(orderBy, entities.PostOrderingIdASC) &&
+		!slices.Contains(orderBy, entities.PostOrderingIdDESC) {
+		columns = append(columns, orderByMap[entities.PostOrderingIdASC])
 	}
 	return columns
 }
 
+// encodeFilter - conditions of the filter, List and Count share them.
+func encodeFilter(q sq.SelectBuilder, filter entities.PostFilter) sq.SelectBuilder {
+	if filter.IsDeleted != nil {
+		if *filter.IsDeleted {
+			q = q.Where(sq.NotEq{"posts.deleted_at": nil})
+		} else {
+			q = q.Where(sq.Eq{"posts.deleted_at": nil})
+		}
+	}
+	if len(filter.IDs) > 0 {
+		q = q.Where(sq.Eq{"posts.id": filter.IDs})
+	}
+	if filter.CreatedAfter != nil {
+		q = q.Where(sq.Gt{"posts.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		q = q.Where(sq.Lt{"posts.created_at": *filter.CreatedBefore})
+	}
+	if filter.UpdatedAfter != nil {
+		q = q.Where(sq.Gt{"posts.updated_at": *filter.UpdatedAfter})
+	}
+	if filter.UpdatedBefore != nil {
+		q = q.Where(sq.Lt{"posts.updated_at": *filter.UpdatedBefore})
+	}
+	if len(filter.AuthorIds) > 0 {
+		q = q.Where(sq.Eq{"posts.author_id": filter.AuthorIds})
+	}
+	if filter.Search != nil {
+		q = q.Where(
+			postgres.Search{
+				Lang:   postgres.SearchLang,
+				Query:  *filter.Search,
+				Fields: []string{"body"},
+			},
+		)
+	}
+	return q
+}
+
 type PostDTO struct {
 	ID        uuid.UUID  `db:"id,omitempty"`
 	UpdatedAt time.Time  `db:"updated_at,omitempty"`
 	CreatedAt time.Time  `db:"created_at,omitempty"`
 	DeletedAt *time.Time `db:"deleted_at"`
 	Body      string     `db:"body"`
+	AuthorId  string     `db:"author_id"`
 }
+
+// Table - table of the repository with the columns it uses.
+var Table = postgres.NewTable("public.posts", PostDTO{})
+
 type PostListDTO []PostDTO
 
 func (list PostListDTO) toEntities() []entities.Post {
@@ -71,6 +132,7 @@ func NewPostDTOFromEntity(entity entities.Post) PostDTO {
 		UpdatedAt: entity.UpdatedAt,
 		DeletedAt: entity.DeletedAt,
 		Body:      entity.Body,
+		AuthorId:  entity.AuthorId,
 	}
 	return dto
 }
@@ -81,18 +143,19 @@ func (dto PostDTO) toEntity() entities.Post {
 		UpdatedAt: dto.UpdatedAt,
 		DeletedAt: dto.DeletedAt,
 		Body:      dto.Body,
+		AuthorId:  dto.AuthorId,
 	}
 	return entity
 }
-func (r *PostRepository) Create(ctx context.Context, tx dtx.TX, entity entities.Post) error {
+func (r *PostRepository) Create(ctx context.Context, entity entities.Post) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewPostDTOFromEntity(entity)
 	q := sq.Insert("public.posts").
-		Columns("id", "created_at", "updated_at", "deleted_at", "body").
-		Values(dto.ID, dto.CreatedAt, dto.UpdatedAt, dto.DeletedAt, dto.Body)
+		Columns("id", "created_at", "updated_at", "deleted_at", "body", "author_id").
+		Values(dto.ID, dto.CreatedAt, dto.UpdatedAt, dto.DeletedAt, dto.Body, dto.AuthorId)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if _, err := tx.GetSQLTx().ExecContext(ctx, query, args...); err != nil {
+	if _, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return e
 	}
@@ -102,22 +165,67 @@ func (r *PostRepository) Get(ctx context.Context, id uuid.UUID) (entities.Post,
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := &PostDTO{}
-	q := sq.Select("posts.id", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.body").
+	q := sq.Select("posts.id", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.body", "posts.author_id").
 		From("public.posts").
 		Where(sq.Eq{"id": id}).
 		Limit(1)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.GetContext(ctx, dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err).WithParam("post_id", id.String())
 		return entities.Post{}, e
 	}
 	return dto.toEntity(), nil
 }
 
+// GetForUpdate - the post within the transaction of the context, locked against
+// concurrent changes until the transaction ends.
+func (r *PostRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (entities.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	dto := &PostDTO{}
+	q := sq.Select("posts.id", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.body", "posts.author_id").
+		From("public.posts").
+		Where(sq.Eq{"id": id}).
+		Limit(1).
+		Suffix("FOR UPDATE")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).GetContext(ctx, dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("post_id", id.String())
+		return entities.Post{}, e
+	}
+	return dto.toEntity(), nil
+}
+
+// GetForShare - the post within the transaction of the context, locked against
+// updates and deletion until the transaction ends.
+func (r *PostRepository) GetForShare(
+	ctx context.Context,
+	id uuid.UUID,
+) (entities.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto PostListDTO
+	q := sq.Select("posts.id", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.body", "posts.author_id").
+		From("public.posts").
+		Where(sq.Eq{"id": id}).
+		Limit(1).
+		Suffix("FOR SHARE")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("post_id", id.String())
+		return entities.Post{}, e
+	}
+	if len(dto) == 0 {
+		e := errs.NewEntityNotFoundError().WithParam("post_id", id.String())
+		return entities.Post{}, e
+	}
+	return dto[0].toEntity(), nil
+}
+
 func (r *PostRepository) List(
 	ctx context.Context,
 	filter entities.PostFilter,
-) ([]entities.Post, error) {
+) ([]entities.Post, *string, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	var dto PostListDTO
@@ -125,50 +233,53 @@ func (r *PostRepository) List(
 	if filter.PageSize == nil {
 		filter.PageSize = pointer.Of(pageSize)
 	}
-	q := sq.Select("posts.id", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.body").
+	q := sq.Select("posts.id", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.body", "posts.author_id").
 		From("public.posts").
 		Limit(pageSize)
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"posts.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"posts.deleted_at": nil})
+	q = encodeFilter(q, filter)
+	orderBy := encodeOrderBy(filter.OrderBy)
+	if filter.Cursor != nil {
+		values, err := r.cursors.Decode(*filter.Cursor, orderBy)
+		if err != nil {
+			return nil, nil, err
 		}
-	}
-	if filter.PageNumber != nil && *filter.PageNumber > 1 {
+		q = q.Where(postgres.Keyset{OrderBy: orderBy, Values: values, Nullable: nullableColumns})
+	} else if filter.PageNumber != nil && *filter.PageNumber > 1 {
 		q = q.Offset((*filter.PageNumber - 1) * *filter.PageSize)
 	}
-	q = q.Limit(*filter.PageSize)
-	if len(filter.OrderBy) > 0 {
-		q = q.OrderBy(encodeOrderBy(filter.OrderBy)...)
-	}
+	q = q.Limit(*filter.PageSize + 1).OrderBy(orderBy...)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.SelectContext(ctx, &dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).SelectContext(ctx, &dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
-		return nil, e
+		return nil, nil, e
 	}
-	return dto.toEntities(), nil
+	var nextCursor *string
+	if uint64(len(dto)) > *filter.PageSize {
+		dto = dto[:*filter.PageSize]
+		if len(dto) > 0 {
+			cursor, err := r.cursors.Encode(orderBy, postgres.KeysetValues(dto[len(dto)-1], orderBy))
+			if err != nil {
+				return nil, nil, err
+			}
+			nextCursor = pointer.Of(cursor)
+		}
+	}
+	return dto.toEntities(), nextCursor, nil
 }
 func (r *PostRepository) Count(ctx context.Context, filter entities.PostFilter) (uint64, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Select("count(id)").From("public.posts")
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"posts.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"posts.deleted_at": nil})
-		}
-	}
+	q = encodeFilter(q, filter)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
 	var count uint64
-	if err := r.readDB.GetContext(ctx, &count, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, &count, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return 0, e
 	}
 	return count, nil
 }
-func (r *PostRepository) Update(ctx context.Context, tx dtx.TX, entity entities.Post) error {
+func (r *PostRepository) Update(ctx context.Context, entity entities.Post) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewPostDTOFromEntity(entity)
@@ -180,7 +291,7 @@ func (r *PostRepository) Update(ctx context.Context, tx dtx.TX, entity entities.
 		q = q.Set("body", dto.Body)
 	}
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("post_id", fmt.Sprint(entity.ID))
 		return e
@@ -195,12 +306,12 @@ func (r *PostRepository) Update(ctx context.Context, tx dtx.TX, entity entities.
 	}
 	return nil
 }
-func (r *PostRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID) error {
+func (r *PostRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Delete("public.posts").Where(sq.Eq{"id": id})
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("post_id", fmt.Sprint(id))
 		return e
@@ -216,3 +327,26 @@ func (r *PostRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID) er
 	}
 	return nil
 }
+
+// ListDeleted - the oldest posts soft deleted before the time, locked until the
+// transaction ends and skipped by the concurrent purges.
+func (r *PostRepository) ListDeleted(
+	ctx context.Context,
+	deletedBefore time.Time,
+	limit uint64,
+) ([]entities.Post, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto PostListDTO
+	q := sq.Select("posts.id", "posts.created_at", "posts.updated_at", "posts.deleted_at", "posts.body", "posts.author_id").
+		From("public.posts").
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at ASC", "id ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		return nil, errs.FromPostgresError(err)
+	}
+	return dto.toEntities(), nil
+}
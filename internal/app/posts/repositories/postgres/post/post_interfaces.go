@@ -19,3 +19,9 @@ type database interface {
 	SelectContext(ctx context.Context, dest any, query string, args ...interface {
 	}) error
 }
+
+// cursorCodec - encodes the keysets of the pages into opaque cursors.
+type cursorCodec interface {
+	Encode(orderBy []string, values []any) (string, error)
+	Decode(cursor string, orderBy []string) ([]any, error)
+}
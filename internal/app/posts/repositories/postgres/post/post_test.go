@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jaswdr/faker"
@@ -26,9 +27,15 @@ func TestNewPostRepository(t *testing.T) {
 		return
 	}
 	defer mockDB.Close()
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	type args struct {
 		writeDB database
 		readDB  database
+		cursors cursorCodec
 		logger  logger
 	}
 	tests := []struct {
@@ -43,17 +50,19 @@ func TestNewPostRepository(t *testing.T) {
 			args: args{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 			want: &PostRepository{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			got := NewPostRepository(tt.args.readDB, tt.args.writeDB, tt.args.logger)
+			got := NewPostRepository(tt.args.readDB, tt.args.writeDB, tt.args.cursors, tt.args.logger)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -69,12 +78,16 @@ func TestPostRepository_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
-	query := "INSERT INTO public.posts (id,created_at,updated_at,deleted_at,body) VALUES ($1,$2,$3,$4,$5)"
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "INSERT INTO public.posts (id,created_at,updated_at,deleted_at,body,author_id) VALUES ($1,$2,$3,$4,$5,$6)"
 	post := entities.NewMockPost(t)
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -82,7 +95,6 @@ func TestPostRepository_Create(t *testing.T) {
 	}
 	type args struct {
 		ctx  context.Context
-		tx   dtx.TX
 		post entities.Post
 	}
 	tests := []struct {
@@ -102,6 +114,7 @@ func TestPostRepository_Create(t *testing.T) {
 						post.CreatedAt,
 						post.DeletedAt,
 						post.Body,
+						post.AuthorId,
 					).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
@@ -112,7 +125,6 @@ func TestPostRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				post: post,
 			},
 			wantErr: nil,
@@ -127,6 +139,7 @@ func TestPostRepository_Create(t *testing.T) {
 						post.CreatedAt,
 						post.DeletedAt,
 						post.Body,
+						post.AuthorId,
 					).
 					WillReturnError(errors.New("test error"))
 			},
@@ -137,7 +150,6 @@ func TestPostRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				post: post,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")),
@@ -151,7 +163,7 @@ func TestPostRepository_Create(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Create(tt.args.ctx, tt.args.tx, tt.args.post)
+			err := r.Create(tt.args.ctx, tt.args.post)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -167,7 +179,7 @@ func TestPostRepository_Get(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	query := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body FROM public.posts WHERE id = $1 LIMIT 1"
+	query := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts WHERE id = $1 LIMIT 1"
 	post := entities.NewMockPost(t)
 	ctx := context.Background()
 	type fields struct {
@@ -256,6 +268,199 @@ func TestPostRepository_Get(t *testing.T) {
 	}
 }
 
+func TestPostRepository_GetForUpdate(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mock.ExpectBegin()
+	mockTX, err := dtx.NewManager(mockDB, &dtx.Config{}).NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts WHERE id = $1 LIMIT 1 FOR UPDATE"
+	post := entities.NewMockPost(t)
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				rows := newPostRows(t, []entities.Post{post})
+				mock.ExpectQuery(query).WithArgs(post.ID).WillReturnRows(rows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			want:    post,
+			wantErr: nil,
+		},
+		{
+			name: "unexpected behavior",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(post.ID).WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			want: entities.Post{},
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", post.ID.String()),
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(post.ID).WillReturnError(sql.ErrNoRows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewEntityNotFoundError().WithParam("post_id", post.ID.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &PostRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.GetForUpdate(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPostRepository_GetForShare(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts WHERE id = $1 LIMIT 1 FOR SHARE"
+	post := entities.NewMockPost(t)
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				rows := newPostRows(t, []entities.Post{post})
+				mock.ExpectQuery(query).WithArgs(post.ID).WillReturnRows(rows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			want:    post,
+			wantErr: nil,
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(post.ID).WillReturnError(sql.ErrNoRows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewEntityNotFoundError().WithParam("post_id", post.ID.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &PostRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.GetForShare(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestPostRepository_List(t *testing.T) {
 	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
 	if err != nil {
@@ -266,9 +471,27 @@ func TestPostRepository_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	replicaDB, _, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer replicaDB.Close()
+	mock.ExpectBegin()
+	mockTX, err := dtx.NewManager(mockDB, &dtx.Config{}).NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	txCtx := dtx.WithTX(context.Background(), mockTX)
 	ctx := context.Background()
 	var posts []entities.Post
-	for i := 0; i < faker.New().IntBetween(2, 20); i++ {
+	for i := 0; i < faker.New().IntBetween(2, 10); i++ {
 		posts = append(posts, entities.NewMockPost(t))
 	}
 	filter := entities.PostFilter{
@@ -277,7 +500,34 @@ func TestPostRepository_List(t *testing.T) {
 		Search:     nil,
 		OrderBy:    []entities.PostOrdering{"id"},
 	}
-	query := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body FROM public.posts ORDER BY posts.id ASC LIMIT 10 OFFSET 10"
+	var page []entities.Post
+	for i := 0; i < 11; i++ {
+		page = append(page, entities.NewMockPost(t))
+	}
+	nextCursor, err := cursors.Encode([]string{"posts.id ASC"}, []any{page[9].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	cursor, err := cursors.Encode([]string{"posts.id ASC"}, []any{posts[0].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts ORDER BY posts.id ASC LIMIT 11 OFFSET 10"
+	cursorQuery := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts WHERE ((posts.id > $1)) ORDER BY posts.id ASC LIMIT 11"
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.PostFilter{
+		PageSize:      filter.PageSize,
+		OrderBy:       filter.OrderBy,
+		IDs:           []uuid.UUID{posts[0].ID, posts[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		AuthorIds:     []string{posts[0].AuthorId},
+	}
+	filterQuery := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts WHERE posts.id IN ($1,$2) AND posts.created_at > $3 AND posts.created_at < $4 AND posts.author_id IN ($5) ORDER BY posts.id ASC LIMIT 11"
+	searchQuery := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts WHERE to_tsvector('english', body) @@ websearch_to_tsquery('english', $1) ORDER BY posts.id ASC LIMIT 11 OFFSET 10"
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -288,12 +538,13 @@ func TestPostRepository_List(t *testing.T) {
 		filter entities.PostFilter
 	}
 	tests := []struct {
-		name    string
-		setup   func()
-		fields  fields
-		args    args
-		want    []entities.Post
-		wantErr error
+		name       string
+		setup      func()
+		fields     fields
+		args       args
+		want       []entities.Post
+		wantCursor *string
+		wantErr    error
 	}{
 		{
 			name: "ok",
@@ -313,6 +564,129 @@ func TestPostRepository_List(t *testing.T) {
 			want:    posts,
 			wantErr: nil,
 		},
+		{
+			name: "in transaction",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(newPostRows(t, posts))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  replicaDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    txCtx,
+				filter: filter,
+			},
+			want:    posts,
+			wantErr: nil,
+		},
+		{
+			name: "search",
+			setup: func() {
+				mock.ExpectQuery(searchQuery).
+					WithArgs("my name is").
+					WillReturnRows(newPostRows(t, posts))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.PostFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					Search:     pointer.Of("my name is"),
+					OrderBy:    filter.OrderBy,
+				},
+			},
+			want:    posts,
+			wantErr: nil,
+		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(posts[0].ID, posts[1].ID, createdAfter, createdBefore, posts[0].AuthorId).
+					WillReturnRows(newPostRows(t, posts))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    posts,
+			wantErr: nil,
+		},
+		{
+			name: "next page",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(newPostRows(t, page))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:       page[:10],
+			wantCursor: pointer.Of(nextCursor),
+			wantErr:    nil,
+		},
+		{
+			name: "cursor",
+			setup: func() {
+				mock.ExpectQuery(cursorQuery).
+					WithArgs(posts[0].ID.String()).
+					WillReturnRows(newPostRows(t, posts))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.PostFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					OrderBy:    filter.OrderBy,
+					Cursor:     pointer.Of(cursor),
+				},
+			},
+			want:    posts,
+			wantErr: nil,
+		},
+		{
+			name:  "invalid cursor",
+			setup: func() {},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.PostFilter{
+					PageSize: filter.PageSize,
+					OrderBy:  filter.OrderBy,
+					Cursor:   pointer.Of(cursor + "x"),
+				},
+			},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("cursor", "Invalid cursor."),
+		},
 		{
 			name: "unexpected behavior",
 			setup: func() {
@@ -359,11 +733,13 @@ func TestPostRepository_List(t *testing.T) {
 			r := &PostRepository{
 				writeDB: tt.fields.writeDB,
 				readDB:  tt.fields.readDB,
+				cursors: cursors,
 				logger:  tt.fields.logger,
 			}
-			got, err := r.List(tt.args.ctx, tt.args.filter)
+			got, gotCursor, err := r.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCursor, gotCursor)
 		})
 	}
 }
@@ -378,12 +754,16 @@ func TestPostRepository_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	post := entities.NewMockPost(t)
 	query := `UPDATE public.posts SET created_at = $1, updated_at = $2, deleted_at = $3, body = $4 WHERE id = $5`
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -391,7 +771,6 @@ func TestPostRepository_Update(t *testing.T) {
 	}
 	type args struct {
 		ctx  context.Context
-		tx   dtx.TX
 		post entities.Post
 	}
 	tests := []struct {
@@ -421,7 +800,6 @@ func TestPostRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				post: post,
 			},
 			wantErr: nil,
@@ -446,7 +824,6 @@ func TestPostRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				post: post,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("post_id", post.ID.String()),
@@ -471,7 +848,6 @@ func TestPostRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				post: post,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -497,7 +873,6 @@ func TestPostRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				post: post,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -523,7 +898,6 @@ func TestPostRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:  ctx,
-				tx:   mockTX,
 				post: post,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -538,7 +912,7 @@ func TestPostRepository_Update(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Update(tt.args.ctx, tt.args.tx, tt.args.post)
+			err := r.Update(tt.args.ctx, tt.args.post)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -554,9 +928,14 @@ func TestPostRepository_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	post := entities.NewMockPost(t)
 	type fields struct {
 		writeDB database
@@ -565,7 +944,6 @@ func TestPostRepository_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		id  uuid.UUID
 	}
 	tests := []struct {
@@ -588,8 +966,7 @@ func TestPostRepository_Delete(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  post.ID,
 			},
 			wantErr: nil,
@@ -607,8 +984,7 @@ func TestPostRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  post.ID,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("post_id", post.ID.String()),
@@ -626,8 +1002,7 @@ func TestPostRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  post.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -646,8 +1021,7 @@ func TestPostRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  post.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -662,7 +1036,7 @@ func TestPostRepository_Delete(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Delete(tt.args.ctx, tt.args.tx, tt.args.id)
+			err := r.Delete(tt.args.ctx, tt.args.id)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -678,6 +1052,16 @@ func TestPostRepository_Count(t *testing.T) {
 	query := "SELECT count(id) FROM public.posts"
 	ctx := context.Background()
 	filter := entities.PostFilter{}
+	posts := []entities.Post{entities.NewMockPost(t), entities.NewMockPost(t)}
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.PostFilter{
+		IDs:           []uuid.UUID{posts[0].ID, posts[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		AuthorIds:     []string{posts[0].AuthorId},
+	}
+	filterQuery := "SELECT count(id) FROM public.posts WHERE posts.id IN ($1,$2) AND posts.created_at > $3 AND posts.created_at < $4 AND posts.author_id IN ($5)"
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -713,6 +1097,25 @@ func TestPostRepository_Count(t *testing.T) {
 			want:    1,
 			wantErr: nil,
 		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(posts[0].ID, posts[1].ID, createdAfter, createdBefore, posts[0].AuthorId).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).
+						AddRow(1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    1,
+			wantErr: nil,
+		},
 		{
 			name: "bad return type",
 			setup: func() {
@@ -781,6 +1184,7 @@ func newPostRows(t *testing.T, posts []entities.Post) *sqlmock.Rows {
 		"updated_at",
 		"created_at",
 		"deleted_at",
+		"author_id",
 	})
 	for _, post := range posts {
 		rows.AddRow(
@@ -789,7 +1193,104 @@ func newPostRows(t *testing.T, posts []entities.Post) *sqlmock.Rows {
 			post.UpdatedAt,
 			post.CreatedAt,
 			post.DeletedAt,
+			post.AuthorId,
 		)
 	}
 	return rows
 }
+
+func TestPostRepository_ListDeleted(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "SELECT posts.id, posts.created_at, posts.updated_at, posts.deleted_at, posts.body, posts.author_id FROM public.posts WHERE deleted_at < $1 ORDER BY deleted_at ASC, id ASC LIMIT 100 FOR UPDATE SKIP LOCKED"
+	deletedBefore := time.Now().UTC()
+	post := entities.NewMockPost(t)
+	post.DeletedAt = pointer.Of(deletedBefore.Add(-time.Hour))
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx           context.Context
+		deletedBefore time.Time
+		limit         uint64
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnRows(newPostRows(t, []entities.Post{post}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    []entities.Post{post},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &PostRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.ListDeleted(tt.args.ctx, tt.args.deletedBefore, tt.args.limit)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
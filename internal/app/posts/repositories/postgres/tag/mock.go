@@ -347,3 +347,57 @@ func (mr *MockdatabaseMockRecorder) SelectContext(ctx, dest, query any, args ...
 	varargs := append([]any{ctx, dest, query}, args...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectContext", reflect.TypeOf((*Mockdatabase)(nil).SelectContext), varargs...)
 }
+
+// MockcursorCodec is a mock of cursorCodec interface.
+type MockcursorCodec struct {
+	ctrl     *gomock.Controller
+	recorder *MockcursorCodecMockRecorder
+	isgomock struct{}
+}
+
+// MockcursorCodecMockRecorder is the mock recorder for MockcursorCodec.
+type MockcursorCodecMockRecorder struct {
+	mock *MockcursorCodec
+}
+
+// NewMockcursorCodec creates a new mock instance.
+func NewMockcursorCodec(ctrl *gomock.Controller) *MockcursorCodec {
+	mock := &MockcursorCodec{ctrl: ctrl}
+	mock.recorder = &MockcursorCodecMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcursorCodec) EXPECT() *MockcursorCodecMockRecorder {
+	return m.recorder
+}
+
+// Decode mocks base method.
+func (m *MockcursorCodec) Decode(cursor string, orderBy []string) ([]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decode", cursor, orderBy)
+	ret0, _ := ret[0].([]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decode indicates an expected call of Decode.
+func (mr *MockcursorCodecMockRecorder) Decode(cursor, orderBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decode", reflect.TypeOf((*MockcursorCodec)(nil).Decode), cursor, orderBy)
+}
+
+// Encode mocks base method.
+func (m *MockcursorCodec) Encode(orderBy []string, values []any) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encode", orderBy, values)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encode indicates an expected call of Encode.
+func (mr *MockcursorCodecMockRecorder) Encode(orderBy, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encode", reflect.TypeOf((*MockcursorCodec)(nil).Encode), orderBy, values)
+}
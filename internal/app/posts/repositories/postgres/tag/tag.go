@@ -3,6 +3,8 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -10,17 +12,24 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type TagRepository struct {
 	readDB  database
 	writeDB database
+	cursors cursorCodec
 	logger  logger
 }
 
-func NewTagRepository(readDB database, writeDB database, logger logger) *TagRepository {
-	return &TagRepository{readDB: readDB, writeDB: writeDB, logger: logger}
+func NewTagRepository(
+	readDB database,
+	writeDB database,
+	cursors cursorCodec,
+	logger logger,
+) *TagRepository {
+	return &TagRepository{readDB: readDB, writeDB: writeDB, cursors: cursors, logger: logger}
 }
 
 var orderByMap = map[entities.TagOrdering]string{
@@ -38,18 +47,69 @@ var orderByMap = map[entities.TagOrdering]string{
 	entities.TagOrderingIdASC:         "tags.id ASC",
 }
 
+// nullableColumns - columns of the ordering which can be NULL.
+var nullableColumns = []string{"tags.deleted_at"}
+
+// encodeOrderBy - columns of the ordering, the id is added if missing to make
+// the order total for the keyset of the cursor.
 func encodeOrderBy(orderBy []entities.TagOrdering) []string {
-	columns := make([]string, len(orderBy))
-	for i, item := range orderBy {
+	columns := make([]string, 0, len(orderBy)+1)
+	for _, item := range orderBy {
 		column, exists := orderByMap[item]
 		if !exists {
 			continue
 		}
-		columns[i] = column
+		columns = append(columns, column)
+	}
+	if !slices.Contains(orderBy, entities.TagOrderingIdASC) &&
+		!slices.Contains(orderBy, entities.TagOrderingIdDESC) {
+		columns = append(columns, orderByMap[entities.TagOrderingIdASC])
 	}
 	return columns
 }
 
+// encodeFilter - conditions of the filter, List and Count share them.
+func encodeFilter(q sq.SelectBuilder, filter entities.TagFilter) sq.SelectBuilder {
+	if filter.IsDeleted != nil {
+		if *filter.IsDeleted {
+			q = q.Where(sq.NotEq{"tags.deleted_at": nil})
+		} else {
+			q = q.Where(sq.Eq{"tags.deleted_at": nil})
+		}
+	}
+	if len(filter.IDs) > 0 {
+		q = q.Where(sq.Eq{"tags.id": filter.IDs})
+	}
+	if filter.CreatedAfter != nil {
+		q = q.Where(sq.Gt{"tags.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		q = q.Where(sq.Lt{"tags.created_at": *filter.CreatedBefore})
+	}
+	if filter.UpdatedAfter != nil {
+		q = q.Where(sq.Gt{"tags.updated_at": *filter.UpdatedAfter})
+	}
+	if filter.UpdatedBefore != nil {
+		q = q.Where(sq.Lt{"tags.updated_at": *filter.UpdatedBefore})
+	}
+	if len(filter.PostIds) > 0 {
+		q = q.Where(sq.Eq{"tags.post_id": filter.PostIds})
+	}
+	if len(filter.Values) > 0 {
+		q = q.Where(sq.Eq{"tags.value": filter.Values})
+	}
+	if filter.Search != nil {
+		q = q.Where(
+			postgres.Search{
+				Lang:   postgres.SearchLang,
+				Query:  *filter.Search,
+				Fields: []string{"value"},
+			},
+		)
+	}
+	return q
+}
+
 type TagDTO struct {
 	ID        uuid.UUID  `db:"id,omitempty"`
 	UpdatedAt time.Time  `db:"updated_at,omitempty"`
@@ -58,6 +118,10 @@ type TagDTO struct {
 	PostId    uuid.UUID  `db:"post_id"`
 	Value     string     `db:"value"`
 }
+
+// Table - table of the repository with the columns it uses.
+var Table = postgres.NewTable("public.tags", TagDTO{})
+
 type TagListDTO []TagDTO
 
 func (list TagListDTO) toEntities() []entities.Tag {
@@ -89,7 +153,7 @@ func (dto TagDTO) toEntity() entities.Tag {
 	}
 	return entity
 }
-func (r *TagRepository) Create(ctx context.Context, tx dtx.TX, entity entities.Tag) error {
+func (r *TagRepository) Create(ctx context.Context, entity entities.Tag) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewTagDTOFromEntity(entity)
@@ -97,7 +161,7 @@ func (r *TagRepository) Create(ctx context.Context, tx dtx.TX, entity entities.T
 		Columns("id", "created_at", "updated_at", "deleted_at", "post_id", "value").
 		Values(dto.ID, dto.CreatedAt, dto.UpdatedAt, dto.DeletedAt, dto.PostId, dto.Value)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if _, err := tx.GetSQLTx().ExecContext(ctx, query, args...); err != nil {
+	if _, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return e
 	}
@@ -112,7 +176,26 @@ func (r *TagRepository) Get(ctx context.Context, id uuid.UUID) (entities.Tag, er
 		Where(sq.Eq{"id": id}).
 		Limit(1)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.GetContext(ctx, dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("tag_id", id.String())
+		return entities.Tag{}, e
+	}
+	return dto.toEntity(), nil
+}
+
+// GetForUpdate - the tag within the transaction of the context, locked against
+// concurrent changes until the transaction ends.
+func (r *TagRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (entities.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	dto := &TagDTO{}
+	q := sq.Select("tags.id", "tags.created_at", "tags.updated_at", "tags.deleted_at", "tags.post_id", "tags.value").
+		From("public.tags").
+		Where(sq.Eq{"id": id}).
+		Limit(1).
+		Suffix("FOR UPDATE")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).GetContext(ctx, dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err).WithParam("tag_id", id.String())
 		return entities.Tag{}, e
 	}
@@ -122,7 +205,7 @@ func (r *TagRepository) Get(ctx context.Context, id uuid.UUID) (entities.Tag, er
 func (r *TagRepository) List(
 	ctx context.Context,
 	filter entities.TagFilter,
-) ([]entities.Tag, error) {
+) ([]entities.Tag, *string, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	var dto TagListDTO
@@ -133,22 +216,82 @@ func (r *TagRepository) List(
 	q := sq.Select("tags.id", "tags.created_at", "tags.updated_at", "tags.deleted_at", "tags.post_id", "tags.value").
 		From("public.tags").
 		Limit(pageSize)
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"tags.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"tags.deleted_at": nil})
+	q = encodeFilter(q, filter)
+	orderBy := encodeOrderBy(filter.OrderBy)
+	if filter.Cursor != nil {
+		values, err := r.cursors.Decode(*filter.Cursor, orderBy)
+		if err != nil {
+			return nil, nil, err
 		}
-	}
-	if filter.PageNumber != nil && *filter.PageNumber > 1 {
+		q = q.Where(postgres.Keyset{OrderBy: orderBy, Values: values, Nullable: nullableColumns})
+	} else if filter.PageNumber != nil && *filter.PageNumber > 1 {
 		q = q.Offset((*filter.PageNumber - 1) * *filter.PageSize)
 	}
-	q = q.Limit(*filter.PageSize)
-	if len(filter.OrderBy) > 0 {
-		q = q.OrderBy(encodeOrderBy(filter.OrderBy)...)
+	q = q.Limit(*filter.PageSize + 1).OrderBy(orderBy...)
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.readDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err)
+		return nil, nil, e
 	}
+	var nextCursor *string
+	if uint64(len(dto)) > *filter.PageSize {
+		dto = dto[:*filter.PageSize]
+		if len(dto) > 0 {
+			cursor, err := r.cursors.Encode(orderBy, postgres.KeysetValues(dto[len(dto)-1], orderBy))
+			if err != nil {
+				return nil, nil, err
+			}
+			nextCursor = pointer.Of(cursor)
+		}
+	}
+	return dto.toEntities(), nextCursor, nil
+}
+
+type TagSuggestionDTO struct {
+	Value      string  `db:"value"`
+	Similarity float64 `db:"similarity"`
+	Count      uint64  `db:"count"`
+}
+type TagSuggestionListDTO []TagSuggestionDTO
+
+func (list TagSuggestionListDTO) toEntities() []entities.TagSuggestion {
+	items := make([]entities.TagSuggestion, len(list))
+	for i, dto := range list {
+		items[i] = entities.TagSuggestion{
+			Value:      dto.Value,
+			Similarity: dto.Similarity,
+			Count:      dto.Count,
+		}
+	}
+	return items
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Suggest - distinct values of the tags which start with the prefix or are
+// similar to it, the most similar and the most used first.
+func (r *TagRepository) Suggest(
+	ctx context.Context,
+	prefix string,
+	limit uint64,
+) ([]entities.TagSuggestion, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto TagSuggestionListDTO
+	q := sq.Select("tags.value").
+		Column("word_similarity(?, tags.value) AS similarity", prefix).
+		Column("count(*) AS count").
+		From("public.tags").
+		Where(sq.Eq{"tags.deleted_at": nil}).
+		Where(sq.Or{
+			sq.ILike{"tags.value": likeEscaper.Replace(prefix) + "%"},
+			sq.Expr("? <% tags.value", prefix),
+		}).
+		GroupBy("tags.value").
+		OrderBy("similarity DESC", "count DESC", "tags.value ASC").
+		Limit(limit)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	if err := r.readDB.SelectContext(ctx, &dto, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).SelectContext(ctx, &dto, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return nil, e
 	}
@@ -158,22 +301,16 @@ func (r *TagRepository) Count(ctx context.Context, filter entities.TagFilter) (u
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Select("count(id)").From("public.tags")
-	if filter.IsDeleted != nil {
-		if *filter.IsDeleted {
-			q = q.Where(sq.NotEq{"tags.deleted_at": nil})
-		} else {
-			q = q.Where(sq.Eq{"tags.deleted_at": nil})
-		}
-	}
+	q = encodeFilter(q, filter)
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
 	var count uint64
-	if err := r.readDB.GetContext(ctx, &count, query, args...); err != nil {
+	if err := dtx.Database(ctx, r.readDB).GetContext(ctx, &count, query, args...); err != nil {
 		e := errs.FromPostgresError(err)
 		return 0, e
 	}
 	return count, nil
 }
-func (r *TagRepository) Update(ctx context.Context, tx dtx.TX, entity entities.Tag) error {
+func (r *TagRepository) Update(ctx context.Context, entity entities.Tag) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	dto := NewTagDTOFromEntity(entity)
@@ -186,7 +323,7 @@ func (r *TagRepository) Update(ctx context.Context, tx dtx.TX, entity entities.T
 		q = q.Set("value", dto.Value)
 	}
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("tag_id", fmt.Sprint(entity.ID))
 		return e
@@ -201,12 +338,12 @@ func (r *TagRepository) Update(ctx context.Context, tx dtx.TX, entity entities.T
 	}
 	return nil
 }
-func (r *TagRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID) error {
+func (r *TagRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
 	q := sq.Delete("public.tags").Where(sq.Eq{"id": id})
 	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
-	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	result, err := dtx.Database(ctx, r.writeDB).ExecContext(ctx, query, args...)
 	if err != nil {
 		e := errs.FromPostgresError(err).WithParam("tag_id", fmt.Sprint(id))
 		return e
@@ -222,3 +359,87 @@ func (r *TagRepository) Delete(ctx context.Context, tx dtx.TX, id uuid.UUID) err
 	}
 	return nil
 }
+
+// ListDeleted - the oldest tags soft deleted before the time, locked until the
+// transaction ends and skipped by the concurrent purges.
+func (r *TagRepository) ListDeleted(
+	ctx context.Context,
+	deletedBefore time.Time,
+	limit uint64,
+) ([]entities.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto TagListDTO
+	q := sq.Select("tags.id", "tags.created_at", "tags.updated_at", "tags.deleted_at", "tags.post_id", "tags.value").
+		From("public.tags").
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at ASC", "id ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		return nil, errs.FromPostgresError(err)
+	}
+	return dto.toEntities(), nil
+}
+
+// DeleteByPost - soft deletes the tags of the post which are not deleted yet.
+func (r *TagRepository) DeleteByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+) ([]entities.Tag, error) {
+	q := sq.Update("public.tags").
+		Set("deleted_at", deletedAt).
+		Where(sq.Eq{"post_id": postId}).
+		Where(sq.Eq{"deleted_at": nil}).
+		Suffix("RETURNING id, created_at, updated_at, deleted_at, post_id, value")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	return r.queryByPost(ctx, postId, query, args)
+}
+
+// RestoreByPost - restores the tags of the post deleted at the same time as the
+// post, the ones deleted before stay deleted.
+func (r *TagRepository) RestoreByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+	updatedAt time.Time,
+) ([]entities.Tag, error) {
+	q := sq.Update("public.tags").
+		Set("deleted_at", nil).
+		Set("updated_at", updatedAt).
+		Where(sq.Eq{"post_id": postId}).
+		Where(sq.Eq{"deleted_at": deletedAt}).
+		Suffix("RETURNING id, created_at, updated_at, deleted_at, post_id, value")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	return r.queryByPost(ctx, postId, query, args)
+}
+
+// PurgeByPost - hard deletes the tags of the post along with it.
+func (r *TagRepository) PurgeByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+) ([]entities.Tag, error) {
+	q := sq.Delete("public.tags").
+		Where(sq.Eq{"post_id": postId}).
+		Suffix("RETURNING id, created_at, updated_at, deleted_at, post_id, value")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	return r.queryByPost(ctx, postId, query, args)
+}
+
+func (r *TagRepository) queryByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	query string,
+	args []interface{},
+) ([]entities.Tag, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var dto TagListDTO
+	if err := dtx.Database(ctx, r.writeDB).SelectContext(ctx, &dto, query, args...); err != nil {
+		e := errs.FromPostgresError(err).WithParam("post_id", postId.String())
+		return nil, e
+	}
+	return dto.toEntities(), nil
+}
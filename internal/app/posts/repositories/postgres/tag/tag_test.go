@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jaswdr/faker"
@@ -26,9 +27,15 @@ func TestNewTagRepository(t *testing.T) {
 		return
 	}
 	defer mockDB.Close()
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	type args struct {
 		writeDB database
 		readDB  database
+		cursors cursorCodec
 		logger  logger
 	}
 	tests := []struct {
@@ -43,17 +50,19 @@ func TestNewTagRepository(t *testing.T) {
 			args: args{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 			want: &TagRepository{
 				writeDB: mockDB,
 				readDB:  mockDB,
+				cursors: cursors,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			got := NewTagRepository(tt.args.readDB, tt.args.writeDB, tt.args.logger)
+			got := NewTagRepository(tt.args.readDB, tt.args.writeDB, tt.args.cursors, tt.args.logger)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -69,12 +78,16 @@ func TestTagRepository_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	query := "INSERT INTO public.tags (id,created_at,updated_at,deleted_at,post_id,value) VALUES ($1,$2,$3,$4,$5,$6)"
 	tag := entities.NewMockTag(t)
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -82,7 +95,6 @@ func TestTagRepository_Create(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		tag entities.Tag
 	}
 	tests := []struct {
@@ -113,7 +125,6 @@ func TestTagRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTX,
 				tag: tag,
 			},
 			wantErr: nil,
@@ -139,7 +150,6 @@ func TestTagRepository_Create(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTX,
 				tag: tag,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")),
@@ -153,7 +163,7 @@ func TestTagRepository_Create(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Create(tt.args.ctx, tt.args.tx, tt.args.tag)
+			err := r.Create(tt.args.ctx, tt.args.tag)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -258,6 +268,111 @@ func TestTagRepository_Get(t *testing.T) {
 	}
 }
 
+func TestTagRepository_GetForUpdate(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mock.ExpectBegin()
+	mockTX, err := dtx.NewManager(mockDB, &dtx.Config{}).NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT tags.id, tags.created_at, tags.updated_at, tags.deleted_at, tags.post_id, tags.value FROM public.tags WHERE id = $1 LIMIT 1 FOR UPDATE"
+	tag := entities.NewMockTag(t)
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				rows := newTagRows(t, []entities.Tag{tag})
+				mock.ExpectQuery(query).WithArgs(tag.ID).WillReturnRows(rows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  tag.ID,
+			},
+			want:    tag,
+			wantErr: nil,
+		},
+		{
+			name: "unexpected behavior",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(tag.ID).WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  tag.ID,
+			},
+			want: entities.Tag{},
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("tag_id", tag.ID.String()),
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectQuery(query).WithArgs(tag.ID).WillReturnError(sql.ErrNoRows)
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  tag.ID,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewEntityNotFoundError().WithParam("tag_id", tag.ID.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &TagRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.GetForUpdate(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestTagRepository_List(t *testing.T) {
 	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
 	if err != nil {
@@ -268,9 +383,14 @@ func TestTagRepository_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
+	cursors, err := postgres.NewCursorCodec(&postgres.Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	ctx := context.Background()
 	var tags []entities.Tag
-	for i := 0; i < faker.New().IntBetween(2, 20); i++ {
+	for i := 0; i < faker.New().IntBetween(2, 10); i++ {
 		tags = append(tags, entities.NewMockTag(t))
 	}
 	filter := entities.TagFilter{
@@ -279,7 +399,35 @@ func TestTagRepository_List(t *testing.T) {
 		Search:     nil,
 		OrderBy:    []entities.TagOrdering{"id"},
 	}
-	query := "SELECT tags.id, tags.created_at, tags.updated_at, tags.deleted_at, tags.post_id, tags.value FROM public.tags ORDER BY tags.id ASC LIMIT 10 OFFSET 10"
+	var page []entities.Tag
+	for i := 0; i < 11; i++ {
+		page = append(page, entities.NewMockTag(t))
+	}
+	nextCursor, err := cursors.Encode([]string{"tags.id ASC"}, []any{page[9].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	cursor, err := cursors.Encode([]string{"tags.id ASC"}, []any{tags[0].ID})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT tags.id, tags.created_at, tags.updated_at, tags.deleted_at, tags.post_id, tags.value FROM public.tags ORDER BY tags.id ASC LIMIT 11 OFFSET 10"
+	cursorQuery := "SELECT tags.id, tags.created_at, tags.updated_at, tags.deleted_at, tags.post_id, tags.value FROM public.tags WHERE ((tags.id > $1)) ORDER BY tags.id ASC LIMIT 11"
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.TagFilter{
+		PageSize:      filter.PageSize,
+		OrderBy:       filter.OrderBy,
+		IDs:           []uuid.UUID{tags[0].ID, tags[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		PostIds:       []uuid.UUID{tags[0].PostId},
+		Values:        []string{tags[0].Value},
+	}
+	filterQuery := "SELECT tags.id, tags.created_at, tags.updated_at, tags.deleted_at, tags.post_id, tags.value FROM public.tags WHERE tags.id IN ($1,$2) AND tags.created_at > $3 AND tags.created_at < $4 AND tags.post_id IN ($5) AND tags.value IN ($6) ORDER BY tags.id ASC LIMIT 11"
+	searchQuery := "SELECT tags.id, tags.created_at, tags.updated_at, tags.deleted_at, tags.post_id, tags.value FROM public.tags WHERE to_tsvector('english', value) @@ websearch_to_tsquery('english', $1) ORDER BY tags.id ASC LIMIT 11 OFFSET 10"
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -290,12 +438,13 @@ func TestTagRepository_List(t *testing.T) {
 		filter entities.TagFilter
 	}
 	tests := []struct {
-		name    string
-		setup   func()
-		fields  fields
-		args    args
-		want    []entities.Tag
-		wantErr error
+		name       string
+		setup      func()
+		fields     fields
+		args       args
+		want       []entities.Tag
+		wantCursor *string
+		wantErr    error
 	}{
 		{
 			name: "ok",
@@ -315,6 +464,111 @@ func TestTagRepository_List(t *testing.T) {
 			want:    tags,
 			wantErr: nil,
 		},
+		{
+			name: "search",
+			setup: func() {
+				mock.ExpectQuery(searchQuery).
+					WithArgs("my name is").
+					WillReturnRows(newTagRows(t, tags))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.TagFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					Search:     pointer.Of("my name is"),
+					OrderBy:    filter.OrderBy,
+				},
+			},
+			want:    tags,
+			wantErr: nil,
+		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(tags[0].ID, tags[1].ID, createdAfter, createdBefore, tags[0].PostId, tags[0].Value).
+					WillReturnRows(newTagRows(t, tags))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    tags,
+			wantErr: nil,
+		},
+		{
+			name: "next page",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(newTagRows(t, page))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:       page[:10],
+			wantCursor: pointer.Of(nextCursor),
+			wantErr:    nil,
+		},
+		{
+			name: "cursor",
+			setup: func() {
+				mock.ExpectQuery(cursorQuery).
+					WithArgs(tags[0].ID.String()).
+					WillReturnRows(newTagRows(t, tags))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.TagFilter{
+					PageSize:   filter.PageSize,
+					PageNumber: filter.PageNumber,
+					OrderBy:    filter.OrderBy,
+					Cursor:     pointer.Of(cursor),
+				},
+			},
+			want:    tags,
+			wantErr: nil,
+		},
+		{
+			name:  "invalid cursor",
+			setup: func() {},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				filter: entities.TagFilter{
+					PageSize: filter.PageSize,
+					OrderBy:  filter.OrderBy,
+					Cursor:   pointer.Of(cursor + "x"),
+				},
+			},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("cursor", "Invalid cursor."),
+		},
 		{
 			name: "unexpected behavior",
 			setup: func() {
@@ -361,11 +615,13 @@ func TestTagRepository_List(t *testing.T) {
 			r := &TagRepository{
 				writeDB: tt.fields.writeDB,
 				readDB:  tt.fields.readDB,
+				cursors: cursors,
 				logger:  tt.fields.logger,
 			}
-			got, err := r.List(tt.args.ctx, tt.args.filter)
+			got, gotCursor, err := r.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCursor, gotCursor)
 		})
 	}
 }
@@ -380,12 +636,16 @@ func TestTagRepository_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
 	tag := entities.NewMockTag(t)
 	query := `UPDATE public.tags SET created_at = $1, updated_at = $2, deleted_at = $3, post_id = $4, value = $5 WHERE id = $6`
-	ctx := context.Background()
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -393,7 +653,6 @@ func TestTagRepository_Update(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		tag entities.Tag
 	}
 	tests := []struct {
@@ -424,7 +683,6 @@ func TestTagRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTX,
 				tag: tag,
 			},
 			wantErr: nil,
@@ -450,7 +708,6 @@ func TestTagRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTX,
 				tag: tag,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("tag_id", tag.ID.String()),
@@ -476,7 +733,6 @@ func TestTagRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTX,
 				tag: tag,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -503,7 +759,6 @@ func TestTagRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTX,
 				tag: tag,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -530,7 +785,6 @@ func TestTagRepository_Update(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTX,
 				tag: tag,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -545,7 +799,7 @@ func TestTagRepository_Update(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Update(tt.args.ctx, tt.args.tx, tt.args.tag)
+			err := r.Update(tt.args.ctx, tt.args.tag)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -561,9 +815,14 @@ func TestTagRepository_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLogger := NewMocklogger(ctrl)
-	mockTxManager := dtx.NewManager(mockDB)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
 	mock.ExpectBegin()
-	mockTX := mockTxManager.NewTx()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
 	tag := entities.NewMockTag(t)
 	type fields struct {
 		writeDB database
@@ -572,7 +831,6 @@ func TestTagRepository_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		id  uuid.UUID
 	}
 	tests := []struct {
@@ -595,8 +853,7 @@ func TestTagRepository_Delete(t *testing.T) {
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  tag.ID,
 			},
 			wantErr: nil,
@@ -614,8 +871,7 @@ func TestTagRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  tag.ID,
 			},
 			wantErr: errs.NewEntityNotFoundError().WithParam("tag_id", tag.ID.String()),
@@ -633,8 +889,7 @@ func TestTagRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  tag.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -653,8 +908,7 @@ func TestTagRepository_Delete(t *testing.T) {
 				logger:  mockLogger,
 			},
 			args: args{
-				ctx: context.Background(),
-				tx:  mockTX,
+				ctx: ctx,
 				id:  tag.ID,
 			},
 			wantErr: errs.FromPostgresError(errors.New("test error")).
@@ -669,22 +923,29 @@ func TestTagRepository_Delete(t *testing.T) {
 				readDB:  tt.fields.readDB,
 				logger:  tt.fields.logger,
 			}
-			err := r.Delete(tt.args.ctx, tt.args.tx, tt.args.id)
+			err := r.Delete(tt.args.ctx, tt.args.id)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
 }
 
-func TestTagRepository_Count(t *testing.T) {
+func TestTagRepository_Suggest(t *testing.T) {
 	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
 	if err != nil {
 		t.Fatal(err)
 		return
 	}
 	defer mockDB.Close()
-	query := "SELECT count(id) FROM public.tags"
+	query := `SELECT tags.value, word_similarity($1, tags.value) AS similarity, count(*) AS count FROM public.tags WHERE tags.deleted_at IS NULL AND (tags.value ILIKE $2 OR $3 <% tags.value) GROUP BY tags.value ORDER BY similarity DESC, count DESC, tags.value ASC LIMIT 5`
 	ctx := context.Background()
-	filter := entities.TagFilter{}
+	suggestions := []entities.TagSuggestion{
+		entities.NewMockTagSuggestion(t),
+		entities.NewMockTagSuggestion(t),
+	}
+	rows := sqlmock.NewRows([]string{"value", "similarity", "count"})
+	for _, suggestion := range suggestions {
+		rows.AddRow(suggestion.Value, suggestion.Similarity, suggestion.Count)
+	}
 	type fields struct {
 		writeDB database
 		readDB  database
@@ -692,22 +953,23 @@ func TestTagRepository_Count(t *testing.T) {
 	}
 	type args struct {
 		ctx    context.Context
-		filter entities.TagFilter
+		prefix string
+		limit  uint64
 	}
 	tests := []struct {
 		name    string
 		setup   func()
 		fields  fields
 		args    args
-		want    uint64
+		want    []entities.TagSuggestion
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
 				mock.ExpectQuery(query).
-					WillReturnRows(sqlmock.NewRows([]string{"count"}).
-						AddRow(1))
+					WithArgs("50%_off", `50\%\_off%`, "50%_off").
+					WillReturnRows(rows)
 			},
 			fields: fields{
 				writeDB: mockDB,
@@ -715,17 +977,17 @@ func TestTagRepository_Count(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				filter: filter,
+				prefix: "50%_off",
+				limit:  5,
 			},
-			want:    1,
+			want:    suggestions,
 			wantErr: nil,
 		},
 		{
-			name: "bad return type",
+			name: "database error",
 			setup: func() {
 				mock.ExpectQuery(query).
-					WillReturnRows(sqlmock.NewRows([]string{"count"}).
-						AddRow("one"))
+					WillReturnError(errors.New("test error"))
 			},
 			fields: fields{
 				writeDB: mockDB,
@@ -733,26 +995,136 @@ func TestTagRepository_Count(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				filter: filter,
-			},
-			want: 0,
-			wantErr: &errs.Error{
-				Code:    13,
-				Message: "Unexpected behavior.",
-				Params: errs.Params{
-					{
-						Key:   "error",
-						Value: "sql: Scan error on column index 0, name \"count\": converting driver.Value type string (\"one\") to a uint64: invalid syntax",
-					},
-				},
+				prefix: "postg",
+				limit:  5,
 			},
+			want:    nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
 		},
-		{
-			name: "database error",
-			setup: func() {
-				mock.ExpectQuery(query).
-					WillReturnError(errors.New("test error"))
-			},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &TagRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.Suggest(tt.args.ctx, tt.args.prefix, tt.args.limit)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagRepository_Count(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	query := "SELECT count(id) FROM public.tags"
+	ctx := context.Background()
+	filter := entities.TagFilter{}
+	tags := []entities.Tag{entities.NewMockTag(t), entities.NewMockTag(t)}
+	createdAfter := time.Now().UTC().Add(-time.Hour)
+	createdBefore := time.Now().UTC()
+	fieldsFilter := entities.TagFilter{
+		IDs:           []uuid.UUID{tags[0].ID, tags[1].ID},
+		CreatedAfter:  pointer.Of(createdAfter),
+		CreatedBefore: pointer.Of(createdBefore),
+		PostIds:       []uuid.UUID{tags[0].PostId},
+		Values:        []string{tags[0].Value},
+	}
+	filterQuery := "SELECT count(id) FROM public.tags WHERE tags.id IN ($1,$2) AND tags.created_at > $3 AND tags.created_at < $4 AND tags.post_id IN ($5) AND tags.value IN ($6)"
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx    context.Context
+		filter entities.TagFilter
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    uint64
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).
+						AddRow(1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    1,
+			wantErr: nil,
+		},
+		{
+			name: "fields filter",
+			setup: func() {
+				mock.ExpectQuery(filterQuery).
+					WithArgs(tags[0].ID, tags[1].ID, createdAfter, createdBefore, tags[0].PostId, tags[0].Value).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).
+						AddRow(1))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: fieldsFilter,
+			},
+			want:    1,
+			wantErr: nil,
+		},
+		{
+			name: "bad return type",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).
+						AddRow("one"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want: 0,
+			wantErr: &errs.Error{
+				Code:    13,
+				Message: "Unexpected behavior.",
+				Params: errs.Params{
+					{
+						Key:   "error",
+						Value: "sql: Scan error on column index 0, name \"count\": converting driver.Value type string (\"one\") to a uint64: invalid syntax",
+					},
+				},
+			},
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnError(errors.New("test error"))
+			},
 			fields: fields{
 				writeDB: mockDB,
 				readDB:  mockDB,
@@ -802,3 +1174,395 @@ func newTagRows(t *testing.T, tags []entities.Tag) *sqlmock.Rows {
 	}
 	return rows
 }
+
+func TestTagRepository_DeleteByPost(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "UPDATE public.tags SET deleted_at = $1 WHERE post_id = $2 AND deleted_at IS NULL RETURNING id, created_at, updated_at, deleted_at, post_id, value"
+	deletedAt := time.Now().UTC()
+	tag := entities.NewMockTag(t)
+	tag.DeletedAt = pointer.Of(deletedAt)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedAt, tag.PostId).
+					WillReturnRows(newTagRows(t, []entities.Tag{tag}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedAt, tag.PostId).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+			},
+			want: nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", tag.PostId.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &TagRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.DeleteByPost(tt.args.ctx, tt.args.postId, tt.args.deletedAt)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagRepository_RestoreByPost(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "UPDATE public.tags SET deleted_at = $1, updated_at = $2 WHERE post_id = $3 AND deleted_at = $4 RETURNING id, created_at, updated_at, deleted_at, post_id, value"
+	deletedAt := time.Now().UTC().Add(-time.Hour)
+	updatedAt := time.Now().UTC()
+	tag := entities.NewMockTag(t)
+	tag.DeletedAt = nil
+	tag.UpdatedAt = updatedAt
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+		updatedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(nil, updatedAt, tag.PostId, deletedAt).
+					WillReturnRows(newTagRows(t, []entities.Tag{tag}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+				updatedAt: updatedAt,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(nil, updatedAt, tag.PostId, deletedAt).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+				updatedAt: updatedAt,
+			},
+			want: nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", tag.PostId.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &TagRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.RestoreByPost(
+				tt.args.ctx,
+				tt.args.postId,
+				tt.args.deletedAt,
+				tt.args.updatedAt,
+			)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagRepository_ListDeleted(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "SELECT tags.id, tags.created_at, tags.updated_at, tags.deleted_at, tags.post_id, tags.value FROM public.tags WHERE deleted_at < $1 ORDER BY deleted_at ASC, id ASC LIMIT 100 FOR UPDATE SKIP LOCKED"
+	deletedBefore := time.Now().UTC()
+	tag := entities.NewMockTag(t)
+	tag.DeletedAt = pointer.Of(deletedBefore.Add(-time.Hour))
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx           context.Context
+		deletedBefore time.Time
+		limit         uint64
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnRows(newTagRows(t, []entities.Tag{tag}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(deletedBefore).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:           ctx,
+				deletedBefore: deletedBefore,
+				limit:         100,
+			},
+			want:    nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &TagRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.ListDeleted(tt.args.ctx, tt.args.deletedBefore, tt.args.limit)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagRepository_PurgeByPost(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLogger := NewMocklogger(ctrl)
+	mockTxManager := dtx.NewManager(mockDB, &dtx.Config{})
+	mock.ExpectBegin()
+	mockTX, err := mockTxManager.NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := dtx.WithTX(context.Background(), mockTX)
+	query := "DELETE FROM public.tags WHERE post_id = $1 RETURNING id, created_at, updated_at, deleted_at, post_id, value"
+	tag := entities.NewMockTag(t)
+	type fields struct {
+		writeDB database
+		readDB  database
+		logger  logger
+	}
+	type args struct {
+		ctx    context.Context
+		postId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(tag.PostId).
+					WillReturnRows(newTagRows(t, []entities.Tag{tag}))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: tag.PostId,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(tag.PostId).
+					WillReturnError(errors.New("test error"))
+			},
+			fields: fields{
+				writeDB: mockDB,
+				readDB:  mockDB,
+				logger:  mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: tag.PostId,
+			},
+			want: nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")).
+				WithParam("post_id", tag.PostId.String()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &TagRepository{
+				writeDB: tt.fields.writeDB,
+				readDB:  tt.fields.readDB,
+				logger:  tt.fields.logger,
+			}
+			got, err := r.PurgeByPost(tt.args.ctx, tt.args.postId)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 )
 
 type LikeEventService struct {
@@ -15,8 +15,12 @@ type LikeEventService struct {
 func NewLikeEventService(likeEventProducer likeEventProducer, logger logger) *LikeEventService {
 	return &LikeEventService{likeEventProducer: likeEventProducer, logger: logger}
 }
-func (s *LikeEventService) Send(ctx context.Context, _ dtx.TX, like entities.Like) error {
-	if err := s.likeEventProducer.Send(ctx, like); err != nil {
+func (s *LikeEventService) Send(
+	ctx context.Context,
+	eventType events.Type,
+	like entities.Like,
+) error {
+	if err := s.likeEventProducer.Send(ctx, eventType, like); err != nil {
 		return err
 	}
 	return nil
@@ -6,21 +6,34 @@ import (
 	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	postEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type likeRepository interface {
-	Create(context.Context, dtx.TX, entities.Like) error
+	Create(context.Context, entities.Like) error
+	Upsert(context.Context, entities.Like) (entities.Like, bool, error)
 	Get(context.Context, uuid.UUID) (entities.Like, error)
-	List(context.Context, entities.LikeFilter) ([]entities.Like, error)
+	GetForUpdate(context.Context, uuid.UUID) (entities.Like, error)
+	GetByUser(context.Context, uuid.UUID, uuid.UUID) (entities.Like, error)
+	List(context.Context, entities.LikeFilter) ([]entities.Like, *string, error)
 	Count(context.Context, entities.LikeFilter) (uint64, error)
-	Update(context.Context, dtx.TX, entities.Like) error
-	Delete(context.Context, dtx.TX, uuid.UUID) error
+	Update(context.Context, entities.Like) error
+	Delete(context.Context, uuid.UUID) error
+	DeleteByPost(context.Context, uuid.UUID, time.Time) ([]entities.Like, error)
+	RestoreByPost(context.Context, uuid.UUID, time.Time, time.Time) ([]entities.Like, error)
+	ListDeleted(context.Context, time.Time, uint64) ([]entities.Like, error)
+	PurgeByPost(context.Context, uuid.UUID) ([]entities.Like, error)
+}
+
+// postRepository - posts the likes refer to.
+type postRepository interface {
+	GetForShare(context.Context, uuid.UUID) (postEntities.Post, error)
 }
 type likeEventProducer interface {
-	Send(context.Context, entities.Like) error
+	Send(context.Context, events.Type, entities.Like) error
 }
 
 // clock - clock interface
@@ -2,15 +2,19 @@ package services
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type LikeService struct {
 	likeRepository likeRepository
+	postRepository postRepository
 	clock          clock
 	logger         logger
 	uuid           uuidGenerator
@@ -18,21 +22,30 @@ type LikeService struct {
 
 func NewLikeService(
 	likeRepository likeRepository,
+	postRepository postRepository,
 	clock clock,
 	logger logger,
 	uuid uuidGenerator,
 ) *LikeService {
-	return &LikeService{likeRepository: likeRepository, clock: clock, logger: logger, uuid: uuid}
+	return &LikeService{
+		likeRepository: likeRepository,
+		postRepository: postRepository,
+		clock:          clock,
+		logger:         logger,
+		uuid:           uuid,
+	}
 }
 
 func (s *LikeService) Create(
 	ctx context.Context,
-	tx dtx.TX,
 	create entities.LikeCreate,
 ) (entities.Like, error) {
 	if err := create.Validate(); err != nil {
 		return entities.Like{}, err
 	}
+	if err := s.ensurePost(ctx, create.PostId); err != nil {
+		return entities.Like{}, err
+	}
 	now := s.clock.Now().UTC()
 	like := entities.Like{
 		ID:        s.uuid.NewUUID(),
@@ -42,7 +55,7 @@ func (s *LikeService) Create(
 		Value:     create.Value,
 		UserId:    create.UserId,
 	}
-	if err := s.likeRepository.Create(ctx, tx, like); err != nil {
+	if err := s.likeRepository.Create(ctx, like); err != nil {
 		return entities.Like{}, err
 	}
 	return like, nil
@@ -58,63 +71,205 @@ func (s *LikeService) Get(ctx context.Context, id uuid.UUID) (entities.Like, err
 func (s *LikeService) List(
 	ctx context.Context,
 	filter entities.LikeFilter,
-) ([]entities.Like, uint64, error) {
+) (entities.LikeList, error) {
 	if err := filter.Validate(); err != nil {
-		return nil, 0, err
+		return entities.LikeList{}, err
 	}
-	like, err := s.likeRepository.List(ctx, filter)
+	likes, nextCursor, err := s.likeRepository.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.LikeList{}, err
 	}
-	count, err := s.likeRepository.Count(ctx, filter)
-	if err != nil {
-		return nil, 0, err
+	list := entities.LikeList{Items: likes, Count: nil, NextCursor: nextCursor}
+	if filter.IncludeCount == nil || *filter.IncludeCount {
+		count, err := s.likeRepository.Count(ctx, filter)
+		if err != nil {
+			return entities.LikeList{}, err
+		}
+		list.Count = pointer.Of(count)
 	}
-	return like, count, nil
+	return list, nil
 }
 
 func (s *LikeService) Update(
 	ctx context.Context,
-	tx dtx.TX,
 	update entities.LikeUpdate,
 ) (entities.Like, error) {
 	if err := update.Validate(); err != nil {
 		return entities.Like{}, err
 	}
-	like, err := s.likeRepository.Get(ctx, update.ID)
+	like, err := s.likeRepository.GetForUpdate(ctx, update.ID)
 	if err != nil {
 		return entities.Like{}, err
 	}
 	{
-		if update.PostId != nil {
-			like.PostId = *update.PostId
-		}
 		if update.Value != nil {
 			like.Value = *update.Value
 		}
-		if update.UserId != nil {
-			like.UserId = *update.UserId
-		}
 	}
 	like.UpdatedAt = s.clock.Now().UTC()
-	if err := s.likeRepository.Update(ctx, tx, like); err != nil {
+	if err := s.likeRepository.Update(ctx, like); err != nil {
 		return entities.Like{}, err
 	}
 	return like, nil
 }
 
+// Toggle - creates, updates or soft deletes the like of the user on the post
+// and returns it with the type of the change, the type is empty if nothing
+// changed. The like is empty if the user does not like the post.
+//
+// The like of the user is locked until the transaction ends, a like created by
+// a concurrent toggle is upserted, so concurrent toggles converge on one like.
+func (s *LikeService) Toggle(
+	ctx context.Context,
+	toggle entities.LikeToggle,
+) (entities.Like, events.Type, error) {
+	if err := toggle.Validate(); err != nil {
+		return entities.Like{}, "", err
+	}
+	like, err := s.likeRepository.GetByUser(ctx, toggle.PostId, toggle.UserId)
+	found := true
+	if err != nil {
+		var domainError *errs.Error
+		if !errors.As(err, &domainError) || domainError.Code != errs.ErrorCodeNotFound {
+			return entities.Like{}, "", err
+		}
+		found = false
+	}
+	now := s.clock.Now().UTC()
+	switch {
+	case !toggle.Liked && !found:
+		return entities.Like{}, "", nil
+	case !toggle.Liked:
+		like.UpdatedAt = now
+		like.DeletedAt = pointer.Of(now)
+		if err := s.likeRepository.Update(ctx, like); err != nil {
+			return entities.Like{}, "", err
+		}
+		return like, events.TypeDeleted, nil
+	case found && like.Value == toggle.Value:
+		return like, "", nil
+	case found:
+		like.Value = toggle.Value
+		like.UpdatedAt = now
+		if err := s.likeRepository.Update(ctx, like); err != nil {
+			return entities.Like{}, "", err
+		}
+		return like, events.TypeUpdated, nil
+	}
+	if err := s.ensurePost(ctx, toggle.PostId); err != nil {
+		return entities.Like{}, "", err
+	}
+	like = entities.Like{
+		ID:        s.uuid.NewUUID(),
+		UpdatedAt: now,
+		CreatedAt: now,
+		PostId:    toggle.PostId,
+		Value:     toggle.Value,
+		UserId:    toggle.UserId,
+	}
+	like, created, err := s.likeRepository.Upsert(ctx, like)
+	if err != nil {
+		var domainError *errs.Error
+		if !errors.As(err, &domainError) || domainError.Code != errs.ErrorCodeAlreadyExists {
+			return entities.Like{}, "", err
+		}
+		// A concurrent toggle already liked the post with the same value.
+		like, err := s.likeRepository.GetByUser(ctx, toggle.PostId, toggle.UserId)
+		if err != nil {
+			return entities.Like{}, "", err
+		}
+		return like, "", nil
+	}
+	if !created {
+		return like, events.TypeUpdated, nil
+	}
+	return like, events.TypeCreated, nil
+}
+
 func (s *LikeService) Delete(
 	ctx context.Context,
-	tx dtx.TX,
 	del entities.LikeDelete,
 ) (entities.Like, error) {
-	like, err := s.likeRepository.Get(ctx, del.ID)
+	like, err := s.likeRepository.GetForUpdate(ctx, del.ID)
 	if err != nil {
 		return entities.Like{}, err
 	}
 	like.DeletedAt = pointer.Of(s.clock.Now().UTC())
-	if err := s.likeRepository.Update(ctx, tx, like); err != nil {
+	if err := s.likeRepository.Update(ctx, like); err != nil {
 		return entities.Like{}, err
 	}
 	return like, nil
 }
+
+// Purge - hard deletes a batch of the likes soft deleted before the time.
+func (s *LikeService) Purge(
+	ctx context.Context,
+	purge entities.LikePurge,
+) ([]entities.Like, error) {
+	if err := purge.Validate(); err != nil {
+		return nil, err
+	}
+	likes, err := s.likeRepository.ListDeleted(ctx, purge.DeletedBefore, purge.Limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, like := range likes {
+		if err := s.likeRepository.Delete(ctx, like.ID); err != nil {
+			return nil, err
+		}
+	}
+	return likes, nil
+}
+
+// DeleteByPost - soft deletes the likes of the post along with it.
+func (s *LikeService) DeleteByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+) ([]entities.Like, error) {
+	likes, err := s.likeRepository.DeleteByPost(ctx, postId, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
+
+// RestoreByPost - restores the likes deleted along with the post.
+func (s *LikeService) RestoreByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+) ([]entities.Like, error) {
+	likes, err := s.likeRepository.RestoreByPost(ctx, postId, deletedAt, s.clock.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
+
+// PurgeByPost - hard deletes the likes of the post along with it.
+func (s *LikeService) PurgeByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+) ([]entities.Like, error) {
+	likes, err := s.likeRepository.PurgeByPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
+
+// ensurePost - fails with a failed precondition unless the post exists and is
+// not deleted, the post stays so until the transaction ends.
+func (s *LikeService) ensurePost(ctx context.Context, postId uuid.UUID) error {
+	post, err := s.postRepository.GetForShare(ctx, postId)
+	var domainError *errs.Error
+	switch {
+	case errors.As(err, &domainError) && domainError.Code == errs.ErrorCodeNotFound,
+		err == nil && post.DeletedAt != nil:
+		return errs.NewReferenceNotFoundError().WithParam("post_id", postId.String())
+	case err != nil:
+		return err
+	}
+	return nil
+}
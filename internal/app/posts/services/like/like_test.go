@@ -7,8 +7,9 @@ import (
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	postEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	"github.com/stretchr/testify/assert"
@@ -19,11 +20,13 @@ func TestNewLikeService(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockPostRepository := NewMockpostRepository(ctrl)
 	mockClock := NewMockclock(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockUUID := NewMockuuidGenerator(ctrl)
 	type args struct {
 		likeRepository likeRepository
+		postRepository postRepository
 		clock          clock
 		logger         logger
 		uuid           uuidGenerator
@@ -40,12 +43,14 @@ func TestNewLikeService(t *testing.T) {
 			},
 			args: args{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				clock:          mockClock,
 				logger:         mockLogger,
 				uuid:           mockUUID,
 			},
 			want: &LikeService{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				clock:          mockClock,
 				logger:         mockLogger,
 				uuid:           mockUUID,
@@ -57,6 +62,7 @@ func TestNewLikeService(t *testing.T) {
 			tt.setup()
 			got := NewLikeService(
 				tt.args.likeRepository,
+				tt.args.postRepository,
 				tt.args.clock,
 				tt.args.logger,
 				tt.args.uuid,
@@ -149,7 +155,10 @@ func TestLikeService_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		likes = append(likes, entities.NewMockLike(t))
 	}
+	nextCursor := pointer.Of(faker.New().Lorem().Word())
 	filter := entities.NewMockLikeFilter(t)
+	withoutCount := filter
+	withoutCount.IncludeCount = pointer.Of(false)
 	type fields struct {
 		likeRepository likeRepository
 		logger         logger
@@ -163,14 +172,13 @@ func TestLikeService_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Like
-		want1   uint64
+		want    entities.LikeList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
-				mockLikeRepository.EXPECT().List(ctx, filter).Return(likes, nil)
+				mockLikeRepository.EXPECT().List(ctx, filter).Return(likes, nextCursor, nil)
 				mockLikeRepository.EXPECT().Count(ctx, filter).Return(count, nil)
 			},
 			fields: fields{
@@ -181,8 +189,23 @@ func TestLikeService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    likes,
-			want1:   count,
+			want:    entities.LikeList{Items: likes, Count: pointer.Of(count), NextCursor: nextCursor},
+			wantErr: nil,
+		},
+		{
+			name: "without count",
+			setup: func() {
+				mockLikeRepository.EXPECT().List(ctx, withoutCount).Return(likes, nil, nil)
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: withoutCount,
+			},
+			want:    entities.LikeList{Items: likes, Count: nil, NextCursor: nil},
 			wantErr: nil,
 		},
 		{
@@ -190,7 +213,7 @@ func TestLikeService_List(t *testing.T) {
 			setup: func() {
 				mockLikeRepository.EXPECT().
 					List(ctx, filter).
-					Return([]entities.Like{}, errs.NewUnexpectedBehaviorError("test error"))
+					Return(nil, nil, errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
 				likeRepository: mockLikeRepository,
@@ -200,14 +223,13 @@ func TestLikeService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.LikeList{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 		{
 			name: "count error",
 			setup: func() {
-				mockLikeRepository.EXPECT().List(ctx, filter).Return(likes, nil)
+				mockLikeRepository.EXPECT().List(ctx, filter).Return(likes, nextCursor, nil)
 				mockLikeRepository.EXPECT().
 					Count(ctx, filter).
 					Return(uint64(0), errs.NewUnexpectedBehaviorError("test error"))
@@ -220,8 +242,7 @@ func TestLikeService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.LikeList{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 	}
@@ -232,10 +253,9 @@ func TestLikeService_List(t *testing.T) {
 				likeRepository: tt.fields.likeRepository,
 				logger:         tt.fields.logger,
 			}
-			got, got1, err := u.List(tt.args.ctx, tt.args.filter)
+			got, err := u.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
@@ -244,22 +264,24 @@ func TestLikeService_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockPostRepository := NewMockpostRepository(ctrl)
 	mockClock := NewMockclock(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockUUID := NewMockuuidGenerator(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	create := entities.NewMockLikeCreate(t)
+	post := postEntities.NewMockPost(t)
+	post.DeletedAt = nil
 	now := time.Now().UTC()
 	type fields struct {
 		likeRepository likeRepository
+		postRepository postRepository
 		clock          clock
 		logger         logger
 		uuid           uuidGenerator
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		create entities.LikeCreate
 	}
 	tests := []struct {
@@ -273,6 +295,9 @@ func TestLikeService_Create(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(post, nil)
 				mockClock.EXPECT().Now().Return(now)
 				mockUUID.EXPECT().
 					NewUUID().
@@ -280,7 +305,6 @@ func TestLikeService_Create(t *testing.T) {
 				mockLikeRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Like{
 							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							PostId:    create.PostId,
@@ -294,13 +318,13 @@ func TestLikeService_Create(t *testing.T) {
 			},
 			fields: fields{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				clock:          mockClock,
 				logger:         mockLogger,
 				uuid:           mockUUID,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want: entities.Like{
@@ -316,6 +340,9 @@ func TestLikeService_Create(t *testing.T) {
 		{
 			name: "unexpected behavior",
 			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(post, nil)
 				mockClock.EXPECT().Now().Return(now)
 				mockUUID.EXPECT().
 					NewUUID().
@@ -323,7 +350,6 @@ func TestLikeService_Create(t *testing.T) {
 				mockLikeRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Like{
 							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 							PostId:    create.PostId,
@@ -337,13 +363,76 @@ func TestLikeService_Create(t *testing.T) {
 			},
 			fields: fields{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "post not found",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(postEntities.Post{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewReferenceNotFoundError().WithParam("post_id", create.PostId.String()),
+		},
+		{
+			name: "post deleted",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(postEntities.Post{ID: create.PostId, DeletedAt: pointer.Of(now)}, nil)
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewReferenceNotFoundError().WithParam("post_id", create.PostId.String()),
+		},
+		{
+			name: "post error",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(postEntities.Post{}, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				clock:          mockClock,
 				logger:         mockLogger,
 				uuid:           mockUUID,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want:    entities.Like{},
@@ -355,13 +444,13 @@ func TestLikeService_Create(t *testing.T) {
 			},
 			fields: fields{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				logger:         mockLogger,
 				clock:          mockClock,
 				uuid:           mockUUID,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: entities.LikeCreate{},
 			},
 			want: entities.Like{},
@@ -377,11 +466,12 @@ func TestLikeService_Create(t *testing.T) {
 			tt.setup()
 			u := &LikeService{
 				likeRepository: tt.fields.likeRepository,
+				postRepository: tt.fields.postRepository,
 				clock:          tt.fields.clock,
 				logger:         tt.fields.logger,
 				uuid:           tt.fields.uuid,
 			}
-			got, err := u.Create(tt.args.ctx, tt.args.tx, tt.args.create)
+			got, err := u.Create(tt.args.ctx, tt.args.create)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
@@ -392,11 +482,11 @@ func TestLikeService_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockPostRepository := NewMockpostRepository(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	ctx := context.Background()
 	like := entities.NewMockLike(t)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	update := entities.NewMockLikeUpdate(t)
 	now := time.Now().UTC()
 	updatedLike := entities.Like{
@@ -405,18 +495,18 @@ func TestLikeService_Update(t *testing.T) {
 		DeletedAt: like.DeletedAt,
 		UpdatedAt: now,
 
-		PostId: *update.PostId,
+		PostId: like.PostId,
 		Value:  *update.Value,
-		UserId: *update.UserId,
+		UserId: like.UserId,
 	}
 	type fields struct {
 		likeRepository likeRepository
+		postRepository postRepository
 		clock          clock
 		logger         logger
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		update entities.LikeUpdate
 	}
 	tests := []struct {
@@ -432,18 +522,18 @@ func TestLikeService_Update(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockLikeRepository.EXPECT().
-					Get(ctx, update.ID).Return(like, nil)
+					GetForUpdate(ctx, update.ID).Return(like, nil)
 				mockLikeRepository.EXPECT().
-					Update(ctx, mockTx, updatedLike).Return(nil)
+					Update(ctx, updatedLike).Return(nil)
 			},
 			fields: fields{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				clock:          mockClock,
 				logger:         mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    updatedLike,
@@ -454,20 +544,20 @@ func TestLikeService_Update(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockLikeRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(like, nil)
 				mockLikeRepository.EXPECT().
-					Update(ctx, mockTx, updatedLike).
+					Update(ctx, updatedLike).
 					Return(errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				clock:          mockClock,
 				logger:         mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Like{},
@@ -477,17 +567,17 @@ func TestLikeService_Update(t *testing.T) {
 			name: "Like not found",
 			setup: func() {
 				mockLikeRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(entities.Like{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
 				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
 				clock:          mockClock,
 				logger:         mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Like{},
@@ -499,23 +589,274 @@ func TestLikeService_Update(t *testing.T) {
 			tt.setup()
 			u := &LikeService{
 				likeRepository: tt.fields.likeRepository,
+				postRepository: tt.fields.postRepository,
 				clock:          tt.fields.clock,
 				logger:         tt.fields.logger,
 			}
-			got, err := u.Update(tt.args.ctx, tt.args.tx, tt.args.update)
+			got, err := u.Update(tt.args.ctx, tt.args.update)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func TestLikeService_Toggle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockPostRepository := NewMockpostRepository(ctrl)
+	mockClock := NewMockclock(ctrl)
+	mockUUID := NewMockuuidGenerator(ctrl)
+	ctx := context.Background()
+	toggle := entities.NewMockLikeToggle(t)
+	toggle.Value = "heart"
+	unlike := toggle
+	unlike.Liked = false
+	post := postEntities.NewMockPost(t)
+	post.DeletedAt = nil
+	now := time.Now().UTC()
+	like := entities.NewMockLike(t)
+	like.DeletedAt = nil
+	like.PostId = toggle.PostId
+	like.UserId = toggle.UserId
+	sameLike := like
+	sameLike.Value = toggle.Value
+	updatedLike := like
+	updatedLike.Value = toggle.Value
+	updatedLike.UpdatedAt = now
+	deletedLike := like
+	deletedLike.UpdatedAt = now
+	deletedLike.DeletedAt = pointer.Of(now)
+	createdLike := entities.Like{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		CreatedAt: now,
+		UpdatedAt: now,
+		PostId:    toggle.PostId,
+		Value:     toggle.Value,
+		UserId:    toggle.UserId,
+	}
+	notFound := errs.NewEntityNotFoundError()
+	tests := []struct {
+		name     string
+		setup    func()
+		toggle   entities.LikeToggle
+		want     entities.Like
+		wantType events.Type
+		wantErr  error
+	}{
+		{
+			name: "create",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(entities.Like{}, notFound)
+				mockClock.EXPECT().Now().Return(now)
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, toggle.PostId).
+					Return(post, nil)
+				mockUUID.EXPECT().
+					NewUUID().
+					Return(uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+				mockLikeRepository.EXPECT().
+					Upsert(ctx, createdLike).
+					Return(createdLike, true, nil)
+			},
+			toggle:   toggle,
+			want:     createdLike,
+			wantType: events.TypeCreated,
+			wantErr:  nil,
+		},
+		{
+			name: "concurrent create with another value",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(entities.Like{}, notFound)
+				mockClock.EXPECT().Now().Return(now)
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, toggle.PostId).
+					Return(post, nil)
+				mockUUID.EXPECT().
+					NewUUID().
+					Return(uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+				mockLikeRepository.EXPECT().
+					Upsert(ctx, createdLike).
+					Return(updatedLike, false, nil)
+			},
+			toggle:   toggle,
+			want:     updatedLike,
+			wantType: events.TypeUpdated,
+			wantErr:  nil,
+		},
+		{
+			name: "concurrent create with the same value",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(entities.Like{}, notFound)
+				mockClock.EXPECT().Now().Return(now)
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, toggle.PostId).
+					Return(post, nil)
+				mockUUID.EXPECT().
+					NewUUID().
+					Return(uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+				mockLikeRepository.EXPECT().
+					Upsert(ctx, createdLike).
+					Return(entities.Like{}, false, errs.NewAlreadyExistsError())
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(sameLike, nil)
+			},
+			toggle:   toggle,
+			want:     sameLike,
+			wantType: "",
+			wantErr:  nil,
+		},
+		{
+			name: "upsert error",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(entities.Like{}, notFound)
+				mockClock.EXPECT().Now().Return(now)
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, toggle.PostId).
+					Return(post, nil)
+				mockUUID.EXPECT().
+					NewUUID().
+					Return(uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+				mockLikeRepository.EXPECT().
+					Upsert(ctx, createdLike).
+					Return(entities.Like{}, false, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			toggle:   toggle,
+			want:     entities.Like{},
+			wantType: "",
+			wantErr:  errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "post deleted",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(entities.Like{}, notFound)
+				mockClock.EXPECT().Now().Return(now)
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, toggle.PostId).
+					Return(postEntities.Post{ID: toggle.PostId, DeletedAt: pointer.Of(now)}, nil)
+			},
+			toggle:   toggle,
+			want:     entities.Like{},
+			wantType: "",
+			wantErr:  errs.NewReferenceNotFoundError().WithParam("post_id", toggle.PostId.String()),
+		},
+		{
+			name: "update value",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(like, nil)
+				mockClock.EXPECT().Now().Return(now)
+				mockLikeRepository.EXPECT().
+					Update(ctx, updatedLike).
+					Return(nil)
+			},
+			toggle:   toggle,
+			want:     updatedLike,
+			wantType: events.TypeUpdated,
+			wantErr:  nil,
+		},
+		{
+			name: "already liked",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(sameLike, nil)
+				mockClock.EXPECT().Now().Return(now)
+			},
+			toggle:   toggle,
+			want:     sameLike,
+			wantType: "",
+			wantErr:  nil,
+		},
+		{
+			name: "unlike",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(like, nil)
+				mockClock.EXPECT().Now().Return(now)
+				mockLikeRepository.EXPECT().
+					Update(ctx, deletedLike).
+					Return(nil)
+			},
+			toggle:   unlike,
+			want:     deletedLike,
+			wantType: events.TypeDeleted,
+			wantErr:  nil,
+		},
+		{
+			name: "not liked",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(entities.Like{}, notFound)
+				mockClock.EXPECT().Now().Return(now)
+			},
+			toggle:   unlike,
+			want:     entities.Like{},
+			wantType: "",
+			wantErr:  nil,
+		},
+		{
+			name: "get error",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					GetByUser(ctx, toggle.PostId, toggle.UserId).
+					Return(entities.Like{}, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			toggle:   toggle,
+			want:     entities.Like{},
+			wantType: "",
+			wantErr:  errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name:  "invalid",
+			setup: func() {},
+			toggle: entities.LikeToggle{
+				PostId: toggle.PostId,
+				UserId: toggle.UserId,
+				Liked:  true,
+			},
+			want:     entities.Like{},
+			wantType: "",
+			wantErr:  errs.NewInvalidFormError().WithParam("value", "cannot be blank"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &LikeService{
+				likeRepository: mockLikeRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				uuid:           mockUUID,
+			}
+			got, gotType, err := s.Toggle(ctx, tt.toggle)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantType, gotType)
+		})
+	}
+}
+
 func TestLikeService_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockLikeRepository := NewMocklikeRepository(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	now := time.Now().UTC()
 	like := entities.NewMockLike(t)
@@ -538,7 +879,6 @@ func TestLikeService_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		del entities.LikeDelete
 	}
 	tests := []struct {
@@ -554,10 +894,10 @@ func TestLikeService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockLikeRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(like, nil)
 				mockLikeRepository.EXPECT().
-					Update(ctx, mockTx, deletedLike).
+					Update(ctx, deletedLike).
 					Return(nil)
 			},
 			fields: fields{
@@ -567,7 +907,6 @@ func TestLikeService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    deletedLike,
@@ -578,10 +917,10 @@ func TestLikeService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockLikeRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(like, nil)
 				mockLikeRepository.EXPECT().
-					Update(ctx, mockTx, deletedLike).
+					Update(ctx, deletedLike).
 					Return(errs.NewUnexpectedBehaviorError("test error 12"))
 			},
 			fields: fields{
@@ -591,7 +930,6 @@ func TestLikeService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Like{},
@@ -601,7 +939,7 @@ func TestLikeService_Delete(t *testing.T) {
 			name: "Like not found",
 			setup: func() {
 				mockLikeRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(entities.Like{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
@@ -611,7 +949,6 @@ func TestLikeService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Like{},
@@ -626,9 +963,380 @@ func TestLikeService_Delete(t *testing.T) {
 				logger:         tt.fields.logger,
 				clock:          tt.fields.clock,
 			}
-			got, err := u.Delete(tt.args.ctx, tt.args.tx, tt.args.del)
+			got, err := u.Delete(tt.args.ctx, tt.args.del)
 			assert.Equal(t, tt.want, got)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
 }
+
+func TestLikeService_DeleteByPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	deletedAt := now.Add(-time.Hour)
+	like := entities.NewMockLike(t)
+	type fields struct {
+		likeRepository likeRepository
+		clock          clock
+		logger         logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					DeleteByPost(ctx, like.PostId, deletedAt).
+					Return([]entities.Like{like}, nil)
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					DeleteByPost(ctx, like.PostId, deletedAt).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &LikeService{
+				likeRepository: tt.fields.likeRepository,
+				logger:         tt.fields.logger,
+				clock:          tt.fields.clock,
+			}
+			got, err := s.DeleteByPost(tt.args.ctx, tt.args.postId, tt.args.deletedAt)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeService_RestoreByPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	deletedAt := now.Add(-time.Hour)
+	like := entities.NewMockLike(t)
+	type fields struct {
+		likeRepository likeRepository
+		clock          clock
+		logger         logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockLikeRepository.EXPECT().
+					RestoreByPost(ctx, like.PostId, deletedAt, now).
+					Return([]entities.Like{like}, nil)
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockLikeRepository.EXPECT().
+					RestoreByPost(ctx, like.PostId, deletedAt, now).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    like.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &LikeService{
+				likeRepository: tt.fields.likeRepository,
+				logger:         tt.fields.logger,
+				clock:          tt.fields.clock,
+			}
+			got, err := s.RestoreByPost(tt.args.ctx, tt.args.postId, tt.args.deletedAt)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeService_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	purge := entities.NewMockLikePurge(t)
+	like := entities.NewMockLike(t)
+	like.DeletedAt = pointer.Of(purge.DeletedBefore.Add(-time.Hour))
+	type fields struct {
+		likeRepository likeRepository
+		clock          clock
+		logger         logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.LikePurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return([]entities.Like{like}, nil)
+				mockLikeRepository.EXPECT().Delete(ctx, like.ID).Return(nil)
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "list error",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "delete error",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return([]entities.Like{like}, nil)
+				mockLikeRepository.EXPECT().
+					Delete(ctx, like.ID).
+					Return(errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name:  "invalid",
+			setup: func() {},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: entities.LikePurge{},
+			},
+			want: nil,
+			wantErr: errs.NewInvalidFormError().WithParams(
+				errs.Param{Key: "deleted_before", Value: "cannot be blank"},
+				errs.Param{Key: "limit", Value: "cannot be blank"},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &LikeService{
+				likeRepository: tt.fields.likeRepository,
+				logger:         tt.fields.logger,
+				clock:          tt.fields.clock,
+			}
+			got, err := s.Purge(tt.args.ctx, tt.args.purge)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeService_PurgeByPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeRepository := NewMocklikeRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	like := entities.NewMockLike(t)
+	type fields struct {
+		likeRepository likeRepository
+		clock          clock
+		logger         logger
+	}
+	type args struct {
+		ctx    context.Context
+		postId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					PurgeByPost(ctx, like.PostId).
+					Return([]entities.Like{like}, nil)
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: like.PostId,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockLikeRepository.EXPECT().
+					PurgeByPost(ctx, like.PostId).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				likeRepository: mockLikeRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: like.PostId,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &LikeService{
+				likeRepository: tt.fields.likeRepository,
+				logger:         tt.fields.logger,
+				clock:          tt.fields.clock,
+			}
+			got, err := s.PurgeByPost(tt.args.ctx, tt.args.postId)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
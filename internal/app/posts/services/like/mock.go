@@ -15,7 +15,8 @@ import (
 	time "time"
 
 	like "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	post "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -63,31 +64,46 @@ func (mr *MocklikeRepositoryMockRecorder) Count(arg0, arg1 any) *gomock.Call {
 }
 
 // Create mocks base method.
-func (m *MocklikeRepository) Create(arg0 context.Context, arg1 dtx.TX, arg2 like.Like) error {
+func (m *MocklikeRepository) Create(arg0 context.Context, arg1 like.Like) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MocklikeRepositoryMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocklikeRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocklikeRepository)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocklikeRepository)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MocklikeRepository) Delete(arg0 context.Context, arg1 dtx.TX, arg2 uuid.UUID) error {
+func (m *MocklikeRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MocklikeRepositoryMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocklikeRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocklikeRepository)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocklikeRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByPost mocks base method.
+func (m *MocklikeRepository) DeleteByPost(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByPost indicates an expected call of DeleteByPost.
+func (mr *MocklikeRepositoryMockRecorder) DeleteByPost(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPost", reflect.TypeOf((*MocklikeRepository)(nil).DeleteByPost), arg0, arg1, arg2)
 }
 
 // Get mocks base method.
@@ -105,13 +121,44 @@ func (mr *MocklikeRepositoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocklikeRepository)(nil).Get), arg0, arg1)
 }
 
+// GetByUser mocks base method.
+func (m *MocklikeRepository) GetByUser(arg0 context.Context, arg1, arg2 uuid.UUID) (like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MocklikeRepositoryMockRecorder) GetByUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MocklikeRepository)(nil).GetByUser), arg0, arg1, arg2)
+}
+
+// GetForUpdate mocks base method.
+func (m *MocklikeRepository) GetForUpdate(arg0 context.Context, arg1 uuid.UUID) (like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MocklikeRepositoryMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MocklikeRepository)(nil).GetForUpdate), arg0, arg1)
+}
+
 // List mocks base method.
-func (m *MocklikeRepository) List(arg0 context.Context, arg1 like.LikeFilter) ([]like.Like, error) {
+func (m *MocklikeRepository) List(arg0 context.Context, arg1 like.LikeFilter) ([]like.Like, *string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
 	ret0, _ := ret[0].([]like.Like)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
@@ -120,18 +167,118 @@ func (mr *MocklikeRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocklikeRepository)(nil).List), arg0, arg1)
 }
 
+// ListDeleted mocks base method.
+func (m *MocklikeRepository) ListDeleted(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MocklikeRepositoryMockRecorder) ListDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MocklikeRepository)(nil).ListDeleted), arg0, arg1, arg2)
+}
+
+// PurgeByPost mocks base method.
+func (m *MocklikeRepository) PurgeByPost(arg0 context.Context, arg1 uuid.UUID) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByPost", arg0, arg1)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeByPost indicates an expected call of PurgeByPost.
+func (mr *MocklikeRepositoryMockRecorder) PurgeByPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByPost", reflect.TypeOf((*MocklikeRepository)(nil).PurgeByPost), arg0, arg1)
+}
+
+// RestoreByPost mocks base method.
+func (m *MocklikeRepository) RestoreByPost(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByPost", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByPost indicates an expected call of RestoreByPost.
+func (mr *MocklikeRepositoryMockRecorder) RestoreByPost(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByPost", reflect.TypeOf((*MocklikeRepository)(nil).RestoreByPost), arg0, arg1, arg2, arg3)
+}
+
 // Update mocks base method.
-func (m *MocklikeRepository) Update(arg0 context.Context, arg1 dtx.TX, arg2 like.Like) error {
+func (m *MocklikeRepository) Update(arg0 context.Context, arg1 like.Like) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MocklikeRepositoryMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocklikeRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocklikeRepository)(nil).Update), arg0, arg1)
+}
+
+// Upsert mocks base method.
+func (m *MocklikeRepository) Upsert(arg0 context.Context, arg1 like.Like) (like.Like, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", arg0, arg1)
+	ret0, _ := ret[0].(like.Like)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MocklikeRepositoryMockRecorder) Upsert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MocklikeRepository)(nil).Upsert), arg0, arg1)
+}
+
+// MockpostRepository is a mock of postRepository interface.
+type MockpostRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpostRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpostRepositoryMockRecorder is the mock recorder for MockpostRepository.
+type MockpostRepositoryMockRecorder struct {
+	mock *MockpostRepository
+}
+
+// NewMockpostRepository creates a new mock instance.
+func NewMockpostRepository(ctrl *gomock.Controller) *MockpostRepository {
+	mock := &MockpostRepository{ctrl: ctrl}
+	mock.recorder = &MockpostRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpostRepository) EXPECT() *MockpostRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetForShare mocks base method.
+func (m *MockpostRepository) GetForShare(arg0 context.Context, arg1 uuid.UUID) (post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForShare", arg0, arg1)
+	ret0, _ := ret[0].(post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForShare indicates an expected call of GetForShare.
+func (mr *MockpostRepositoryMockRecorder) GetForShare(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocklikeRepository)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForShare", reflect.TypeOf((*MockpostRepository)(nil).GetForShare), arg0, arg1)
 }
 
 // MocklikeEventProducer is a mock of likeEventProducer interface.
@@ -159,17 +306,17 @@ func (m *MocklikeEventProducer) EXPECT() *MocklikeEventProducerMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MocklikeEventProducer) Send(arg0 context.Context, arg1 like.Like) error {
+func (m *MocklikeEventProducer) Send(arg0 context.Context, arg1 events.Type, arg2 like.Like) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0, arg1)
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Send indicates an expected call of Send.
-func (mr *MocklikeEventProducerMockRecorder) Send(arg0, arg1 any) *gomock.Call {
+func (mr *MocklikeEventProducerMockRecorder) Send(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MocklikeEventProducer)(nil).Send), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MocklikeEventProducer)(nil).Send), arg0, arg1, arg2)
 }
 
 // Mockclock is a mock of clock interface.
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 )
 
 type PostEventService struct {
@@ -15,8 +15,12 @@ type PostEventService struct {
 func NewPostEventService(postEventProducer postEventProducer, logger logger) *PostEventService {
 	return &PostEventService{postEventProducer: postEventProducer, logger: logger}
 }
-func (s *PostEventService) Send(ctx context.Context, _ dtx.TX, post entities.Post) error {
-	if err := s.postEventProducer.Send(ctx, post); err != nil {
+func (s *PostEventService) Send(
+	ctx context.Context,
+	eventType events.Type,
+	post entities.Post,
+) error {
+	if err := s.postEventProducer.Send(ctx, eventType, post); err != nil {
 		return err
 	}
 	return nil
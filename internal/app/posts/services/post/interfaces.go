@@ -6,21 +6,23 @@ import (
 	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type postRepository interface {
-	Create(context.Context, dtx.TX, entities.Post) error
+	Create(context.Context, entities.Post) error
 	Get(context.Context, uuid.UUID) (entities.Post, error)
-	List(context.Context, entities.PostFilter) ([]entities.Post, error)
+	GetForUpdate(context.Context, uuid.UUID) (entities.Post, error)
+	List(context.Context, entities.PostFilter) ([]entities.Post, *string, error)
 	Count(context.Context, entities.PostFilter) (uint64, error)
-	Update(context.Context, dtx.TX, entities.Post) error
-	Delete(context.Context, dtx.TX, uuid.UUID) error
+	Update(context.Context, entities.Post) error
+	Delete(context.Context, uuid.UUID) error
+	ListDeleted(context.Context, time.Time, uint64) ([]entities.Post, error)
 }
 type postEventProducer interface {
-	Send(context.Context, entities.Post) error
+	Send(context.Context, events.Type, entities.Post) error
 }
 
 // clock - clock interface
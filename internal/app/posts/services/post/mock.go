@@ -15,7 +15,7 @@ import (
 	time "time"
 
 	post "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -63,31 +63,31 @@ func (mr *MockpostRepositoryMockRecorder) Count(arg0, arg1 any) *gomock.Call {
 }
 
 // Create mocks base method.
-func (m *MockpostRepository) Create(arg0 context.Context, arg1 dtx.TX, arg2 post.Post) error {
+func (m *MockpostRepository) Create(arg0 context.Context, arg1 post.Post) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockpostRepositoryMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockpostRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpostRepository)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpostRepository)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MockpostRepository) Delete(arg0 context.Context, arg1 dtx.TX, arg2 uuid.UUID) error {
+func (m *MockpostRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockpostRepositoryMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockpostRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockpostRepository)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockpostRepository)(nil).Delete), arg0, arg1)
 }
 
 // Get mocks base method.
@@ -105,13 +105,29 @@ func (mr *MockpostRepositoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockpostRepository)(nil).Get), arg0, arg1)
 }
 
+// GetForUpdate mocks base method.
+func (m *MockpostRepository) GetForUpdate(arg0 context.Context, arg1 uuid.UUID) (post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockpostRepositoryMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockpostRepository)(nil).GetForUpdate), arg0, arg1)
+}
+
 // List mocks base method.
-func (m *MockpostRepository) List(arg0 context.Context, arg1 post.PostFilter) ([]post.Post, error) {
+func (m *MockpostRepository) List(arg0 context.Context, arg1 post.PostFilter) ([]post.Post, *string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
 	ret0, _ := ret[0].([]post.Post)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
@@ -120,18 +136,33 @@ func (mr *MockpostRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpostRepository)(nil).List), arg0, arg1)
 }
 
+// ListDeleted mocks base method.
+func (m *MockpostRepository) ListDeleted(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockpostRepositoryMockRecorder) ListDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockpostRepository)(nil).ListDeleted), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
-func (m *MockpostRepository) Update(arg0 context.Context, arg1 dtx.TX, arg2 post.Post) error {
+func (m *MockpostRepository) Update(arg0 context.Context, arg1 post.Post) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockpostRepositoryMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockpostRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpostRepository)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpostRepository)(nil).Update), arg0, arg1)
 }
 
 // MockpostEventProducer is a mock of postEventProducer interface.
@@ -159,17 +190,17 @@ func (m *MockpostEventProducer) EXPECT() *MockpostEventProducerMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MockpostEventProducer) Send(arg0 context.Context, arg1 post.Post) error {
+func (m *MockpostEventProducer) Send(arg0 context.Context, arg1 events.Type, arg2 post.Post) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0, arg1)
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockpostEventProducerMockRecorder) Send(arg0, arg1 any) *gomock.Call {
+func (mr *MockpostEventProducerMockRecorder) Send(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockpostEventProducer)(nil).Send), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockpostEventProducer)(nil).Send), arg0, arg1, arg2)
 }
 
 // Mockclock is a mock of clock interface.
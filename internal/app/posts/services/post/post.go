@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
@@ -27,15 +27,20 @@ func NewPostService(
 
 func (s *PostService) Create(
 	ctx context.Context,
-	tx dtx.TX,
 	create entities.PostCreate,
 ) (entities.Post, error) {
 	if err := create.Validate(); err != nil {
 		return entities.Post{}, err
 	}
 	now := s.clock.Now().UTC()
-	post := entities.Post{ID: s.uuid.NewUUID(), UpdatedAt: now, CreatedAt: now, Body: create.Body}
-	if err := s.postRepository.Create(ctx, tx, post); err != nil {
+	post := entities.Post{
+		ID:        s.uuid.NewUUID(),
+		UpdatedAt: now,
+		CreatedAt: now,
+		Body:      create.Body,
+		AuthorId:  create.AuthorId,
+	}
+	if err := s.postRepository.Create(ctx, post); err != nil {
 		return entities.Post{}, err
 	}
 	return post, nil
@@ -48,33 +53,46 @@ func (s *PostService) Get(ctx context.Context, id uuid.UUID) (entities.Post, err
 	return post, nil
 }
 
+// GetForUpdate - the post within the transaction of the context, locked against
+// concurrent changes until the transaction ends.
+func (s *PostService) GetForUpdate(ctx context.Context, id uuid.UUID) (entities.Post, error) {
+	post, err := s.postRepository.GetForUpdate(ctx, id)
+	if err != nil {
+		return entities.Post{}, err
+	}
+	return post, nil
+}
+
 func (s *PostService) List(
 	ctx context.Context,
 	filter entities.PostFilter,
-) ([]entities.Post, uint64, error) {
+) (entities.PostList, error) {
 	if err := filter.Validate(); err != nil {
-		return nil, 0, err
+		return entities.PostList{}, err
 	}
-	post, err := s.postRepository.List(ctx, filter)
+	posts, nextCursor, err := s.postRepository.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.PostList{}, err
 	}
-	count, err := s.postRepository.Count(ctx, filter)
-	if err != nil {
-		return nil, 0, err
+	list := entities.PostList{Items: posts, Count: nil, NextCursor: nextCursor}
+	if filter.IncludeCount == nil || *filter.IncludeCount {
+		count, err := s.postRepository.Count(ctx, filter)
+		if err != nil {
+			return entities.PostList{}, err
+		}
+		list.Count = pointer.Of(count)
 	}
-	return post, count, nil
+	return list, nil
 }
 
 func (s *PostService) Update(
 	ctx context.Context,
-	tx dtx.TX,
 	update entities.PostUpdate,
 ) (entities.Post, error) {
 	if err := update.Validate(); err != nil {
 		return entities.Post{}, err
 	}
-	post, err := s.postRepository.Get(ctx, update.ID)
+	post, err := s.postRepository.GetForUpdate(ctx, update.ID)
 	if err != nil {
 		return entities.Post{}, err
 	}
@@ -84,7 +102,7 @@ func (s *PostService) Update(
 		}
 	}
 	post.UpdatedAt = s.clock.Now().UTC()
-	if err := s.postRepository.Update(ctx, tx, post); err != nil {
+	if err := s.postRepository.Update(ctx, post); err != nil {
 		return entities.Post{}, err
 	}
 	return post, nil
@@ -92,15 +110,63 @@ func (s *PostService) Update(
 
 func (s *PostService) Delete(
 	ctx context.Context,
-	tx dtx.TX,
 	del entities.PostDelete,
 ) (entities.Post, error) {
-	post, err := s.postRepository.Get(ctx, del.ID)
+	post, err := s.postRepository.GetForUpdate(ctx, del.ID)
 	if err != nil {
 		return entities.Post{}, err
 	}
 	post.DeletedAt = pointer.Of(s.clock.Now().UTC())
-	if err := s.postRepository.Update(ctx, tx, post); err != nil {
+	if err := s.postRepository.Update(ctx, post); err != nil {
+		return entities.Post{}, err
+	}
+	return post, nil
+}
+
+// ListDeleted - a batch of the posts soft deleted before the time, locked until
+// the transaction ends so they are purged once.
+func (s *PostService) ListDeleted(
+	ctx context.Context,
+	purge entities.PostPurge,
+) ([]entities.Post, error) {
+	if err := purge.Validate(); err != nil {
+		return nil, err
+	}
+	posts, err := s.postRepository.ListDeleted(ctx, purge.DeletedBefore, purge.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// Purge - hard deletes the post, its tags and likes are removed by the cascade
+// of the database, so they are purged first.
+func (s *PostService) Purge(ctx context.Context, id uuid.UUID) error {
+	if err := s.postRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Restore - undoes the soft deletion of the post.
+func (s *PostService) Restore(
+	ctx context.Context,
+	restore entities.PostRestore,
+) (entities.Post, error) {
+	if err := restore.Validate(); err != nil {
+		return entities.Post{}, err
+	}
+	post, err := s.postRepository.GetForUpdate(ctx, restore.ID)
+	if err != nil {
+		return entities.Post{}, err
+	}
+	if post.DeletedAt == nil {
+		return entities.Post{}, errs.NewError(errs.ErrorCodeFailedPrecondition, "Post is not deleted.").
+			WithParam("post_id", restore.ID.String())
+	}
+	post.DeletedAt = nil
+	post.UpdatedAt = s.clock.Now().UTC()
+	if err := s.postRepository.Update(ctx, post); err != nil {
 		return entities.Post{}, err
 	}
 	return post, nil
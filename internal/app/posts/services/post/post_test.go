@@ -7,7 +7,6 @@ import (
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
@@ -138,6 +137,78 @@ func TestPostService_Get(t *testing.T) {
 	}
 }
 
+func TestPostService_GetForUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostRepository := NewMockpostRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	post := entities.NewMockPost(t)
+	type fields struct {
+		postRepository postRepository
+		logger         logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockPostRepository.EXPECT().GetForUpdate(ctx, post.ID).Return(post, nil)
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			want:    post,
+			wantErr: nil,
+		},
+		{
+			name: "Post not found",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForUpdate(ctx, post.ID).
+					Return(entities.Post{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &PostService{
+				postRepository: tt.fields.postRepository,
+				logger:         tt.fields.logger,
+			}
+			got, err := u.GetForUpdate(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestPostService_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -149,7 +220,10 @@ func TestPostService_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		posts = append(posts, entities.NewMockPost(t))
 	}
+	nextCursor := pointer.Of(faker.New().Lorem().Word())
 	filter := entities.NewMockPostFilter(t)
+	withoutCount := filter
+	withoutCount.IncludeCount = pointer.Of(false)
 	type fields struct {
 		postRepository postRepository
 		logger         logger
@@ -163,14 +237,13 @@ func TestPostService_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Post
-		want1   uint64
+		want    entities.PostList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
-				mockPostRepository.EXPECT().List(ctx, filter).Return(posts, nil)
+				mockPostRepository.EXPECT().List(ctx, filter).Return(posts, nextCursor, nil)
 				mockPostRepository.EXPECT().Count(ctx, filter).Return(count, nil)
 			},
 			fields: fields{
@@ -181,8 +254,23 @@ func TestPostService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    posts,
-			want1:   count,
+			want:    entities.PostList{Items: posts, Count: pointer.Of(count), NextCursor: nextCursor},
+			wantErr: nil,
+		},
+		{
+			name: "without count",
+			setup: func() {
+				mockPostRepository.EXPECT().List(ctx, withoutCount).Return(posts, nil, nil)
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: withoutCount,
+			},
+			want:    entities.PostList{Items: posts, Count: nil, NextCursor: nil},
 			wantErr: nil,
 		},
 		{
@@ -190,7 +278,7 @@ func TestPostService_List(t *testing.T) {
 			setup: func() {
 				mockPostRepository.EXPECT().
 					List(ctx, filter).
-					Return([]entities.Post{}, errs.NewUnexpectedBehaviorError("test error"))
+					Return(nil, nil, errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
 				postRepository: mockPostRepository,
@@ -200,14 +288,13 @@ func TestPostService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.PostList{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 		{
 			name: "count error",
 			setup: func() {
-				mockPostRepository.EXPECT().List(ctx, filter).Return(posts, nil)
+				mockPostRepository.EXPECT().List(ctx, filter).Return(posts, nextCursor, nil)
 				mockPostRepository.EXPECT().
 					Count(ctx, filter).
 					Return(uint64(0), errs.NewUnexpectedBehaviorError("test error"))
@@ -220,8 +307,7 @@ func TestPostService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.PostList{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 	}
@@ -232,10 +318,9 @@ func TestPostService_List(t *testing.T) {
 				postRepository: tt.fields.postRepository,
 				logger:         tt.fields.logger,
 			}
-			got, got1, err := u.List(tt.args.ctx, tt.args.filter)
+			got, err := u.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
@@ -247,7 +332,6 @@ func TestPostService_Create(t *testing.T) {
 	mockClock := NewMockclock(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockUUID := NewMockuuidGenerator(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	create := entities.NewMockPostCreate(t)
 	now := time.Now().UTC()
@@ -259,7 +343,6 @@ func TestPostService_Create(t *testing.T) {
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		create entities.PostCreate
 	}
 	tests := []struct {
@@ -280,10 +363,10 @@ func TestPostService_Create(t *testing.T) {
 				mockPostRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Post{
 							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							Body:      create.Body,
+							AuthorId:  create.AuthorId,
 							UpdatedAt: now,
 							CreatedAt: now,
 						},
@@ -298,12 +381,12 @@ func TestPostService_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want: entities.Post{
 				ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 				Body:      create.Body,
+				AuthorId:  create.AuthorId,
 				UpdatedAt: now,
 				CreatedAt: now,
 			},
@@ -319,10 +402,10 @@ func TestPostService_Create(t *testing.T) {
 				mockPostRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Post{
 							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 							Body:      create.Body,
+							AuthorId:  create.AuthorId,
 							UpdatedAt: now,
 							CreatedAt: now,
 						},
@@ -337,7 +420,6 @@ func TestPostService_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want:    entities.Post{},
@@ -355,7 +437,6 @@ func TestPostService_Create(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: entities.PostCreate{},
 			},
 			want: entities.Post{},
@@ -373,7 +454,7 @@ func TestPostService_Create(t *testing.T) {
 				logger:         tt.fields.logger,
 				uuid:           tt.fields.uuid,
 			}
-			got, err := u.Create(tt.args.ctx, tt.args.tx, tt.args.create)
+			got, err := u.Create(tt.args.ctx, tt.args.create)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
@@ -388,7 +469,6 @@ func TestPostService_Update(t *testing.T) {
 	ctx := context.Background()
 	post := entities.NewMockPost(t)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	update := entities.NewMockPostUpdate(t)
 	now := time.Now().UTC()
 	updatedPost := entities.Post{
@@ -397,7 +477,8 @@ func TestPostService_Update(t *testing.T) {
 		DeletedAt: post.DeletedAt,
 		UpdatedAt: now,
 
-		Body: *update.Body,
+		Body:     *update.Body,
+		AuthorId: post.AuthorId,
 	}
 	type fields struct {
 		postRepository postRepository
@@ -406,7 +487,6 @@ func TestPostService_Update(t *testing.T) {
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		update entities.PostUpdate
 	}
 	tests := []struct {
@@ -422,9 +502,9 @@ func TestPostService_Update(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockPostRepository.EXPECT().
-					Get(ctx, update.ID).Return(post, nil)
+					GetForUpdate(ctx, update.ID).Return(post, nil)
 				mockPostRepository.EXPECT().
-					Update(ctx, mockTx, updatedPost).Return(nil)
+					Update(ctx, updatedPost).Return(nil)
 			},
 			fields: fields{
 				postRepository: mockPostRepository,
@@ -433,7 +513,6 @@ func TestPostService_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    updatedPost,
@@ -444,10 +523,10 @@ func TestPostService_Update(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockPostRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(post, nil)
 				mockPostRepository.EXPECT().
-					Update(ctx, mockTx, updatedPost).
+					Update(ctx, updatedPost).
 					Return(errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
@@ -457,7 +536,6 @@ func TestPostService_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Post{},
@@ -467,7 +545,7 @@ func TestPostService_Update(t *testing.T) {
 			name: "Post not found",
 			setup: func() {
 				mockPostRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(entities.Post{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
@@ -477,7 +555,6 @@ func TestPostService_Update(t *testing.T) {
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Post{},
@@ -492,7 +569,7 @@ func TestPostService_Update(t *testing.T) {
 				clock:          tt.fields.clock,
 				logger:         tt.fields.logger,
 			}
-			got, err := u.Update(tt.args.ctx, tt.args.tx, tt.args.update)
+			got, err := u.Update(tt.args.ctx, tt.args.update)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
@@ -505,7 +582,6 @@ func TestPostService_Delete(t *testing.T) {
 	mockPostRepository := NewMockpostRepository(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	now := time.Now().UTC()
 	post := entities.NewMockPost(t)
@@ -515,7 +591,8 @@ func TestPostService_Delete(t *testing.T) {
 		UpdatedAt: post.CreatedAt,
 		DeletedAt: pointer.Of(now),
 
-		Body: post.Body,
+		Body:     post.Body,
+		AuthorId: post.AuthorId,
 	}
 	del := entities.NewMockPostDelete(t)
 	del.ID = post.ID
@@ -526,7 +603,6 @@ func TestPostService_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		del entities.PostDelete
 	}
 	tests := []struct {
@@ -542,10 +618,10 @@ func TestPostService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockPostRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(post, nil)
 				mockPostRepository.EXPECT().
-					Update(ctx, mockTx, deletedPost).
+					Update(ctx, deletedPost).
 					Return(nil)
 			},
 			fields: fields{
@@ -555,7 +631,6 @@ func TestPostService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    deletedPost,
@@ -566,10 +641,10 @@ func TestPostService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockPostRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(post, nil)
 				mockPostRepository.EXPECT().
-					Update(ctx, mockTx, deletedPost).
+					Update(ctx, deletedPost).
 					Return(errs.NewUnexpectedBehaviorError("test error 12"))
 			},
 			fields: fields{
@@ -579,7 +654,6 @@ func TestPostService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Post{},
@@ -589,7 +663,7 @@ func TestPostService_Delete(t *testing.T) {
 			name: "Post not found",
 			setup: func() {
 				mockPostRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(entities.Post{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
@@ -599,7 +673,6 @@ func TestPostService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Post{},
@@ -614,9 +687,310 @@ func TestPostService_Delete(t *testing.T) {
 				logger:         tt.fields.logger,
 				clock:          tt.fields.clock,
 			}
-			got, err := u.Delete(tt.args.ctx, tt.args.tx, tt.args.del)
+			got, err := u.Delete(tt.args.ctx, tt.args.del)
 			assert.Equal(t, tt.want, got)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
 }
+
+func TestPostService_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostRepository := NewMockpostRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	post := entities.NewMockPost(t)
+	post.DeletedAt = pointer.Of(now.Add(-time.Hour))
+	restoredPost := post
+	restoredPost.DeletedAt = nil
+	restoredPost.UpdatedAt = now
+	activePost := restoredPost
+	restore := entities.NewMockPostRestore(t)
+	restore.ID = post.ID
+	type fields struct {
+		postRepository postRepository
+		clock          clock
+		logger         logger
+	}
+	type args struct {
+		ctx     context.Context
+		restore entities.PostRestore
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockPostRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(post, nil)
+				mockPostRepository.EXPECT().
+					Update(ctx, restoredPost).
+					Return(nil)
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    restoredPost,
+			wantErr: nil,
+		},
+		{
+			name: "not deleted",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(activePost, nil)
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want: entities.Post{},
+			wantErr: errs.NewError(errs.ErrorCodeFailedPrecondition, "Post is not deleted.").
+				WithParam("post_id", restore.ID.String()),
+		},
+		{
+			name: "update error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockPostRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(post, nil)
+				mockPostRepository.EXPECT().
+					Update(ctx, restoredPost).
+					Return(errs.NewUnexpectedBehaviorError("test error 13"))
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error 13"),
+		},
+		{
+			name: "Post not found",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForUpdate(ctx, restore.ID).
+					Return(entities.Post{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &PostService{
+				postRepository: tt.fields.postRepository,
+				logger:         tt.fields.logger,
+				clock:          tt.fields.clock,
+			}
+			got, err := u.Restore(tt.args.ctx, tt.args.restore)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPostService_ListDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostRepository := NewMockpostRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	purge := entities.NewMockPostPurge(t)
+	post := entities.NewMockPost(t)
+	post.DeletedAt = pointer.Of(purge.DeletedBefore.Add(-time.Hour))
+	type fields struct {
+		postRepository postRepository
+		clock          clock
+		logger         logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.PostPurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return([]entities.Post{post}, nil)
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Post{post},
+			wantErr: nil,
+		},
+		{
+			name: "list error",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name:  "invalid",
+			setup: func() {},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: entities.PostPurge{},
+			},
+			want: nil,
+			wantErr: errs.NewInvalidFormError().WithParams(
+				errs.Param{Key: "deleted_before", Value: "cannot be blank"},
+				errs.Param{Key: "limit", Value: "cannot be blank"},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &PostService{
+				postRepository: tt.fields.postRepository,
+				logger:         tt.fields.logger,
+				clock:          tt.fields.clock,
+			}
+			got, err := s.ListDeleted(tt.args.ctx, tt.args.purge)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPostService_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostRepository := NewMockpostRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	post := entities.NewMockPost(t)
+	type fields struct {
+		postRepository postRepository
+		logger         logger
+	}
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockPostRepository.EXPECT().Delete(ctx, post.ID).Return(nil)
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "delete error",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					Delete(ctx, post.ID).
+					Return(errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  post.ID,
+			},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &PostService{
+				postRepository: tt.fields.postRepository,
+				logger:         tt.fields.logger,
+			}
+			err := s.Purge(tt.args.ctx, tt.args.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
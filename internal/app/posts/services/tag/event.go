@@ -4,7 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 )
 
 type TagEventService struct {
@@ -15,8 +15,12 @@ type TagEventService struct {
 func NewTagEventService(tagEventProducer tagEventProducer, logger logger) *TagEventService {
 	return &TagEventService{tagEventProducer: tagEventProducer, logger: logger}
 }
-func (s *TagEventService) Send(ctx context.Context, _ dtx.TX, tag entities.Tag) error {
-	if err := s.tagEventProducer.Send(ctx, tag); err != nil {
+func (s *TagEventService) Send(
+	ctx context.Context,
+	eventType events.Type,
+	tag entities.Tag,
+) error {
+	if err := s.tagEventProducer.Send(ctx, eventType, tag); err != nil {
 		return err
 	}
 	return nil
@@ -5,22 +5,34 @@ import (
 	"context"
 	"time"
 
+	postEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type tagRepository interface {
-	Create(context.Context, dtx.TX, entities.Tag) error
+	Create(context.Context, entities.Tag) error
 	Get(context.Context, uuid.UUID) (entities.Tag, error)
-	List(context.Context, entities.TagFilter) ([]entities.Tag, error)
+	GetForUpdate(context.Context, uuid.UUID) (entities.Tag, error)
+	List(context.Context, entities.TagFilter) ([]entities.Tag, *string, error)
 	Count(context.Context, entities.TagFilter) (uint64, error)
-	Update(context.Context, dtx.TX, entities.Tag) error
-	Delete(context.Context, dtx.TX, uuid.UUID) error
+	Suggest(context.Context, string, uint64) ([]entities.TagSuggestion, error)
+	Update(context.Context, entities.Tag) error
+	Delete(context.Context, uuid.UUID) error
+	DeleteByPost(context.Context, uuid.UUID, time.Time) ([]entities.Tag, error)
+	RestoreByPost(context.Context, uuid.UUID, time.Time, time.Time) ([]entities.Tag, error)
+	ListDeleted(context.Context, time.Time, uint64) ([]entities.Tag, error)
+	PurgeByPost(context.Context, uuid.UUID) ([]entities.Tag, error)
+}
+
+// postRepository - posts the tags refer to.
+type postRepository interface {
+	GetForShare(context.Context, uuid.UUID) (postEntities.Post, error)
 }
 type tagEventProducer interface {
-	Send(context.Context, entities.Tag) error
+	Send(context.Context, events.Type, entities.Tag) error
 }
 
 // clock - clock interface
@@ -14,8 +14,9 @@ import (
 	reflect "reflect"
 	time "time"
 
+	post "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
 	tag "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -63,31 +64,46 @@ func (mr *MocktagRepositoryMockRecorder) Count(arg0, arg1 any) *gomock.Call {
 }
 
 // Create mocks base method.
-func (m *MocktagRepository) Create(arg0 context.Context, arg1 dtx.TX, arg2 tag.Tag) error {
+func (m *MocktagRepository) Create(arg0 context.Context, arg1 tag.Tag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MocktagRepositoryMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocktagRepositoryMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktagRepository)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktagRepository)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MocktagRepository) Delete(arg0 context.Context, arg1 dtx.TX, arg2 uuid.UUID) error {
+func (m *MocktagRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MocktagRepositoryMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocktagRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktagRepository)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktagRepository)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByPost mocks base method.
+func (m *MocktagRepository) DeleteByPost(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByPost indicates an expected call of DeleteByPost.
+func (mr *MocktagRepositoryMockRecorder) DeleteByPost(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPost", reflect.TypeOf((*MocktagRepository)(nil).DeleteByPost), arg0, arg1, arg2)
 }
 
 // Get mocks base method.
@@ -105,13 +121,29 @@ func (mr *MocktagRepositoryMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocktagRepository)(nil).Get), arg0, arg1)
 }
 
+// GetForUpdate mocks base method.
+func (m *MocktagRepository) GetForUpdate(arg0 context.Context, arg1 uuid.UUID) (tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MocktagRepositoryMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MocktagRepository)(nil).GetForUpdate), arg0, arg1)
+}
+
 // List mocks base method.
-func (m *MocktagRepository) List(arg0 context.Context, arg1 tag.TagFilter) ([]tag.Tag, error) {
+func (m *MocktagRepository) List(arg0 context.Context, arg1 tag.TagFilter) ([]tag.Tag, *string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
 	ret0, _ := ret[0].([]tag.Tag)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
@@ -120,18 +152,117 @@ func (mr *MocktagRepositoryMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocktagRepository)(nil).List), arg0, arg1)
 }
 
+// ListDeleted mocks base method.
+func (m *MocktagRepository) ListDeleted(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MocktagRepositoryMockRecorder) ListDeleted(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MocktagRepository)(nil).ListDeleted), arg0, arg1, arg2)
+}
+
+// PurgeByPost mocks base method.
+func (m *MocktagRepository) PurgeByPost(arg0 context.Context, arg1 uuid.UUID) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByPost", arg0, arg1)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeByPost indicates an expected call of PurgeByPost.
+func (mr *MocktagRepositoryMockRecorder) PurgeByPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByPost", reflect.TypeOf((*MocktagRepository)(nil).PurgeByPost), arg0, arg1)
+}
+
+// RestoreByPost mocks base method.
+func (m *MocktagRepository) RestoreByPost(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByPost", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByPost indicates an expected call of RestoreByPost.
+func (mr *MocktagRepositoryMockRecorder) RestoreByPost(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByPost", reflect.TypeOf((*MocktagRepository)(nil).RestoreByPost), arg0, arg1, arg2, arg3)
+}
+
+// Suggest mocks base method.
+func (m *MocktagRepository) Suggest(arg0 context.Context, arg1 string, arg2 uint64) ([]tag.TagSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]tag.TagSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MocktagRepositoryMockRecorder) Suggest(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MocktagRepository)(nil).Suggest), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
-func (m *MocktagRepository) Update(arg0 context.Context, arg1 dtx.TX, arg2 tag.Tag) error {
+func (m *MocktagRepository) Update(arg0 context.Context, arg1 tag.Tag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MocktagRepositoryMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocktagRepositoryMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocktagRepository)(nil).Update), arg0, arg1)
+}
+
+// MockpostRepository is a mock of postRepository interface.
+type MockpostRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockpostRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockpostRepositoryMockRecorder is the mock recorder for MockpostRepository.
+type MockpostRepositoryMockRecorder struct {
+	mock *MockpostRepository
+}
+
+// NewMockpostRepository creates a new mock instance.
+func NewMockpostRepository(ctrl *gomock.Controller) *MockpostRepository {
+	mock := &MockpostRepository{ctrl: ctrl}
+	mock.recorder = &MockpostRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpostRepository) EXPECT() *MockpostRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetForShare mocks base method.
+func (m *MockpostRepository) GetForShare(arg0 context.Context, arg1 uuid.UUID) (post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForShare", arg0, arg1)
+	ret0, _ := ret[0].(post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForShare indicates an expected call of GetForShare.
+func (mr *MockpostRepositoryMockRecorder) GetForShare(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocktagRepository)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForShare", reflect.TypeOf((*MockpostRepository)(nil).GetForShare), arg0, arg1)
 }
 
 // MocktagEventProducer is a mock of tagEventProducer interface.
@@ -159,17 +290,17 @@ func (m *MocktagEventProducer) EXPECT() *MocktagEventProducerMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MocktagEventProducer) Send(arg0 context.Context, arg1 tag.Tag) error {
+func (m *MocktagEventProducer) Send(arg0 context.Context, arg1 events.Type, arg2 tag.Tag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0, arg1)
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Send indicates an expected call of Send.
-func (mr *MocktagEventProducerMockRecorder) Send(arg0, arg1 any) *gomock.Call {
+func (mr *MocktagEventProducerMockRecorder) Send(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MocktagEventProducer)(nil).Send), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MocktagEventProducer)(nil).Send), arg0, arg1, arg2)
 }
 
 // Mockclock is a mock of clock interface.
@@ -2,37 +2,50 @@ package services
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type TagService struct {
-	tagRepository tagRepository
-	clock         clock
-	logger        logger
-	uuid          uuidGenerator
+	tagRepository  tagRepository
+	postRepository postRepository
+	clock          clock
+	logger         logger
+	uuid           uuidGenerator
 }
 
 func NewTagService(
 	tagRepository tagRepository,
+	postRepository postRepository,
 	clock clock,
 	logger logger,
 	uuid uuidGenerator,
 ) *TagService {
-	return &TagService{tagRepository: tagRepository, clock: clock, logger: logger, uuid: uuid}
+	return &TagService{
+		tagRepository:  tagRepository,
+		postRepository: postRepository,
+		clock:          clock,
+		logger:         logger,
+		uuid:           uuid,
+	}
 }
 
 func (s *TagService) Create(
 	ctx context.Context,
-	tx dtx.TX,
 	create entities.TagCreate,
 ) (entities.Tag, error) {
 	if err := create.Validate(); err != nil {
 		return entities.Tag{}, err
 	}
+	if err := s.ensurePost(ctx, create.PostId); err != nil {
+		return entities.Tag{}, err
+	}
 	now := s.clock.Now().UTC()
 	tag := entities.Tag{
 		ID:        s.uuid.NewUUID(),
@@ -41,7 +54,7 @@ func (s *TagService) Create(
 		PostId:    create.PostId,
 		Value:     create.Value,
 	}
-	if err := s.tagRepository.Create(ctx, tx, tag); err != nil {
+	if err := s.tagRepository.Create(ctx, tag); err != nil {
 		return entities.Tag{}, err
 	}
 	return tag, nil
@@ -57,35 +70,60 @@ func (s *TagService) Get(ctx context.Context, id uuid.UUID) (entities.Tag, error
 func (s *TagService) List(
 	ctx context.Context,
 	filter entities.TagFilter,
-) ([]entities.Tag, uint64, error) {
+) (entities.TagList, error) {
 	if err := filter.Validate(); err != nil {
-		return nil, 0, err
+		return entities.TagList{}, err
 	}
-	tag, err := s.tagRepository.List(ctx, filter)
+	tags, nextCursor, err := s.tagRepository.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.TagList{}, err
+	}
+	list := entities.TagList{Items: tags, Count: nil, NextCursor: nextCursor}
+	if filter.IncludeCount == nil || *filter.IncludeCount {
+		count, err := s.tagRepository.Count(ctx, filter)
+		if err != nil {
+			return entities.TagList{}, err
+		}
+		list.Count = pointer.Of(count)
+	}
+	return list, nil
+}
+
+// Suggest - suggestions of the tag values for the typed prefix.
+func (s *TagService) Suggest(
+	ctx context.Context,
+	suggest entities.TagSuggest,
+) ([]entities.TagSuggestion, error) {
+	suggest.Prefix = strings.TrimSpace(suggest.Prefix)
+	if err := suggest.Validate(); err != nil {
+		return nil, err
 	}
-	count, err := s.tagRepository.Count(ctx, filter)
+	if suggest.Limit == 0 {
+		suggest.Limit = entities.TagSuggestLimit
+	}
+	suggestions, err := s.tagRepository.Suggest(ctx, suggest.Prefix, suggest.Limit)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
-	return tag, count, nil
+	return suggestions, nil
 }
 
 func (s *TagService) Update(
 	ctx context.Context,
-	tx dtx.TX,
 	update entities.TagUpdate,
 ) (entities.Tag, error) {
 	if err := update.Validate(); err != nil {
 		return entities.Tag{}, err
 	}
-	tag, err := s.tagRepository.Get(ctx, update.ID)
+	tag, err := s.tagRepository.GetForUpdate(ctx, update.ID)
 	if err != nil {
 		return entities.Tag{}, err
 	}
 	{
-		if update.PostId != nil {
+		if update.PostId != nil && *update.PostId != tag.PostId {
+			if err := s.ensurePost(ctx, *update.PostId); err != nil {
+				return entities.Tag{}, err
+			}
 			tag.PostId = *update.PostId
 		}
 		if update.Value != nil {
@@ -93,7 +131,7 @@ func (s *TagService) Update(
 		}
 	}
 	tag.UpdatedAt = s.clock.Now().UTC()
-	if err := s.tagRepository.Update(ctx, tx, tag); err != nil {
+	if err := s.tagRepository.Update(ctx, tag); err != nil {
 		return entities.Tag{}, err
 	}
 	return tag, nil
@@ -101,16 +139,88 @@ func (s *TagService) Update(
 
 func (s *TagService) Delete(
 	ctx context.Context,
-	tx dtx.TX,
 	del entities.TagDelete,
 ) (entities.Tag, error) {
-	tag, err := s.tagRepository.Get(ctx, del.ID)
+	tag, err := s.tagRepository.GetForUpdate(ctx, del.ID)
 	if err != nil {
 		return entities.Tag{}, err
 	}
 	tag.DeletedAt = pointer.Of(s.clock.Now().UTC())
-	if err := s.tagRepository.Update(ctx, tx, tag); err != nil {
+	if err := s.tagRepository.Update(ctx, tag); err != nil {
 		return entities.Tag{}, err
 	}
 	return tag, nil
 }
+
+// Purge - hard deletes a batch of the tags soft deleted before the time.
+func (s *TagService) Purge(
+	ctx context.Context,
+	purge entities.TagPurge,
+) ([]entities.Tag, error) {
+	if err := purge.Validate(); err != nil {
+		return nil, err
+	}
+	tags, err := s.tagRepository.ListDeleted(ctx, purge.DeletedBefore, purge.Limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if err := s.tagRepository.Delete(ctx, tag.ID); err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
+
+// DeleteByPost - soft deletes the tags of the post along with it.
+func (s *TagService) DeleteByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+) ([]entities.Tag, error) {
+	tags, err := s.tagRepository.DeleteByPost(ctx, postId, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// RestoreByPost - restores the tags deleted along with the post.
+func (s *TagService) RestoreByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+	deletedAt time.Time,
+) ([]entities.Tag, error) {
+	tags, err := s.tagRepository.RestoreByPost(ctx, postId, deletedAt, s.clock.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// PurgeByPost - hard deletes the tags of the post along with it.
+func (s *TagService) PurgeByPost(
+	ctx context.Context,
+	postId uuid.UUID,
+) ([]entities.Tag, error) {
+	tags, err := s.tagRepository.PurgeByPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ensurePost - fails with a failed precondition unless the post exists and is
+// not deleted, the post stays so until the transaction ends.
+func (s *TagService) ensurePost(ctx context.Context, postId uuid.UUID) error {
+	post, err := s.postRepository.GetForShare(ctx, postId)
+	var domainError *errs.Error
+	switch {
+	case errors.As(err, &domainError) && domainError.Code == errs.ErrorCodeNotFound,
+		err == nil && post.DeletedAt != nil:
+		return errs.NewReferenceNotFoundError().WithParam("post_id", postId.String())
+	case err != nil:
+		return err
+	}
+	return nil
+}
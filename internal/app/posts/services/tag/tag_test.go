@@ -6,8 +6,8 @@ import (
 	"time"
 
 	"github.com/jaswdr/faker"
+	postEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
@@ -19,14 +19,16 @@ func TestNewTagService(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockTagRepository := NewMocktagRepository(ctrl)
+	mockPostRepository := NewMockpostRepository(ctrl)
 	mockClock := NewMockclock(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockUUID := NewMockuuidGenerator(ctrl)
 	type args struct {
-		tagRepository tagRepository
-		clock         clock
-		logger        logger
-		uuid          uuidGenerator
+		tagRepository  tagRepository
+		postRepository postRepository
+		clock          clock
+		logger         logger
+		uuid           uuidGenerator
 	}
 	tests := []struct {
 		name  string
@@ -39,23 +41,25 @@ func TestNewTagService(t *testing.T) {
 			setup: func() {
 			},
 			args: args{
-				tagRepository: mockTagRepository,
-				clock:         mockClock,
-				logger:        mockLogger,
-				uuid:          mockUUID,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
 			},
 			want: &TagService{
-				tagRepository: mockTagRepository,
-				clock:         mockClock,
-				logger:        mockLogger,
-				uuid:          mockUUID,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			got := NewTagService(tt.args.tagRepository, tt.args.clock, tt.args.logger, tt.args.uuid)
+			got := NewTagService(tt.args.tagRepository, tt.args.postRepository, tt.args.clock, tt.args.logger, tt.args.uuid)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -144,7 +148,10 @@ func TestTagService_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		tags = append(tags, entities.NewMockTag(t))
 	}
+	nextCursor := pointer.Of(faker.New().Lorem().Word())
 	filter := entities.NewMockTagFilter(t)
+	withoutCount := filter
+	withoutCount.IncludeCount = pointer.Of(false)
 	type fields struct {
 		tagRepository tagRepository
 		logger        logger
@@ -158,14 +165,13 @@ func TestTagService_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Tag
-		want1   uint64
+		want    entities.TagList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
-				mockTagRepository.EXPECT().List(ctx, filter).Return(tags, nil)
+				mockTagRepository.EXPECT().List(ctx, filter).Return(tags, nextCursor, nil)
 				mockTagRepository.EXPECT().Count(ctx, filter).Return(count, nil)
 			},
 			fields: fields{
@@ -176,8 +182,23 @@ func TestTagService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    tags,
-			want1:   count,
+			want:    entities.TagList{Items: tags, Count: pointer.Of(count), NextCursor: nextCursor},
+			wantErr: nil,
+		},
+		{
+			name: "without count",
+			setup: func() {
+				mockTagRepository.EXPECT().List(ctx, withoutCount).Return(tags, nil, nil)
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: withoutCount,
+			},
+			want:    entities.TagList{Items: tags, Count: nil, NextCursor: nil},
 			wantErr: nil,
 		},
 		{
@@ -185,7 +206,7 @@ func TestTagService_List(t *testing.T) {
 			setup: func() {
 				mockTagRepository.EXPECT().
 					List(ctx, filter).
-					Return([]entities.Tag{}, errs.NewUnexpectedBehaviorError("test error"))
+					Return(nil, nil, errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
 				tagRepository: mockTagRepository,
@@ -195,14 +216,13 @@ func TestTagService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.TagList{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 		{
 			name: "count error",
 			setup: func() {
-				mockTagRepository.EXPECT().List(ctx, filter).Return(tags, nil)
+				mockTagRepository.EXPECT().List(ctx, filter).Return(tags, nextCursor, nil)
 				mockTagRepository.EXPECT().
 					Count(ctx, filter).
 					Return(uint64(0), errs.NewUnexpectedBehaviorError("test error"))
@@ -215,8 +235,128 @@ func TestTagService_List(t *testing.T) {
 				ctx:    ctx,
 				filter: filter,
 			},
+			want:    entities.TagList{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &TagService{
+				tagRepository: tt.fields.tagRepository,
+				logger:        tt.fields.logger,
+			}
+			got, err := u.List(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagService_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagRepository := NewMocktagRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	ctx := context.Background()
+	suggestions := []entities.TagSuggestion{
+		entities.NewMockTagSuggestion(t),
+		entities.NewMockTagSuggestion(t),
+	}
+	type fields struct {
+		tagRepository tagRepository
+		logger        logger
+	}
+	type args struct {
+		ctx     context.Context
+		suggest entities.TagSuggest
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.TagSuggestion
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockTagRepository.EXPECT().Suggest(ctx, "postg", uint64(5)).Return(suggestions, nil)
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: " postg ", Limit: 5},
+			},
+			want:    suggestions,
+			wantErr: nil,
+		},
+		{
+			name: "default limit",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					Suggest(ctx, "postg", entities.TagSuggestLimit).
+					Return(suggestions, nil)
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: "postg", Limit: 0},
+			},
+			want:    suggestions,
+			wantErr: nil,
+		},
+		{
+			name:  "blank prefix",
+			setup: func() {},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: "  ", Limit: 5},
+			},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("prefix", "cannot be blank"),
+		},
+		{
+			name:  "limit is too big",
+			setup: func() {},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: "postg", Limit: entities.TagSuggestMaxLimit + 1},
+			},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("limit", "must be no greater than 50"),
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					Suggest(ctx, "postg", uint64(5)).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: "postg", Limit: 5},
+			},
 			want:    nil,
-			want1:   0,
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
 	}
@@ -227,10 +367,9 @@ func TestTagService_List(t *testing.T) {
 				tagRepository: tt.fields.tagRepository,
 				logger:        tt.fields.logger,
 			}
-			got, got1, err := u.List(tt.args.ctx, tt.args.filter)
+			got, err := u.Suggest(tt.args.ctx, tt.args.suggest)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
@@ -239,22 +378,24 @@ func TestTagService_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockTagRepository := NewMocktagRepository(ctrl)
+	mockPostRepository := NewMockpostRepository(ctrl)
 	mockClock := NewMockclock(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockUUID := NewMockuuidGenerator(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	create := entities.NewMockTagCreate(t)
+	post := postEntities.NewMockPost(t)
+	post.DeletedAt = nil
 	now := time.Now().UTC()
 	type fields struct {
-		tagRepository tagRepository
-		clock         clock
-		logger        logger
-		uuid          uuidGenerator
+		tagRepository  tagRepository
+		postRepository postRepository
+		clock          clock
+		logger         logger
+		uuid           uuidGenerator
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		create entities.TagCreate
 	}
 	tests := []struct {
@@ -268,6 +409,9 @@ func TestTagService_Create(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(post, nil)
 				mockClock.EXPECT().Now().Return(now)
 				mockUUID.EXPECT().
 					NewUUID().
@@ -275,7 +419,6 @@ func TestTagService_Create(t *testing.T) {
 				mockTagRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Tag{
 							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
 							PostId:    create.PostId,
@@ -287,14 +430,14 @@ func TestTagService_Create(t *testing.T) {
 					Return(nil)
 			},
 			fields: fields{
-				tagRepository: mockTagRepository,
-				clock:         mockClock,
-				logger:        mockLogger,
-				uuid:          mockUUID,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want: entities.Tag{
@@ -309,6 +452,9 @@ func TestTagService_Create(t *testing.T) {
 		{
 			name: "unexpected behavior",
 			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(post, nil)
 				mockClock.EXPECT().Now().Return(now)
 				mockUUID.EXPECT().
 					NewUUID().
@@ -316,7 +462,6 @@ func TestTagService_Create(t *testing.T) {
 				mockTagRepository.EXPECT().
 					Create(
 						ctx,
-						mockTx,
 						entities.Tag{
 							ID:        uuid.MustParse("00000000-0000-0000-0000-000000000002"),
 							PostId:    create.PostId,
@@ -328,14 +473,77 @@ func TestTagService_Create(t *testing.T) {
 					Return(errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
-				tagRepository: mockTagRepository,
-				clock:         mockClock,
-				logger:        mockLogger,
-				uuid:          mockUUID,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "post not found",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(postEntities.Post{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewReferenceNotFoundError().WithParam("post_id", create.PostId.String()),
+		},
+		{
+			name: "post deleted",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(postEntities.Post{ID: create.PostId, DeletedAt: pointer.Of(now)}, nil)
+			},
+			fields: fields{
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewReferenceNotFoundError().WithParam("post_id", create.PostId.String()),
+		},
+		{
+			name: "post error",
+			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, create.PostId).
+					Return(postEntities.Post{}, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+				uuid:           mockUUID,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: create,
 			},
 			want:    entities.Tag{},
@@ -346,14 +554,14 @@ func TestTagService_Create(t *testing.T) {
 			setup: func() {
 			},
 			fields: fields{
-				tagRepository: mockTagRepository,
-				logger:        mockLogger,
-				clock:         mockClock,
-				uuid:          mockUUID,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				logger:         mockLogger,
+				clock:          mockClock,
+				uuid:           mockUUID,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				create: entities.TagCreate{},
 			},
 			want: entities.Tag{},
@@ -367,12 +575,13 @@ func TestTagService_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 			u := &TagService{
-				tagRepository: tt.fields.tagRepository,
-				clock:         tt.fields.clock,
-				logger:        tt.fields.logger,
-				uuid:          tt.fields.uuid,
+				tagRepository:  tt.fields.tagRepository,
+				postRepository: tt.fields.postRepository,
+				clock:          tt.fields.clock,
+				logger:         tt.fields.logger,
+				uuid:           tt.fields.uuid,
 			}
-			got, err := u.Create(tt.args.ctx, tt.args.tx, tt.args.create)
+			got, err := u.Create(tt.args.ctx, tt.args.create)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
@@ -383,12 +592,14 @@ func TestTagService_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockTagRepository := NewMocktagRepository(ctrl)
+	mockPostRepository := NewMockpostRepository(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	ctx := context.Background()
 	tag := entities.NewMockTag(t)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	update := entities.NewMockTagUpdate(t)
+	post := postEntities.NewMockPost(t)
+	post.DeletedAt = nil
 	now := time.Now().UTC()
 	updatedTag := entities.Tag{
 		ID:        tag.ID,
@@ -400,13 +611,13 @@ func TestTagService_Update(t *testing.T) {
 		Value:  *update.Value,
 	}
 	type fields struct {
-		tagRepository tagRepository
-		clock         clock
-		logger        logger
+		tagRepository  tagRepository
+		postRepository postRepository
+		clock          clock
+		logger         logger
 	}
 	type args struct {
 		ctx    context.Context
-		tx     dtx.TX
 		update entities.TagUpdate
 	}
 	tests := []struct {
@@ -420,20 +631,23 @@ func TestTagService_Update(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, *update.PostId).
+					Return(post, nil)
 				mockClock.EXPECT().Now().Return(now)
 				mockTagRepository.EXPECT().
-					Get(ctx, update.ID).Return(tag, nil)
+					GetForUpdate(ctx, update.ID).Return(tag, nil)
 				mockTagRepository.EXPECT().
-					Update(ctx, mockTx, updatedTag).Return(nil)
+					Update(ctx, updatedTag).Return(nil)
 			},
 			fields: fields{
-				tagRepository: mockTagRepository,
-				clock:         mockClock,
-				logger:        mockLogger,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    updatedTag,
@@ -442,42 +656,68 @@ func TestTagService_Update(t *testing.T) {
 		{
 			name: "update error",
 			setup: func() {
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, *update.PostId).
+					Return(post, nil)
 				mockClock.EXPECT().Now().Return(now)
 				mockTagRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(tag, nil)
 				mockTagRepository.EXPECT().
-					Update(ctx, mockTx, updatedTag).
+					Update(ctx, updatedTag).
 					Return(errs.NewUnexpectedBehaviorError("test error"))
 			},
 			fields: fields{
-				tagRepository: mockTagRepository,
-				clock:         mockClock,
-				logger:        mockLogger,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Tag{},
 			wantErr: errs.NewUnexpectedBehaviorError("test error"),
 		},
+		{
+			name: "post deleted",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					GetForUpdate(ctx, update.ID).
+					Return(tag, nil)
+				mockPostRepository.EXPECT().
+					GetForShare(ctx, *update.PostId).
+					Return(postEntities.Post{ID: *update.PostId, DeletedAt: pointer.Of(now)}, nil)
+			},
+			fields: fields{
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				update: update,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewReferenceNotFoundError().WithParam("post_id", update.PostId.String()),
+		},
 		{
 			name: "Tag not found",
 			setup: func() {
 				mockTagRepository.EXPECT().
-					Get(ctx, update.ID).
+					GetForUpdate(ctx, update.ID).
 					Return(entities.Tag{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
-				tagRepository: mockTagRepository,
-				clock:         mockClock,
-				logger:        mockLogger,
+				tagRepository:  mockTagRepository,
+				postRepository: mockPostRepository,
+				clock:          mockClock,
+				logger:         mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
-				tx:     mockTx,
 				update: update,
 			},
 			want:    entities.Tag{},
@@ -488,11 +728,12 @@ func TestTagService_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 			u := &TagService{
-				tagRepository: tt.fields.tagRepository,
-				clock:         tt.fields.clock,
-				logger:        tt.fields.logger,
+				tagRepository:  tt.fields.tagRepository,
+				postRepository: tt.fields.postRepository,
+				clock:          tt.fields.clock,
+				logger:         tt.fields.logger,
 			}
-			got, err := u.Update(tt.args.ctx, tt.args.tx, tt.args.update)
+			got, err := u.Update(tt.args.ctx, tt.args.update)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
 		})
@@ -505,7 +746,6 @@ func TestTagService_Delete(t *testing.T) {
 	mockTagRepository := NewMocktagRepository(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockClock := NewMockclock(ctrl)
-	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
 	now := time.Now().UTC()
 	tag := entities.NewMockTag(t)
@@ -527,7 +767,6 @@ func TestTagService_Delete(t *testing.T) {
 	}
 	type args struct {
 		ctx context.Context
-		tx  dtx.TX
 		del entities.TagDelete
 	}
 	tests := []struct {
@@ -543,10 +782,10 @@ func TestTagService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockTagRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(tag, nil)
 				mockTagRepository.EXPECT().
-					Update(ctx, mockTx, deletedTag).
+					Update(ctx, deletedTag).
 					Return(nil)
 			},
 			fields: fields{
@@ -556,7 +795,6 @@ func TestTagService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    deletedTag,
@@ -567,10 +805,10 @@ func TestTagService_Delete(t *testing.T) {
 			setup: func() {
 				mockClock.EXPECT().Now().Return(now)
 				mockTagRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(tag, nil)
 				mockTagRepository.EXPECT().
-					Update(ctx, mockTx, deletedTag).
+					Update(ctx, deletedTag).
 					Return(errs.NewUnexpectedBehaviorError("test error 12"))
 			},
 			fields: fields{
@@ -580,7 +818,6 @@ func TestTagService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Tag{},
@@ -590,7 +827,7 @@ func TestTagService_Delete(t *testing.T) {
 			name: "Tag not found",
 			setup: func() {
 				mockTagRepository.EXPECT().
-					Get(ctx, del.ID).
+					GetForUpdate(ctx, del.ID).
 					Return(entities.Tag{}, errs.NewEntityNotFoundError())
 			},
 			fields: fields{
@@ -600,7 +837,6 @@ func TestTagService_Delete(t *testing.T) {
 			},
 			args: args{
 				ctx: ctx,
-				tx:  mockTx,
 				del: del,
 			},
 			want:    entities.Tag{},
@@ -615,9 +851,380 @@ func TestTagService_Delete(t *testing.T) {
 				logger:        tt.fields.logger,
 				clock:         tt.fields.clock,
 			}
-			got, err := u.Delete(tt.args.ctx, tt.args.tx, tt.args.del)
+			got, err := u.Delete(tt.args.ctx, tt.args.del)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTagService_DeleteByPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagRepository := NewMocktagRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	deletedAt := now.Add(-time.Hour)
+	tag := entities.NewMockTag(t)
+	type fields struct {
+		tagRepository tagRepository
+		clock         clock
+		logger        logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					DeleteByPost(ctx, tag.PostId, deletedAt).
+					Return([]entities.Tag{tag}, nil)
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					DeleteByPost(ctx, tag.PostId, deletedAt).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &TagService{
+				tagRepository: tt.fields.tagRepository,
+				logger:        tt.fields.logger,
+				clock:         tt.fields.clock,
+			}
+			got, err := s.DeleteByPost(tt.args.ctx, tt.args.postId, tt.args.deletedAt)
+			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagService_RestoreByPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagRepository := NewMocktagRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	now := time.Now().UTC()
+	deletedAt := now.Add(-time.Hour)
+	tag := entities.NewMockTag(t)
+	type fields struct {
+		tagRepository tagRepository
+		clock         clock
+		logger        logger
+	}
+	type args struct {
+		ctx       context.Context
+		postId    uuid.UUID
+		deletedAt time.Time
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockTagRepository.EXPECT().
+					RestoreByPost(ctx, tag.PostId, deletedAt, now).
+					Return([]entities.Tag{tag}, nil)
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockTagRepository.EXPECT().
+					RestoreByPost(ctx, tag.PostId, deletedAt, now).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:       ctx,
+				postId:    tag.PostId,
+				deletedAt: deletedAt,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &TagService{
+				tagRepository: tt.fields.tagRepository,
+				logger:        tt.fields.logger,
+				clock:         tt.fields.clock,
+			}
+			got, err := s.RestoreByPost(tt.args.ctx, tt.args.postId, tt.args.deletedAt)
 			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagService_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagRepository := NewMocktagRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	purge := entities.NewMockTagPurge(t)
+	tag := entities.NewMockTag(t)
+	tag.DeletedAt = pointer.Of(purge.DeletedBefore.Add(-time.Hour))
+	type fields struct {
+		tagRepository tagRepository
+		clock         clock
+		logger        logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.TagPurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return([]entities.Tag{tag}, nil)
+				mockTagRepository.EXPECT().Delete(ctx, tag.ID).Return(nil)
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "list error",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "delete error",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					ListDeleted(ctx, purge.DeletedBefore, purge.Limit).
+					Return([]entities.Tag{tag}, nil)
+				mockTagRepository.EXPECT().
+					Delete(ctx, tag.ID).
+					Return(errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name:  "invalid",
+			setup: func() {},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: entities.TagPurge{},
+			},
+			want: nil,
+			wantErr: errs.NewInvalidFormError().WithParams(
+				errs.Param{Key: "deleted_before", Value: "cannot be blank"},
+				errs.Param{Key: "limit", Value: "cannot be blank"},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &TagService{
+				tagRepository: tt.fields.tagRepository,
+				logger:        tt.fields.logger,
+				clock:         tt.fields.clock,
+			}
+			got, err := s.Purge(tt.args.ctx, tt.args.purge)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagService_PurgeByPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagRepository := NewMocktagRepository(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockClock := NewMockclock(ctrl)
+	ctx := context.Background()
+	tag := entities.NewMockTag(t)
+	type fields struct {
+		tagRepository tagRepository
+		clock         clock
+		logger        logger
+	}
+	type args struct {
+		ctx    context.Context
+		postId uuid.UUID
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					PurgeByPost(ctx, tag.PostId).
+					Return([]entities.Tag{tag}, nil)
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: tag.PostId,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "repository error",
+			setup: func() {
+				mockTagRepository.EXPECT().
+					PurgeByPost(ctx, tag.PostId).
+					Return(nil, errs.NewUnexpectedBehaviorError("test error"))
+			},
+			fields: fields{
+				tagRepository: mockTagRepository,
+				logger:        mockLogger,
+				clock:         mockClock,
+			},
+			args: args{
+				ctx:    ctx,
+				postId: tag.PostId,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			s := &TagService{
+				tagRepository: tt.fields.tagRepository,
+				logger:        tt.fields.logger,
+				clock:         tt.fields.clock,
+			}
+			got, err := s.PurgeByPost(tt.args.ctx, tt.args.postId)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -3,26 +3,33 @@ package usecases
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type likeService interface {
-	Create(context.Context, dtx.TX, entities.LikeCreate) (entities.Like, error)
+	Create(context.Context, entities.LikeCreate) (entities.Like, error)
 	Get(context.Context, uuid.UUID) (entities.Like, error)
-	List(context.Context, entities.LikeFilter) ([]entities.Like, uint64, error)
-	Update(context.Context, dtx.TX, entities.LikeUpdate) (entities.Like, error)
-	Delete(context.Context, dtx.TX, entities.LikeDelete) (entities.Like, error)
+	List(context.Context, entities.LikeFilter) (entities.LikeList, error)
+	Update(context.Context, entities.LikeUpdate) (entities.Like, error)
+	Toggle(context.Context, entities.LikeToggle) (entities.Like, events.Type, error)
+	Delete(context.Context, entities.LikeDelete) (entities.Like, error)
+	Purge(context.Context, entities.LikePurge) ([]entities.Like, error)
 }
 type likeEventService interface {
-	Send(context.Context, dtx.TX, entities.Like) error
+	Send(context.Context, events.Type, entities.Like) error
 }
 type logger interface {
 	log.Logger
 }
 type dtxManager interface {
-	NewTx() dtx.TX
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type authorizer interface {
+	Authorize(context.Context, authz.Permission) error
 }
@@ -2,10 +2,13 @@ package usecases
 
 import (
 	"context"
+	"strings"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/apikey"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
@@ -13,6 +16,7 @@ type LikeUseCase struct {
 	likeService      likeService
 	likeEventService likeEventService
 	dtxManager       dtxManager
+	authorizer       authorizer
 	logger           logger
 }
 
@@ -20,12 +24,14 @@ func NewLikeUseCase(
 	likeService likeService,
 	likeEventService likeEventService,
 	dtxManager dtxManager,
+	authorizer authorizer,
 	logger logger,
 ) *LikeUseCase {
 	return &LikeUseCase{
 		likeService:      likeService,
 		likeEventService: likeEventService,
 		dtxManager:       dtxManager,
+		authorizer:       authorizer,
 		logger:           logger,
 	}
 }
@@ -34,26 +40,27 @@ func (u *LikeUseCase) Create(
 	ctx context.Context,
 	create entities.LikeCreate,
 ) (entities.Like, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	like, err := u.likeService.Create(ctx, tx, create)
-	if err != nil {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionLikeCreate); err != nil {
 		return entities.Like{}, err
 	}
-	if err := u.likeEventService.Send(ctx, tx, like); err != nil {
-		return entities.Like{}, err
-	}
-	if err := tx.Commit(); err != nil {
+	var like entities.Like
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		like, err = u.likeService.Create(ctx, create)
+		if err != nil {
+			return err
+		}
+		return u.likeEventService.Send(ctx, events.TypeCreated, like)
+	})
+	if err != nil {
 		return entities.Like{}, err
 	}
 	return like, nil
 }
 func (u *LikeUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Like, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionLikeDetail); err != nil {
+		return entities.Like{}, err
+	}
 	like, err := u.likeService.Get(ctx, id)
 	if err != nil {
 		return entities.Like{}, err
@@ -64,54 +71,122 @@ func (u *LikeUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Like, err
 func (u *LikeUseCase) List(
 	ctx context.Context,
 	filter entities.LikeFilter,
-) ([]entities.Like, uint64, error) {
-	likes, count, err := u.likeService.List(ctx, filter)
+) (entities.LikeList, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionLikeList); err != nil {
+		return entities.LikeList{}, err
+	}
+	list, err := u.likeService.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.LikeList{}, err
 	}
-	return likes, count, nil
+	return list, nil
 }
 
 func (u *LikeUseCase) Update(
 	ctx context.Context,
 	update entities.LikeUpdate,
 ) (entities.Like, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
+	if err := u.authorizer.Authorize(ctx, entities.PermissionLikeUpdate); err != nil {
+		return entities.Like{}, err
+	}
+	var like entities.Like
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		like, err = u.likeService.Update(ctx, update)
+		if err != nil {
+			return err
 		}
-	}(tx)
-	like, err := u.likeService.Update(ctx, tx, update)
+		return u.likeEventService.Send(ctx, events.TypeUpdated, like)
+	})
 	if err != nil {
 		return entities.Like{}, err
 	}
-	if err := u.likeEventService.Send(ctx, tx, like); err != nil {
+	return like, nil
+}
+
+// Toggle - likes or unlikes the post on behalf of the caller, the caller must
+// be a user, callers with an API key are denied. Repeating the same toggle
+// changes nothing and sends no event.
+func (u *LikeUseCase) Toggle(
+	ctx context.Context,
+	toggle entities.LikeToggle,
+) (entities.Like, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionLikeToggle); err != nil {
 		return entities.Like{}, err
 	}
-	if err := tx.Commit(); err != nil {
-		return entities.Like{}, err
+	subject, ok := auth.SubjectFromContext(ctx)
+	if !ok {
+		return entities.Like{}, errs.NewUnauthenticatedError()
 	}
-	return like, nil
-}
-func (u *LikeUseCase) Delete(ctx context.Context, del entities.LikeDelete) (entities.Like, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
+	if strings.HasPrefix(subject, apikey.SubjectPrefix) {
+		return entities.Like{}, errs.NewPermissionDeniedError().
+			WithParam("subject", "API keys can not like posts, a like is made on behalf of a user.")
+	}
+	userId, err := uuid.Parse(subject)
+	if err != nil {
+		return entities.Like{}, errs.NewPermissionDeniedError().WithParam("subject", subject)
+	}
+	toggle.UserId = userId
+	var like entities.Like
+	err = u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var (
+			eventType events.Type
+			err       error
+		)
+		like, eventType, err = u.likeService.Toggle(ctx, toggle)
+		if err != nil {
+			return err
 		}
-	}(tx)
-	like, err := u.likeService.Delete(ctx, tx, del)
+		if eventType == "" {
+			return nil
+		}
+		return u.likeEventService.Send(ctx, eventType, like)
+	})
 	if err != nil {
 		return entities.Like{}, err
 	}
-	if err := u.likeEventService.Send(ctx, tx, like); err != nil {
+	return like, nil
+}
+func (u *LikeUseCase) Delete(ctx context.Context, del entities.LikeDelete) (entities.Like, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionLikeDelete); err != nil {
 		return entities.Like{}, err
 	}
-	if err := tx.Commit(); err != nil {
+	var like entities.Like
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		like, err = u.likeService.Delete(ctx, del)
+		if err != nil {
+			return err
+		}
+		return u.likeEventService.Send(ctx, events.TypeDeleted, like)
+	})
+	if err != nil {
 		return entities.Like{}, err
 	}
 	return like, nil
 }
+
+// Purge - hard deletes a batch of the likes soft deleted before the time.
+func (u *LikeUseCase) Purge(
+	ctx context.Context,
+	purge entities.LikePurge,
+) ([]entities.Like, error) {
+	var likes []entities.Like
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		likes, err = u.likeService.Purge(ctx, purge)
+		if err != nil {
+			return err
+		}
+		for _, like := range likes {
+			if err := u.likeEventService.Send(ctx, events.TypePurged, like); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
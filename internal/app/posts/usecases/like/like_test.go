@@ -6,11 +6,15 @@ import (
 
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
+	"github.com/mikalai-mitsin/example/internal/pkg/apikey"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
@@ -20,11 +24,13 @@ func TestNewLikeUseCase(t *testing.T) {
 	mockLikeService := NewMocklikeService(ctrl)
 	mockLikeEventService := NewMocklikeEventService(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	type args struct {
 		likeService      likeService
 		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	tests := []struct {
@@ -40,12 +46,14 @@ func TestNewLikeUseCase(t *testing.T) {
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			want: &LikeUseCase{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 		},
@@ -57,6 +65,7 @@ func TestNewLikeUseCase(t *testing.T) {
 				tt.args.likeService,
 				tt.args.likeEventService,
 				tt.args.dtxManager,
+				tt.args.authorizer,
 				tt.args.logger,
 			)
 			assert.Equal(t, tt.want, got)
@@ -71,12 +80,14 @@ func TestLikeUseCase_Get(t *testing.T) {
 	mockLikeEventService := NewMocklikeEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	like := entities.NewMockLike(t)
 	type fields struct {
 		likeService      likeService
 		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -94,6 +105,7 @@ func TestLikeUseCase_Get(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeDetail).Return(nil)
 				mockLikeService.EXPECT().
 					Get(ctx, like.ID).
 					Return(like, nil)
@@ -102,6 +114,7 @@ func TestLikeUseCase_Get(t *testing.T) {
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -114,6 +127,7 @@ func TestLikeUseCase_Get(t *testing.T) {
 		{
 			name: "Like not found",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeDetail).Return(nil)
 				mockLikeService.EXPECT().
 					Get(ctx, like.ID).
 					Return(entities.Like{}, errs.NewEntityNotFoundError())
@@ -122,6 +136,7 @@ func TestLikeUseCase_Get(t *testing.T) {
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -131,6 +146,27 @@ func TestLikeUseCase_Get(t *testing.T) {
 			want:    entities.Like{},
 			wantErr: errs.NewEntityNotFoundError(),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionLikeDetail).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  uuid.UUID(like.ID),
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -139,6 +175,7 @@ func TestLikeUseCase_Get(t *testing.T) {
 				likeService:      tt.fields.likeService,
 				likeEventService: tt.fields.likeEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Get(tt.args.ctx, tt.args.id)
@@ -156,14 +193,17 @@ func TestLikeUseCase_Create(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	like := entities.NewMockLike(t)
 	create := entities.NewMockLikeCreate(t)
 	type fields struct {
 		likeService      likeService
 		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -181,15 +221,18 @@ func TestLikeUseCase_Create(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockLikeService.EXPECT().Create(ctx, mockTx, create).Return(like, nil)
-				mockLikeEventService.EXPECT().Send(ctx, mockTx, like).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().Create(txCtx, create).Return(like, nil)
+				mockLikeEventService.EXPECT().Send(txCtx, events.TypeCreated, like).Return(nil)
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -202,16 +245,19 @@ func TestLikeUseCase_Create(t *testing.T) {
 		{
 			name: "create error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockLikeService.EXPECT().
-					Create(ctx, mockTx, create).
+					Create(txCtx, create).
 					Return(entities.Like{}, errs.NewUnexpectedBehaviorError("c u"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -221,6 +267,27 @@ func TestLikeUseCase_Create(t *testing.T) {
 			want:    entities.Like{},
 			wantErr: errs.NewUnexpectedBehaviorError("c u"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionLikeCreate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -229,6 +296,7 @@ func TestLikeUseCase_Create(t *testing.T) {
 				likeService:      tt.fields.likeService,
 				likeEventService: tt.fields.likeEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Create(tt.args.ctx, tt.args.create)
@@ -246,14 +314,17 @@ func TestLikeUseCase_Update(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	like := entities.NewMockLike(t)
 	update := entities.NewMockLikeUpdate(t)
 	type fields struct {
 		likeService      likeService
 		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -271,15 +342,18 @@ func TestLikeUseCase_Update(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockLikeService.EXPECT().Update(ctx, mockTx, update).Return(like, nil)
-				mockLikeEventService.EXPECT().Send(ctx, mockTx, like).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().Update(txCtx, update).Return(like, nil)
+				mockLikeEventService.EXPECT().Send(txCtx, events.TypeUpdated, like).Return(nil)
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -292,16 +366,19 @@ func TestLikeUseCase_Update(t *testing.T) {
 		{
 			name: "update error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockLikeService.EXPECT().
-					Update(ctx, mockTx, update).
+					Update(txCtx, update).
 					Return(entities.Like{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -311,6 +388,27 @@ func TestLikeUseCase_Update(t *testing.T) {
 			want:    entities.Like{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionLikeUpdate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				update: update,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -319,6 +417,7 @@ func TestLikeUseCase_Update(t *testing.T) {
 				likeService:      tt.fields.likeService,
 				likeEventService: tt.fields.likeEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Update(tt.args.ctx, tt.args.update)
@@ -328,6 +427,220 @@ func TestLikeUseCase_Update(t *testing.T) {
 	}
 }
 
+func TestLikeUseCase_Toggle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeService := NewMocklikeService(ctrl)
+	mockLikeEventService := NewMocklikeEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	userId := uuid.NewUUID()
+	ctx := auth.WithSubject(context.Background(), userId.String())
+	txCtx := dtx.WithTX(ctx, mockTx)
+	serviceCtx := auth.WithSubject(context.Background(), apikey.SubjectPrefix+uuid.NewUUID().String())
+	malformedCtx := auth.WithSubject(context.Background(), "user")
+	like := entities.NewMockLike(t)
+	toggle := entities.NewMockLikeToggle(t)
+	toggle.UserId = uuid.UUID{}
+	userToggle := toggle
+	userToggle.UserId = userId
+	type fields struct {
+		likeService      likeService
+		likeEventService likeEventService
+		dtxManager       dtxManager
+		authorizer       authorizer
+		logger           logger
+	}
+	type args struct {
+		ctx    context.Context
+		toggle entities.LikeToggle
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeToggle).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().
+					Toggle(txCtx, userToggle).
+					Return(like, events.TypeCreated, nil)
+				mockLikeEventService.EXPECT().Send(txCtx, events.TypeCreated, like).Return(nil)
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				toggle: toggle,
+			},
+			want:    like,
+			wantErr: nil,
+		},
+		{
+			name: "unchanged",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeToggle).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().
+					Toggle(txCtx, userToggle).
+					Return(like, events.Type(""), nil)
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				toggle: toggle,
+			},
+			want:    like,
+			wantErr: nil,
+		},
+		{
+			name: "toggle error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeToggle).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().
+					Toggle(txCtx, userToggle).
+					Return(entities.Like{}, events.Type(""), errs.NewAlreadyExistsError())
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				toggle: toggle,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewAlreadyExistsError(),
+		},
+		{
+			name: "not a user",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(serviceCtx, entities.PermissionLikeToggle).Return(nil)
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    serviceCtx,
+				toggle: toggle,
+			},
+			want: entities.Like{},
+			wantErr: errs.NewPermissionDeniedError().
+				WithParam("subject", "API keys can not like posts, a like is made on behalf of a user."),
+		},
+		{
+			name: "malformed subject",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(malformedCtx, entities.PermissionLikeToggle).Return(nil)
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    malformedCtx,
+				toggle: toggle,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewPermissionDeniedError().WithParam("subject", "user"),
+		},
+		{
+			name: "unauthenticated",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(context.Background(), entities.PermissionLikeToggle).
+					Return(nil)
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    context.Background(),
+				toggle: toggle,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewUnauthenticatedError(),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionLikeToggle).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				toggle: toggle,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := &LikeUseCase{
+				likeService:      tt.fields.likeService,
+				likeEventService: tt.fields.likeEventService,
+				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
+				logger:           tt.fields.logger,
+			}
+			got, err := i.Toggle(tt.args.ctx, tt.args.toggle)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestLikeUseCase_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -336,8 +649,10 @@ func TestLikeUseCase_Delete(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	like := entities.NewMockLike(t)
 	del := entities.NewMockLikeDelete(t)
 	del.ID = like.ID
@@ -345,6 +660,7 @@ func TestLikeUseCase_Delete(t *testing.T) {
 		likeService      likeService
 		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -362,17 +678,20 @@ func TestLikeUseCase_Delete(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockLikeService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(like, nil)
-				mockLikeEventService.EXPECT().Send(ctx, mockTx, like).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockLikeEventService.EXPECT().Send(txCtx, events.TypeDeleted, like).Return(nil)
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -385,16 +704,19 @@ func TestLikeUseCase_Delete(t *testing.T) {
 		{
 			name: "delete error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockLikeService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(entities.Like{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -404,6 +726,27 @@ func TestLikeUseCase_Delete(t *testing.T) {
 			want:    entities.Like{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionLikeDelete).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Like{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -412,6 +755,7 @@ func TestLikeUseCase_Delete(t *testing.T) {
 				likeService:      tt.fields.likeService,
 				likeEventService: tt.fields.likeEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Delete(tt.args.ctx, tt.args.del)
@@ -428,6 +772,7 @@ func TestLikeUseCase_List(t *testing.T) {
 	mockLikeEventService := NewMocklikeEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	filter := entities.NewMockLikeFilter(t)
 	count := faker.New().UInt64Between(2, 20)
@@ -435,10 +780,12 @@ func TestLikeUseCase_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		likes = append(likes, entities.NewMockLike(t))
 	}
+	list := entities.LikeList{Items: likes, Count: pointer.Of(count), NextCursor: nil}
 	type fields struct {
 		likeService      likeService
 		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -450,52 +797,201 @@ func TestLikeUseCase_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Like
-		want1   uint64
+		want    entities.LikeList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeList).Return(nil)
 				mockLikeService.EXPECT().
 					List(ctx, filter).
-					Return(likes, count, nil)
+					Return(list, nil)
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    likes,
-			want1:   count,
+			want:    list,
 			wantErr: nil,
 		},
 		{
 			name: "list error",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionLikeList).Return(nil)
 				mockLikeService.EXPECT().
 					List(ctx, filter).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("l e"))
+					Return(entities.LikeList{}, errs.NewUnexpectedBehaviorError("l e"))
 			},
 			fields: fields{
 				likeService:      mockLikeService,
 				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.LikeList{},
 			wantErr: errs.NewUnexpectedBehaviorError("l e"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionLikeList).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.LikeList{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := &LikeUseCase{
+				likeService:      tt.fields.likeService,
+				likeEventService: tt.fields.likeEventService,
+				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
+				logger:           tt.fields.logger,
+			}
+			got, err := i.List(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLikeUseCase_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLikeService := NewMocklikeService(ctrl)
+	mockLikeEventService := NewMocklikeEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
+	like := entities.NewMockLike(t)
+	purge := entities.NewMockLikePurge(t)
+	type fields struct {
+		likeService      likeService
+		likeEventService likeEventService
+		dtxManager       dtxManager
+		authorizer       authorizer
+		logger           logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.LikePurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Like
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().
+					Purge(txCtx, purge).
+					Return([]entities.Like{like}, nil)
+				mockLikeEventService.EXPECT().Send(txCtx, events.TypePurged, like).Return(nil)
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Like{like},
+			wantErr: nil,
+		},
+		{
+			name: "purge error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().
+					Purge(txCtx, purge).
+					Return(nil, errs.NewUnexpectedBehaviorError("p 1"))
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 1"),
+		},
+		{
+			name: "event error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockLikeService.EXPECT().
+					Purge(txCtx, purge).
+					Return([]entities.Like{like}, nil)
+				mockLikeEventService.EXPECT().
+					Send(txCtx, events.TypePurged, like).
+					Return(errs.NewUnexpectedBehaviorError("p 2"))
+			},
+			fields: fields{
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 2"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -504,12 +1000,12 @@ func TestLikeUseCase_List(t *testing.T) {
 				likeService:      tt.fields.likeService,
 				likeEventService: tt.fields.likeEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
-			got, got1, err := i.List(tt.args.ctx, tt.args.filter)
+			got, err := i.Purge(tt.args.ctx, tt.args.purge)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
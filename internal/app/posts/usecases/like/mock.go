@@ -11,10 +11,12 @@ package usecases
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
 
 	like "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	authz "github.com/mikalai-mitsin/example/internal/pkg/authz"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -47,33 +49,33 @@ func (m *MocklikeService) EXPECT() *MocklikeServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MocklikeService) Create(arg0 context.Context, arg1 dtx.TX, arg2 like.LikeCreate) (like.Like, error) {
+func (m *MocklikeService) Create(arg0 context.Context, arg1 like.LikeCreate) (like.Like, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(like.Like)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MocklikeServiceMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocklikeServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocklikeService)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocklikeService)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MocklikeService) Delete(arg0 context.Context, arg1 dtx.TX, arg2 like.LikeDelete) (like.Like, error) {
+func (m *MocklikeService) Delete(arg0 context.Context, arg1 like.LikeDelete) (like.Like, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(like.Like)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MocklikeServiceMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocklikeServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocklikeService)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocklikeService)(nil).Delete), arg0, arg1)
 }
 
 // Get mocks base method.
@@ -92,13 +94,12 @@ func (mr *MocklikeServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MocklikeService) List(arg0 context.Context, arg1 like.LikeFilter) ([]like.Like, uint64, error) {
+func (m *MocklikeService) List(arg0 context.Context, arg1 like.LikeFilter) (like.LikeList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]like.Like)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(like.LikeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -107,19 +108,50 @@ func (mr *MocklikeServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocklikeService)(nil).List), arg0, arg1)
 }
 
+// Purge mocks base method.
+func (m *MocklikeService) Purge(arg0 context.Context, arg1 like.LikePurge) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MocklikeServiceMockRecorder) Purge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MocklikeService)(nil).Purge), arg0, arg1)
+}
+
+// Toggle mocks base method.
+func (m *MocklikeService) Toggle(arg0 context.Context, arg1 like.LikeToggle) (like.Like, events.Type, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Toggle", arg0, arg1)
+	ret0, _ := ret[0].(like.Like)
+	ret1, _ := ret[1].(events.Type)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Toggle indicates an expected call of Toggle.
+func (mr *MocklikeServiceMockRecorder) Toggle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Toggle", reflect.TypeOf((*MocklikeService)(nil).Toggle), arg0, arg1)
+}
+
 // Update mocks base method.
-func (m *MocklikeService) Update(arg0 context.Context, arg1 dtx.TX, arg2 like.LikeUpdate) (like.Like, error) {
+func (m *MocklikeService) Update(arg0 context.Context, arg1 like.LikeUpdate) (like.Like, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(like.Like)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MocklikeServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocklikeServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocklikeService)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocklikeService)(nil).Update), arg0, arg1)
 }
 
 // MocklikeEventService is a mock of likeEventService interface.
@@ -147,7 +179,7 @@ func (m *MocklikeEventService) EXPECT() *MocklikeEventServiceMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MocklikeEventService) Send(arg0 context.Context, arg1 dtx.TX, arg2 like.Like) error {
+func (m *MocklikeEventService) Send(arg0 context.Context, arg1 events.Type, arg2 like.Like) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -430,16 +462,54 @@ func (m *MockdtxManager) EXPECT() *MockdtxManagerMockRecorder {
 	return m.recorder
 }
 
-// NewTx mocks base method.
-func (m *MockdtxManager) NewTx() dtx.TX {
+// RunInTx mocks base method.
+func (m *MockdtxManager) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewTx")
-	ret0, _ := ret[0].(dtx.TX)
+	ret := m.ctrl.Call(m, "RunInTx", ctx, opts, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockdtxManagerMockRecorder) RunInTx(ctx, opts, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockdtxManager)(nil).RunInTx), ctx, opts, fn)
+}
+
+// Mockauthorizer is a mock of authorizer interface.
+type Mockauthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorizerMockRecorder is the mock recorder for Mockauthorizer.
+type MockauthorizerMockRecorder struct {
+	mock *Mockauthorizer
+}
+
+// NewMockauthorizer creates a new mock instance.
+func NewMockauthorizer(ctrl *gomock.Controller) *Mockauthorizer {
+	mock := &Mockauthorizer{ctrl: ctrl}
+	mock.recorder = &MockauthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockauthorizer) EXPECT() *MockauthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *Mockauthorizer) Authorize(arg0 context.Context, arg1 authz.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// NewTx indicates an expected call of NewTx.
-func (mr *MockdtxManagerMockRecorder) NewTx() *gomock.Call {
+// Authorize indicates an expected call of Authorize.
+func (mr *MockauthorizerMockRecorder) Authorize(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTx", reflect.TypeOf((*MockdtxManager)(nil).NewTx))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*Mockauthorizer)(nil).Authorize), arg0, arg1)
 }
@@ -3,26 +3,58 @@ package usecases
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
+	"time"
 
+	likeEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	tagEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type postService interface {
-	Create(context.Context, dtx.TX, entities.PostCreate) (entities.Post, error)
+	Create(context.Context, entities.PostCreate) (entities.Post, error)
 	Get(context.Context, uuid.UUID) (entities.Post, error)
-	List(context.Context, entities.PostFilter) ([]entities.Post, uint64, error)
-	Update(context.Context, dtx.TX, entities.PostUpdate) (entities.Post, error)
-	Delete(context.Context, dtx.TX, entities.PostDelete) (entities.Post, error)
+	GetForUpdate(context.Context, uuid.UUID) (entities.Post, error)
+	List(context.Context, entities.PostFilter) (entities.PostList, error)
+	Update(context.Context, entities.PostUpdate) (entities.Post, error)
+	Delete(context.Context, entities.PostDelete) (entities.Post, error)
+	Restore(context.Context, entities.PostRestore) (entities.Post, error)
+	ListDeleted(context.Context, entities.PostPurge) ([]entities.Post, error)
+	Purge(context.Context, uuid.UUID) error
 }
 type postEventService interface {
-	Send(context.Context, dtx.TX, entities.Post) error
+	Send(context.Context, events.Type, entities.Post) error
+}
+
+// tagService - tags deleted, restored and purged along with their post.
+type tagService interface {
+	DeleteByPost(context.Context, uuid.UUID, time.Time) ([]tagEntities.Tag, error)
+	RestoreByPost(context.Context, uuid.UUID, time.Time) ([]tagEntities.Tag, error)
+	PurgeByPost(context.Context, uuid.UUID) ([]tagEntities.Tag, error)
+}
+type tagEventService interface {
+	Send(context.Context, events.Type, tagEntities.Tag) error
+}
+
+// likeService - likes deleted, restored and purged along with their post.
+type likeService interface {
+	DeleteByPost(context.Context, uuid.UUID, time.Time) ([]likeEntities.Like, error)
+	RestoreByPost(context.Context, uuid.UUID, time.Time) ([]likeEntities.Like, error)
+	PurgeByPost(context.Context, uuid.UUID) ([]likeEntities.Like, error)
+}
+type likeEventService interface {
+	Send(context.Context, events.Type, likeEntities.Like) error
 }
 type logger interface {
 	log.Logger
 }
 type dtxManager interface {
-	NewTx() dtx.TX
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type authorizer interface {
+	Authorize(context.Context, authz.Permission) error
 }
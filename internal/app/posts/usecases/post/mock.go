@@ -11,10 +11,15 @@ package usecases
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
+	like "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
 	post "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	tag "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
+	authz "github.com/mikalai-mitsin/example/internal/pkg/authz"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -47,33 +52,33 @@ func (m *MockpostService) EXPECT() *MockpostServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockpostService) Create(arg0 context.Context, arg1 dtx.TX, arg2 post.PostCreate) (post.Post, error) {
+func (m *MockpostService) Create(arg0 context.Context, arg1 post.PostCreate) (post.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(post.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockpostServiceMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockpostServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpostService)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpostService)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MockpostService) Delete(arg0 context.Context, arg1 dtx.TX, arg2 post.PostDelete) (post.Post, error) {
+func (m *MockpostService) Delete(arg0 context.Context, arg1 post.PostDelete) (post.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(post.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockpostServiceMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockpostServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockpostService)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockpostService)(nil).Delete), arg0, arg1)
 }
 
 // Get mocks base method.
@@ -91,14 +96,28 @@ func (mr *MockpostServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockpostService)(nil).Get), arg0, arg1)
 }
 
+// GetForUpdate mocks base method.
+func (m *MockpostService) GetForUpdate(arg0 context.Context, arg1 uuid.UUID) (post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockpostServiceMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockpostService)(nil).GetForUpdate), arg0, arg1)
+}
+
 // List mocks base method.
-func (m *MockpostService) List(arg0 context.Context, arg1 post.PostFilter) ([]post.Post, uint64, error) {
+func (m *MockpostService) List(arg0 context.Context, arg1 post.PostFilter) (post.PostList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]post.Post)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(post.PostList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -107,19 +126,63 @@ func (mr *MockpostServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpostService)(nil).List), arg0, arg1)
 }
 
+// ListDeleted mocks base method.
+func (m *MockpostService) ListDeleted(arg0 context.Context, arg1 post.PostPurge) ([]post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", arg0, arg1)
+	ret0, _ := ret[0].([]post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockpostServiceMockRecorder) ListDeleted(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockpostService)(nil).ListDeleted), arg0, arg1)
+}
+
+// Purge mocks base method.
+func (m *MockpostService) Purge(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockpostServiceMockRecorder) Purge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockpostService)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockpostService) Restore(arg0 context.Context, arg1 post.PostRestore) (post.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(post.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockpostServiceMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockpostService)(nil).Restore), arg0, arg1)
+}
+
 // Update mocks base method.
-func (m *MockpostService) Update(arg0 context.Context, arg1 dtx.TX, arg2 post.PostUpdate) (post.Post, error) {
+func (m *MockpostService) Update(arg0 context.Context, arg1 post.PostUpdate) (post.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(post.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockpostServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockpostServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpostService)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockpostService)(nil).Update), arg0, arg1)
 }
 
 // MockpostEventService is a mock of postEventService interface.
@@ -147,7 +210,7 @@ func (m *MockpostEventService) EXPECT() *MockpostEventServiceMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MockpostEventService) Send(arg0 context.Context, arg1 dtx.TX, arg2 post.Post) error {
+func (m *MockpostEventService) Send(arg0 context.Context, arg1 events.Type, arg2 post.Post) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -160,6 +223,220 @@ func (mr *MockpostEventServiceMockRecorder) Send(arg0, arg1, arg2 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockpostEventService)(nil).Send), arg0, arg1, arg2)
 }
 
+// MocktagService is a mock of tagService interface.
+type MocktagService struct {
+	ctrl     *gomock.Controller
+	recorder *MocktagServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocktagServiceMockRecorder is the mock recorder for MocktagService.
+type MocktagServiceMockRecorder struct {
+	mock *MocktagService
+}
+
+// NewMocktagService creates a new mock instance.
+func NewMocktagService(ctrl *gomock.Controller) *MocktagService {
+	mock := &MocktagService{ctrl: ctrl}
+	mock.recorder = &MocktagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktagService) EXPECT() *MocktagServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteByPost mocks base method.
+func (m *MocktagService) DeleteByPost(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByPost indicates an expected call of DeleteByPost.
+func (mr *MocktagServiceMockRecorder) DeleteByPost(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPost", reflect.TypeOf((*MocktagService)(nil).DeleteByPost), arg0, arg1, arg2)
+}
+
+// PurgeByPost mocks base method.
+func (m *MocktagService) PurgeByPost(arg0 context.Context, arg1 uuid.UUID) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByPost", arg0, arg1)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeByPost indicates an expected call of PurgeByPost.
+func (mr *MocktagServiceMockRecorder) PurgeByPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByPost", reflect.TypeOf((*MocktagService)(nil).PurgeByPost), arg0, arg1)
+}
+
+// RestoreByPost mocks base method.
+func (m *MocktagService) RestoreByPost(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByPost indicates an expected call of RestoreByPost.
+func (mr *MocktagServiceMockRecorder) RestoreByPost(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByPost", reflect.TypeOf((*MocktagService)(nil).RestoreByPost), arg0, arg1, arg2)
+}
+
+// MocktagEventService is a mock of tagEventService interface.
+type MocktagEventService struct {
+	ctrl     *gomock.Controller
+	recorder *MocktagEventServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocktagEventServiceMockRecorder is the mock recorder for MocktagEventService.
+type MocktagEventServiceMockRecorder struct {
+	mock *MocktagEventService
+}
+
+// NewMocktagEventService creates a new mock instance.
+func NewMocktagEventService(ctrl *gomock.Controller) *MocktagEventService {
+	mock := &MocktagEventService{ctrl: ctrl}
+	mock.recorder = &MocktagEventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktagEventService) EXPECT() *MocktagEventServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MocktagEventService) Send(arg0 context.Context, arg1 events.Type, arg2 tag.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocktagEventServiceMockRecorder) Send(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MocktagEventService)(nil).Send), arg0, arg1, arg2)
+}
+
+// MocklikeService is a mock of likeService interface.
+type MocklikeService struct {
+	ctrl     *gomock.Controller
+	recorder *MocklikeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocklikeServiceMockRecorder is the mock recorder for MocklikeService.
+type MocklikeServiceMockRecorder struct {
+	mock *MocklikeService
+}
+
+// NewMocklikeService creates a new mock instance.
+func NewMocklikeService(ctrl *gomock.Controller) *MocklikeService {
+	mock := &MocklikeService{ctrl: ctrl}
+	mock.recorder = &MocklikeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklikeService) EXPECT() *MocklikeServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteByPost mocks base method.
+func (m *MocklikeService) DeleteByPost(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByPost indicates an expected call of DeleteByPost.
+func (mr *MocklikeServiceMockRecorder) DeleteByPost(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPost", reflect.TypeOf((*MocklikeService)(nil).DeleteByPost), arg0, arg1, arg2)
+}
+
+// PurgeByPost mocks base method.
+func (m *MocklikeService) PurgeByPost(arg0 context.Context, arg1 uuid.UUID) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByPost", arg0, arg1)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeByPost indicates an expected call of PurgeByPost.
+func (mr *MocklikeServiceMockRecorder) PurgeByPost(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByPost", reflect.TypeOf((*MocklikeService)(nil).PurgeByPost), arg0, arg1)
+}
+
+// RestoreByPost mocks base method.
+func (m *MocklikeService) RestoreByPost(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]like.Like, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByPost", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]like.Like)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByPost indicates an expected call of RestoreByPost.
+func (mr *MocklikeServiceMockRecorder) RestoreByPost(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByPost", reflect.TypeOf((*MocklikeService)(nil).RestoreByPost), arg0, arg1, arg2)
+}
+
+// MocklikeEventService is a mock of likeEventService interface.
+type MocklikeEventService struct {
+	ctrl     *gomock.Controller
+	recorder *MocklikeEventServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocklikeEventServiceMockRecorder is the mock recorder for MocklikeEventService.
+type MocklikeEventServiceMockRecorder struct {
+	mock *MocklikeEventService
+}
+
+// NewMocklikeEventService creates a new mock instance.
+func NewMocklikeEventService(ctrl *gomock.Controller) *MocklikeEventService {
+	mock := &MocklikeEventService{ctrl: ctrl}
+	mock.recorder = &MocklikeEventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklikeEventService) EXPECT() *MocklikeEventServiceMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MocklikeEventService) Send(arg0 context.Context, arg1 events.Type, arg2 like.Like) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocklikeEventServiceMockRecorder) Send(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MocklikeEventService)(nil).Send), arg0, arg1, arg2)
+}
+
 // Mocklogger is a mock of logger interface.
 type Mocklogger struct {
 	ctrl     *gomock.Controller
@@ -430,16 +707,54 @@ func (m *MockdtxManager) EXPECT() *MockdtxManagerMockRecorder {
 	return m.recorder
 }
 
-// NewTx mocks base method.
-func (m *MockdtxManager) NewTx() dtx.TX {
+// RunInTx mocks base method.
+func (m *MockdtxManager) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewTx")
-	ret0, _ := ret[0].(dtx.TX)
+	ret := m.ctrl.Call(m, "RunInTx", ctx, opts, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockdtxManagerMockRecorder) RunInTx(ctx, opts, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockdtxManager)(nil).RunInTx), ctx, opts, fn)
+}
+
+// Mockauthorizer is a mock of authorizer interface.
+type Mockauthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorizerMockRecorder is the mock recorder for Mockauthorizer.
+type MockauthorizerMockRecorder struct {
+	mock *Mockauthorizer
+}
+
+// NewMockauthorizer creates a new mock instance.
+func NewMockauthorizer(ctrl *gomock.Controller) *Mockauthorizer {
+	mock := &Mockauthorizer{ctrl: ctrl}
+	mock.recorder = &MockauthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockauthorizer) EXPECT() *MockauthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *Mockauthorizer) Authorize(arg0 context.Context, arg1 authz.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// NewTx indicates an expected call of NewTx.
-func (mr *MockdtxManagerMockRecorder) NewTx() *gomock.Call {
+// Authorize indicates an expected call of Authorize.
+func (mr *MockauthorizerMockRecorder) Authorize(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTx", reflect.TypeOf((*MockdtxManager)(nil).NewTx))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*Mockauthorizer)(nil).Authorize), arg0, arg1)
 }
@@ -3,29 +3,47 @@ package usecases
 import (
 	"context"
 
+	likeEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	tagEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type PostUseCase struct {
 	postService      postService
 	postEventService postEventService
+	tagService       tagService
+	tagEventService  tagEventService
+	likeService      likeService
+	likeEventService likeEventService
 	dtxManager       dtxManager
+	authorizer       authorizer
 	logger           logger
 }
 
 func NewPostUseCase(
 	postService postService,
 	postEventService postEventService,
+	tagService tagService,
+	tagEventService tagEventService,
+	likeService likeService,
+	likeEventService likeEventService,
 	dtxManager dtxManager,
+	authorizer authorizer,
 	logger logger,
 ) *PostUseCase {
 	return &PostUseCase{
 		postService:      postService,
 		postEventService: postEventService,
+		tagService:       tagService,
+		tagEventService:  tagEventService,
+		likeService:      likeService,
+		likeEventService: likeEventService,
 		dtxManager:       dtxManager,
+		authorizer:       authorizer,
 		logger:           logger,
 	}
 }
@@ -34,26 +52,30 @@ func (u *PostUseCase) Create(
 	ctx context.Context,
 	create entities.PostCreate,
 ) (entities.Post, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	post, err := u.postService.Create(ctx, tx, create)
-	if err != nil {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionPostCreate); err != nil {
 		return entities.Post{}, err
 	}
-	if err := u.postEventService.Send(ctx, tx, post); err != nil {
-		return entities.Post{}, err
+	if subject, ok := auth.SubjectFromContext(ctx); ok {
+		create.AuthorId = subject
 	}
-	if err := tx.Commit(); err != nil {
+	var post entities.Post
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		post, err = u.postService.Create(ctx, create)
+		if err != nil {
+			return err
+		}
+		return u.postEventService.Send(ctx, events.TypeCreated, post)
+	})
+	if err != nil {
 		return entities.Post{}, err
 	}
 	return post, nil
 }
 func (u *PostUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Post, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionPostDetail); err != nil {
+		return entities.Post{}, err
+	}
 	post, err := u.postService.Get(ctx, id)
 	if err != nil {
 		return entities.Post{}, err
@@ -64,54 +86,190 @@ func (u *PostUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Post, err
 func (u *PostUseCase) List(
 	ctx context.Context,
 	filter entities.PostFilter,
-) ([]entities.Post, uint64, error) {
-	posts, count, err := u.postService.List(ctx, filter)
+) (entities.PostList, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionPostList); err != nil {
+		return entities.PostList{}, err
+	}
+	list, err := u.postService.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.PostList{}, err
 	}
-	return posts, count, nil
+	return list, nil
 }
 
 func (u *PostUseCase) Update(
 	ctx context.Context,
 	update entities.PostUpdate,
 ) (entities.Post, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	post, err := u.postService.Update(ctx, tx, update)
-	if err != nil {
-		return entities.Post{}, err
-	}
-	if err := u.postEventService.Send(ctx, tx, post); err != nil {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionPostUpdate); err != nil {
 		return entities.Post{}, err
 	}
-	if err := tx.Commit(); err != nil {
+	var post entities.Post
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		current, err := u.postService.GetForUpdate(ctx, update.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeAuthor(ctx, current); err != nil {
+			return err
+		}
+		post, err = u.postService.Update(ctx, update)
+		if err != nil {
+			return err
+		}
+		return u.postEventService.Send(ctx, events.TypeUpdated, post)
+	})
+	if err != nil {
 		return entities.Post{}, err
 	}
 	return post, nil
 }
+
+// Delete - soft deletes the post along with its tags and likes.
 func (u *PostUseCase) Delete(ctx context.Context, del entities.PostDelete) (entities.Post, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	post, err := u.postService.Delete(ctx, tx, del)
+	if err := u.authorizer.Authorize(ctx, entities.PermissionPostDelete); err != nil {
+		return entities.Post{}, err
+	}
+	var post entities.Post
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		current, err := u.postService.GetForUpdate(ctx, del.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeAuthor(ctx, current); err != nil {
+			return err
+		}
+		post, err = u.postService.Delete(ctx, del)
+		if err != nil {
+			return err
+		}
+		if err := u.postEventService.Send(ctx, events.TypeDeleted, post); err != nil {
+			return err
+		}
+		tags, err := u.tagService.DeleteByPost(ctx, post.ID, *post.DeletedAt)
+		if err != nil {
+			return err
+		}
+		likes, err := u.likeService.DeleteByPost(ctx, post.ID, *post.DeletedAt)
+		if err != nil {
+			return err
+		}
+		return u.sendChildren(ctx, events.TypeDeleted, tags, likes)
+	})
 	if err != nil {
 		return entities.Post{}, err
 	}
-	if err := u.postEventService.Send(ctx, tx, post); err != nil {
+	return post, nil
+}
+
+// Restore - undoes the soft deletion of the post along with the tags and likes
+// deleted with it.
+func (u *PostUseCase) Restore(
+	ctx context.Context,
+	restore entities.PostRestore,
+) (entities.Post, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionPostDelete); err != nil {
 		return entities.Post{}, err
 	}
-	if err := tx.Commit(); err != nil {
+	var post entities.Post
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		deleted, err := u.postService.GetForUpdate(ctx, restore.ID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeAuthor(ctx, deleted); err != nil {
+			return err
+		}
+		post, err = u.postService.Restore(ctx, restore)
+		if err != nil {
+			return err
+		}
+		if err := u.postEventService.Send(ctx, events.TypeRestored, post); err != nil {
+			return err
+		}
+		deletedAt := pointer.Value(deleted.DeletedAt)
+		tags, err := u.tagService.RestoreByPost(ctx, post.ID, deletedAt)
+		if err != nil {
+			return err
+		}
+		likes, err := u.likeService.RestoreByPost(ctx, post.ID, deletedAt)
+		if err != nil {
+			return err
+		}
+		return u.sendChildren(ctx, events.TypeRestored, tags, likes)
+	})
+	if err != nil {
 		return entities.Post{}, err
 	}
 	return post, nil
 }
+
+// authorizeAuthor - the caller is the author of the post or holds the moderate
+// permission. The post is locked by GetForUpdate, so the post can not change
+// between the check and the write.
+func (u *PostUseCase) authorizeAuthor(ctx context.Context, post entities.Post) error {
+	if subject, ok := auth.SubjectFromContext(ctx); ok && subject == post.AuthorId {
+		return nil
+	}
+	return u.authorizer.Authorize(ctx, entities.PermissionPostModerate)
+}
+
+// Purge - hard deletes a batch of the posts soft deleted before the time along
+// with their tags and likes. The tags and likes are purged before their post,
+// the cascade of the database would remove them without the events.
+func (u *PostUseCase) Purge(
+	ctx context.Context,
+	purge entities.PostPurge,
+) ([]entities.Post, error) {
+	var posts []entities.Post
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		posts, err = u.postService.ListDeleted(ctx, purge)
+		if err != nil {
+			return err
+		}
+		for _, post := range posts {
+			tags, err := u.tagService.PurgeByPost(ctx, post.ID)
+			if err != nil {
+				return err
+			}
+			likes, err := u.likeService.PurgeByPost(ctx, post.ID)
+			if err != nil {
+				return err
+			}
+			if err := u.sendChildren(ctx, events.TypePurged, tags, likes); err != nil {
+				return err
+			}
+			if err := u.postService.Purge(ctx, post.ID); err != nil {
+				return err
+			}
+			if err := u.postEventService.Send(ctx, events.TypePurged, post); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (u *PostUseCase) sendChildren(
+	ctx context.Context,
+	eventType events.Type,
+	tags []tagEntities.Tag,
+	likes []likeEntities.Like,
+) error {
+	for _, tag := range tags {
+		if err := u.tagEventService.Send(ctx, eventType, tag); err != nil {
+			return err
+		}
+	}
+	for _, like := range likes {
+		if err := u.likeEventService.Send(ctx, eventType, like); err != nil {
+			return err
+		}
+	}
+	return nil
+}
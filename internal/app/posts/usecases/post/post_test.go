@@ -5,12 +5,17 @@ import (
 	"testing"
 
 	"github.com/jaswdr/faker"
+	likeEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/like"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/post"
+	tagEntities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
@@ -19,12 +24,22 @@ func TestNewPostUseCase(t *testing.T) {
 	defer ctrl.Finish()
 	mockPostService := NewMockpostService(ctrl)
 	mockPostEventService := NewMockpostEventService(ctrl)
+	mockTagService := NewMocktagService(ctrl)
+	mockTagEventService := NewMocktagEventService(ctrl)
+	mockLikeService := NewMocklikeService(ctrl)
+	mockLikeEventService := NewMocklikeEventService(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	type args struct {
 		postService      postService
 		postEventService postEventService
+		tagService       tagService
+		tagEventService  tagEventService
+		likeService      likeService
+		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	tests := []struct {
@@ -39,13 +54,23 @@ func TestNewPostUseCase(t *testing.T) {
 			args: args{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			want: &PostUseCase{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 		},
@@ -56,7 +81,12 @@ func TestNewPostUseCase(t *testing.T) {
 			got := NewPostUseCase(
 				tt.args.postService,
 				tt.args.postEventService,
+				tt.args.tagService,
+				tt.args.tagEventService,
+				tt.args.likeService,
+				tt.args.likeEventService,
 				tt.args.dtxManager,
+				tt.args.authorizer,
 				tt.args.logger,
 			)
 			assert.Equal(t, tt.want, got)
@@ -71,12 +101,14 @@ func TestPostUseCase_Get(t *testing.T) {
 	mockPostEventService := NewMockpostEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	post := entities.NewMockPost(t)
 	type fields struct {
 		postService      postService
 		postEventService postEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -94,6 +126,7 @@ func TestPostUseCase_Get(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDetail).Return(nil)
 				mockPostService.EXPECT().
 					Get(ctx, post.ID).
 					Return(post, nil)
@@ -102,6 +135,7 @@ func TestPostUseCase_Get(t *testing.T) {
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -114,6 +148,7 @@ func TestPostUseCase_Get(t *testing.T) {
 		{
 			name: "Post not found",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDetail).Return(nil)
 				mockPostService.EXPECT().
 					Get(ctx, post.ID).
 					Return(entities.Post{}, errs.NewEntityNotFoundError())
@@ -122,6 +157,7 @@ func TestPostUseCase_Get(t *testing.T) {
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -131,6 +167,27 @@ func TestPostUseCase_Get(t *testing.T) {
 			want:    entities.Post{},
 			wantErr: errs.NewEntityNotFoundError(),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionPostDetail).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  uuid.UUID(post.ID),
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -139,6 +196,7 @@ func TestPostUseCase_Get(t *testing.T) {
 				postService:      tt.fields.postService,
 				postEventService: tt.fields.postEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Get(tt.args.ctx, tt.args.id)
@@ -156,14 +214,21 @@ func TestPostUseCase_Create(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	post := entities.NewMockPost(t)
 	create := entities.NewMockPostCreate(t)
+	authorCtx := auth.WithSubject(ctx, post.AuthorId)
+	authorTxCtx := dtx.WithTX(authorCtx, mockTx)
+	authored := create
+	authored.AuthorId = post.AuthorId
 	type fields struct {
 		postService      postService
 		postEventService postEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -181,15 +246,18 @@ func TestPostUseCase_Create(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockPostService.EXPECT().Create(ctx, mockTx, create).Return(post, nil)
-				mockPostEventService.EXPECT().Send(ctx, mockTx, post).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().Create(txCtx, create).Return(post, nil)
+				mockPostEventService.EXPECT().Send(txCtx, events.TypeCreated, post).Return(nil)
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -199,19 +267,50 @@ func TestPostUseCase_Create(t *testing.T) {
 			want:    post,
 			wantErr: nil,
 		},
+		{
+			name: "author from subject",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(authorCtx, entities.PermissionPostCreate).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(authorCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().Create(authorTxCtx, authored).Return(post, nil)
+				mockPostEventService.EXPECT().
+					Send(authorTxCtx, events.TypeCreated, post).
+					Return(nil)
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    authorCtx,
+				create: create,
+			},
+			want:    post,
+			wantErr: nil,
+		},
 		{
 			name: "create error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockPostService.EXPECT().
-					Create(ctx, mockTx, create).
+					Create(txCtx, create).
 					Return(entities.Post{}, errs.NewUnexpectedBehaviorError("c u"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -221,6 +320,27 @@ func TestPostUseCase_Create(t *testing.T) {
 			want:    entities.Post{},
 			wantErr: errs.NewUnexpectedBehaviorError("c u"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionPostCreate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -229,6 +349,7 @@ func TestPostUseCase_Create(t *testing.T) {
 				postService:      tt.fields.postService,
 				postEventService: tt.fields.postEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Create(tt.args.ctx, tt.args.create)
@@ -246,14 +367,19 @@ func TestPostUseCase_Update(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
-	ctx := context.Background()
 	post := entities.NewMockPost(t)
+	ctx := auth.WithSubject(context.Background(), post.AuthorId)
+	txCtx := dtx.WithTX(ctx, mockTx)
+	otherCtx := auth.WithSubject(context.Background(), uuid.NewUUID().String())
+	otherTxCtx := dtx.WithTX(otherCtx, mockTx)
 	update := entities.NewMockPostUpdate(t)
 	type fields struct {
 		postService      postService
 		postEventService postEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -271,15 +397,19 @@ func TestPostUseCase_Update(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockPostService.EXPECT().Update(ctx, mockTx, update).Return(post, nil)
-				mockPostEventService.EXPECT().Send(ctx, mockTx, post).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, update.ID).Return(post, nil)
+				mockPostService.EXPECT().Update(txCtx, update).Return(post, nil)
+				mockPostEventService.EXPECT().Send(txCtx, events.TypeUpdated, post).Return(nil)
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -289,19 +419,83 @@ func TestPostUseCase_Update(t *testing.T) {
 			want:    post,
 			wantErr: nil,
 		},
+		{
+			name: "moderator",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionPostUpdate).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(otherTxCtx, update.ID).Return(post, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionPostModerate).
+					Return(nil)
+				mockPostService.EXPECT().Update(otherTxCtx, update).Return(post, nil)
+				mockPostEventService.EXPECT().
+					Send(otherTxCtx, events.TypeUpdated, post).
+					Return(nil)
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    otherCtx,
+				update: update,
+			},
+			want:    post,
+			wantErr: nil,
+		},
+		{
+			name: "not the author",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionPostUpdate).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(otherTxCtx, update.ID).Return(post, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionPostModerate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    otherCtx,
+				update: update,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 		{
 			name: "update error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, update.ID).Return(post, nil)
 				mockPostService.EXPECT().
-					Update(ctx, mockTx, update).
+					Update(txCtx, update).
 					Return(entities.Post{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -311,6 +505,27 @@ func TestPostUseCase_Update(t *testing.T) {
 			want:    entities.Post{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionPostUpdate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				update: update,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -319,6 +534,7 @@ func TestPostUseCase_Update(t *testing.T) {
 				postService:      tt.fields.postService,
 				postEventService: tt.fields.postEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Update(tt.args.ctx, tt.args.update)
@@ -333,18 +549,33 @@ func TestPostUseCase_Delete(t *testing.T) {
 	defer ctrl.Finish()
 	mockPostService := NewMockpostService(ctrl)
 	mockPostEventService := NewMockpostEventService(ctrl)
+	mockTagService := NewMocktagService(ctrl)
+	mockTagEventService := NewMocktagEventService(ctrl)
+	mockLikeService := NewMocklikeService(ctrl)
+	mockLikeEventService := NewMocklikeEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
-	ctx := context.Background()
 	post := entities.NewMockPost(t)
+	ctx := auth.WithSubject(context.Background(), post.AuthorId)
+	txCtx := dtx.WithTX(ctx, mockTx)
+	otherCtx := auth.WithSubject(context.Background(), uuid.NewUUID().String())
+	otherTxCtx := dtx.WithTX(otherCtx, mockTx)
 	del := entities.NewMockPostDelete(t)
 	del.ID = post.ID
+	tag := tagEntities.NewMockTag(t)
+	like := likeEntities.NewMockLike(t)
 	type fields struct {
 		postService      postService
 		postEventService postEventService
+		tagService       tagService
+		tagEventService  tagEventService
+		likeService      likeService
+		likeEventService likeEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -362,17 +593,33 @@ func TestPostUseCase_Delete(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, del.ID).Return(post, nil)
 				mockPostService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(post, nil)
-				mockPostEventService.EXPECT().Send(ctx, mockTx, post).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockPostEventService.EXPECT().Send(txCtx, events.TypeDeleted, post).Return(nil)
+				mockTagService.EXPECT().
+					DeleteByPost(txCtx, post.ID, *post.DeletedAt).
+					Return([]tagEntities.Tag{tag}, nil)
+				mockLikeService.EXPECT().
+					DeleteByPost(txCtx, post.ID, *post.DeletedAt).
+					Return([]likeEntities.Like{like}, nil)
+				mockTagEventService.EXPECT().Send(txCtx, events.TypeDeleted, tag).Return(nil)
+				mockLikeEventService.EXPECT().Send(txCtx, events.TypeDeleted, like).Return(nil)
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -382,19 +629,109 @@ func TestPostUseCase_Delete(t *testing.T) {
 			want:    post,
 			wantErr: nil,
 		},
+		{
+			name: "moderator",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionPostDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(otherTxCtx, del.ID).Return(post, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionPostModerate).
+					Return(nil)
+				mockPostService.EXPECT().
+					Delete(otherTxCtx, del).
+					Return(post, nil)
+				mockPostEventService.EXPECT().
+					Send(otherTxCtx, events.TypeDeleted, post).
+					Return(nil)
+				mockTagService.EXPECT().
+					DeleteByPost(otherTxCtx, post.ID, *post.DeletedAt).
+					Return([]tagEntities.Tag{tag}, nil)
+				mockLikeService.EXPECT().
+					DeleteByPost(otherTxCtx, post.ID, *post.DeletedAt).
+					Return([]likeEntities.Like{like}, nil)
+				mockTagEventService.EXPECT().
+					Send(otherTxCtx, events.TypeDeleted, tag).
+					Return(nil)
+				mockLikeEventService.EXPECT().
+					Send(otherTxCtx, events.TypeDeleted, like).
+					Return(nil)
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: otherCtx,
+				del: del,
+			},
+			want:    post,
+			wantErr: nil,
+		},
+		{
+			name: "not the author",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionPostDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(otherTxCtx, del.ID).Return(post, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionPostModerate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: otherCtx,
+				del: del,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 		{
 			name: "delete error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, del.ID).Return(post, nil)
 				mockPostService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(entities.Post{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
@@ -404,6 +741,105 @@ func TestPostUseCase_Delete(t *testing.T) {
 			want:    entities.Post{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "tags error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, del.ID).Return(post, nil)
+				mockPostService.EXPECT().
+					Delete(txCtx, del).
+					Return(post, nil)
+				mockPostEventService.EXPECT().Send(txCtx, events.TypeDeleted, post).Return(nil)
+				mockTagService.EXPECT().
+					DeleteByPost(txCtx, post.ID, *post.DeletedAt).
+					Return(nil, errs.NewUnexpectedBehaviorError("d 3"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewUnexpectedBehaviorError("d 3"),
+		},
+		{
+			name: "like event error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, del.ID).Return(post, nil)
+				mockPostService.EXPECT().
+					Delete(txCtx, del).
+					Return(post, nil)
+				mockPostEventService.EXPECT().Send(txCtx, events.TypeDeleted, post).Return(nil)
+				mockTagService.EXPECT().
+					DeleteByPost(txCtx, post.ID, *post.DeletedAt).
+					Return(nil, nil)
+				mockLikeService.EXPECT().
+					DeleteByPost(txCtx, post.ID, *post.DeletedAt).
+					Return([]likeEntities.Like{like}, nil)
+				mockLikeEventService.EXPECT().
+					Send(txCtx, events.TypeDeleted, like).
+					Return(errs.NewUnexpectedBehaviorError("d 4"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewUnexpectedBehaviorError("d 4"),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionPostDelete).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -411,7 +847,12 @@ func TestPostUseCase_Delete(t *testing.T) {
 			i := &PostUseCase{
 				postService:      tt.fields.postService,
 				postEventService: tt.fields.postEventService,
+				tagService:       tt.fields.tagService,
+				tagEventService:  tt.fields.tagEventService,
+				likeService:      tt.fields.likeService,
+				likeEventService: tt.fields.likeEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
 			got, err := i.Delete(tt.args.ctx, tt.args.del)
@@ -421,6 +862,556 @@ func TestPostUseCase_Delete(t *testing.T) {
 	}
 }
 
+func TestPostUseCase_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostService := NewMockpostService(ctrl)
+	mockPostEventService := NewMockpostEventService(ctrl)
+	mockTagService := NewMocktagService(ctrl)
+	mockTagEventService := NewMocktagEventService(ctrl)
+	mockLikeService := NewMocklikeService(ctrl)
+	mockLikeEventService := NewMocklikeEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	deleted := entities.NewMockPost(t)
+	ctx := auth.WithSubject(context.Background(), deleted.AuthorId)
+	txCtx := dtx.WithTX(ctx, mockTx)
+	otherCtx := auth.WithSubject(context.Background(), uuid.NewUUID().String())
+	otherTxCtx := dtx.WithTX(otherCtx, mockTx)
+	post := deleted
+	post.DeletedAt = nil
+	restore := entities.NewMockPostRestore(t)
+	restore.ID = post.ID
+	tag := tagEntities.NewMockTag(t)
+	like := likeEntities.NewMockLike(t)
+	type fields struct {
+		postService      postService
+		postEventService postEventService
+		tagService       tagService
+		tagEventService  tagEventService
+		likeService      likeService
+		likeEventService likeEventService
+		dtxManager       dtxManager
+		authorizer       authorizer
+		logger           logger
+	}
+	type args struct {
+		ctx     context.Context
+		restore entities.PostRestore
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, restore.ID).Return(deleted, nil)
+				mockPostService.EXPECT().
+					Restore(txCtx, restore).
+					Return(post, nil)
+				mockPostEventService.EXPECT().Send(txCtx, events.TypeRestored, post).Return(nil)
+				mockTagService.EXPECT().
+					RestoreByPost(txCtx, post.ID, *deleted.DeletedAt).
+					Return([]tagEntities.Tag{tag}, nil)
+				mockLikeService.EXPECT().
+					RestoreByPost(txCtx, post.ID, *deleted.DeletedAt).
+					Return([]likeEntities.Like{like}, nil)
+				mockTagEventService.EXPECT().Send(txCtx, events.TypeRestored, tag).Return(nil)
+				mockLikeEventService.EXPECT().Send(txCtx, events.TypeRestored, like).Return(nil)
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    post,
+			wantErr: nil,
+		},
+		{
+			name: "moderator",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionPostDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(otherTxCtx, restore.ID).Return(deleted, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionPostModerate).
+					Return(nil)
+				mockPostService.EXPECT().
+					Restore(otherTxCtx, restore).
+					Return(post, nil)
+				mockPostEventService.EXPECT().
+					Send(otherTxCtx, events.TypeRestored, post).
+					Return(nil)
+				mockTagService.EXPECT().
+					RestoreByPost(otherTxCtx, post.ID, *deleted.DeletedAt).
+					Return([]tagEntities.Tag{tag}, nil)
+				mockLikeService.EXPECT().
+					RestoreByPost(otherTxCtx, post.ID, *deleted.DeletedAt).
+					Return([]likeEntities.Like{like}, nil)
+				mockTagEventService.EXPECT().
+					Send(otherTxCtx, events.TypeRestored, tag).
+					Return(nil)
+				mockLikeEventService.EXPECT().
+					Send(otherTxCtx, events.TypeRestored, like).
+					Return(nil)
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:     otherCtx,
+				restore: restore,
+			},
+			want:    post,
+			wantErr: nil,
+		},
+		{
+			name: "not the author",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(otherCtx, entities.PermissionPostDelete).
+					Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(otherCtx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(otherTxCtx, restore.ID).Return(deleted, nil)
+				mockAuthorizer.EXPECT().
+					Authorize(otherTxCtx, entities.PermissionPostModerate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:     otherCtx,
+				restore: restore,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().
+					GetForUpdate(txCtx, restore.ID).
+					Return(entities.Post{}, errs.NewEntityNotFoundError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewEntityNotFoundError(),
+		},
+		{
+			name: "restore error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, restore.ID).Return(deleted, nil)
+				mockPostService.EXPECT().
+					Restore(txCtx, restore).
+					Return(entities.Post{}, errs.NewUnexpectedBehaviorError("r 2"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewUnexpectedBehaviorError("r 2"),
+		},
+		{
+			name: "likes error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().GetForUpdate(txCtx, restore.ID).Return(deleted, nil)
+				mockPostService.EXPECT().
+					Restore(txCtx, restore).
+					Return(post, nil)
+				mockPostEventService.EXPECT().Send(txCtx, events.TypeRestored, post).Return(nil)
+				mockTagService.EXPECT().
+					RestoreByPost(txCtx, post.ID, *deleted.DeletedAt).
+					Return(nil, nil)
+				mockLikeService.EXPECT().
+					RestoreByPost(txCtx, post.ID, *deleted.DeletedAt).
+					Return(nil, errs.NewUnexpectedBehaviorError("r 3"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewUnexpectedBehaviorError("r 3"),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionPostDelete).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				restore: restore,
+			},
+			want:    entities.Post{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := &PostUseCase{
+				postService:      tt.fields.postService,
+				postEventService: tt.fields.postEventService,
+				tagService:       tt.fields.tagService,
+				tagEventService:  tt.fields.tagEventService,
+				likeService:      tt.fields.likeService,
+				likeEventService: tt.fields.likeEventService,
+				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
+				logger:           tt.fields.logger,
+			}
+			got, err := i.Restore(tt.args.ctx, tt.args.restore)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPostUseCase_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockPostService := NewMockpostService(ctrl)
+	mockPostEventService := NewMockpostEventService(ctrl)
+	mockTagService := NewMocktagService(ctrl)
+	mockTagEventService := NewMocktagEventService(ctrl)
+	mockLikeService := NewMocklikeService(ctrl)
+	mockLikeEventService := NewMocklikeEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
+	post := entities.NewMockPost(t)
+	purge := entities.NewMockPostPurge(t)
+	tag := tagEntities.NewMockTag(t)
+	like := likeEntities.NewMockLike(t)
+	type fields struct {
+		postService      postService
+		postEventService postEventService
+		tagService       tagService
+		tagEventService  tagEventService
+		likeService      likeService
+		likeEventService likeEventService
+		dtxManager       dtxManager
+		authorizer       authorizer
+		logger           logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.PostPurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Post
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				gomock.InOrder(
+					mockPostService.EXPECT().
+						ListDeleted(txCtx, purge).
+						Return([]entities.Post{post}, nil),
+					mockTagService.EXPECT().
+						PurgeByPost(txCtx, post.ID).
+						Return([]tagEntities.Tag{tag}, nil),
+					mockLikeService.EXPECT().
+						PurgeByPost(txCtx, post.ID).
+						Return([]likeEntities.Like{like}, nil),
+					mockTagEventService.EXPECT().Send(txCtx, events.TypePurged, tag).Return(nil),
+					mockLikeEventService.EXPECT().Send(txCtx, events.TypePurged, like).Return(nil),
+					mockPostService.EXPECT().Purge(txCtx, post.ID).Return(nil),
+					mockPostEventService.EXPECT().Send(txCtx, events.TypePurged, post).Return(nil),
+				)
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Post{post},
+			wantErr: nil,
+		},
+		{
+			name: "list error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().
+					ListDeleted(txCtx, purge).
+					Return(nil, errs.NewUnexpectedBehaviorError("p 1"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 1"),
+		},
+		{
+			name: "tags error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().
+					ListDeleted(txCtx, purge).
+					Return([]entities.Post{post}, nil)
+				mockTagService.EXPECT().
+					PurgeByPost(txCtx, post.ID).
+					Return(nil, errs.NewUnexpectedBehaviorError("p 2"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 2"),
+		},
+		{
+			name: "tag event error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().
+					ListDeleted(txCtx, purge).
+					Return([]entities.Post{post}, nil)
+				mockTagService.EXPECT().
+					PurgeByPost(txCtx, post.ID).
+					Return([]tagEntities.Tag{tag}, nil)
+				mockLikeService.EXPECT().
+					PurgeByPost(txCtx, post.ID).
+					Return(nil, nil)
+				mockTagEventService.EXPECT().
+					Send(txCtx, events.TypePurged, tag).
+					Return(errs.NewUnexpectedBehaviorError("p 3"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 3"),
+		},
+		{
+			name: "post purge error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockPostService.EXPECT().
+					ListDeleted(txCtx, purge).
+					Return([]entities.Post{post}, nil)
+				mockTagService.EXPECT().
+					PurgeByPost(txCtx, post.ID).
+					Return(nil, nil)
+				mockLikeService.EXPECT().
+					PurgeByPost(txCtx, post.ID).
+					Return(nil, nil)
+				mockPostService.EXPECT().
+					Purge(txCtx, post.ID).
+					Return(errs.NewUnexpectedBehaviorError("p 4"))
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				tagService:       mockTagService,
+				tagEventService:  mockTagEventService,
+				likeService:      mockLikeService,
+				likeEventService: mockLikeEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 4"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := &PostUseCase{
+				postService:      tt.fields.postService,
+				postEventService: tt.fields.postEventService,
+				tagService:       tt.fields.tagService,
+				tagEventService:  tt.fields.tagEventService,
+				likeService:      tt.fields.likeService,
+				likeEventService: tt.fields.likeEventService,
+				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
+				logger:           tt.fields.logger,
+			}
+			got, err := i.Purge(tt.args.ctx, tt.args.purge)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestPostUseCase_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -428,6 +1419,7 @@ func TestPostUseCase_List(t *testing.T) {
 	mockPostEventService := NewMockpostEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	filter := entities.NewMockPostFilter(t)
 	count := faker.New().UInt64Between(2, 20)
@@ -435,10 +1427,12 @@ func TestPostUseCase_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		posts = append(posts, entities.NewMockPost(t))
 	}
+	list := entities.PostList{Items: posts, Count: pointer.Of(count), NextCursor: nil}
 	type fields struct {
 		postService      postService
 		postEventService postEventService
 		dtxManager       dtxManager
+		authorizer       authorizer
 		logger           logger
 	}
 	type args struct {
@@ -450,52 +1444,74 @@ func TestPostUseCase_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Post
-		want1   uint64
+		want    entities.PostList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostList).Return(nil)
 				mockPostService.EXPECT().
 					List(ctx, filter).
-					Return(posts, count, nil)
+					Return(list, nil)
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    posts,
-			want1:   count,
+			want:    list,
 			wantErr: nil,
 		},
 		{
 			name: "list error",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionPostList).Return(nil)
 				mockPostService.EXPECT().
 					List(ctx, filter).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("l e"))
+					Return(entities.PostList{}, errs.NewUnexpectedBehaviorError("l e"))
 			},
 			fields: fields{
 				postService:      mockPostService,
 				postEventService: mockPostEventService,
 				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
 				logger:           mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.PostList{},
 			wantErr: errs.NewUnexpectedBehaviorError("l e"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionPostList).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				postService:      mockPostService,
+				postEventService: mockPostEventService,
+				dtxManager:       mockDtxManager,
+				authorizer:       mockAuthorizer,
+				logger:           mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.PostList{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -504,12 +1520,12 @@ func TestPostUseCase_List(t *testing.T) {
 				postService:      tt.fields.postService,
 				postEventService: tt.fields.postEventService,
 				dtxManager:       tt.fields.dtxManager,
+				authorizer:       tt.fields.authorizer,
 				logger:           tt.fields.logger,
 			}
-			got, got1, err := i.List(tt.args.ctx, tt.args.filter)
+			got, err := i.List(tt.args.ctx, tt.args.filter)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
@@ -3,26 +3,33 @@ package usecases
 //go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
 import (
 	"context"
+	"database/sql"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
 type tagService interface {
-	Create(context.Context, dtx.TX, entities.TagCreate) (entities.Tag, error)
+	Create(context.Context, entities.TagCreate) (entities.Tag, error)
 	Get(context.Context, uuid.UUID) (entities.Tag, error)
-	List(context.Context, entities.TagFilter) ([]entities.Tag, uint64, error)
-	Update(context.Context, dtx.TX, entities.TagUpdate) (entities.Tag, error)
-	Delete(context.Context, dtx.TX, entities.TagDelete) (entities.Tag, error)
+	List(context.Context, entities.TagFilter) (entities.TagList, error)
+	Suggest(context.Context, entities.TagSuggest) ([]entities.TagSuggestion, error)
+	Update(context.Context, entities.TagUpdate) (entities.Tag, error)
+	Delete(context.Context, entities.TagDelete) (entities.Tag, error)
+	Purge(context.Context, entities.TagPurge) ([]entities.Tag, error)
 }
 type tagEventService interface {
-	Send(context.Context, dtx.TX, entities.Tag) error
+	Send(context.Context, events.Type, entities.Tag) error
 }
 type logger interface {
 	log.Logger
 }
 type dtxManager interface {
-	NewTx() dtx.TX
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+type authorizer interface {
+	Authorize(context.Context, authz.Permission) error
 }
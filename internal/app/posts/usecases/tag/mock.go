@@ -11,10 +11,12 @@ package usecases
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
 
 	tag "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	dtx "github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	authz "github.com/mikalai-mitsin/example/internal/pkg/authz"
+	events "github.com/mikalai-mitsin/example/internal/pkg/events"
 	log "github.com/mikalai-mitsin/example/internal/pkg/log"
 	uuid "github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	fxevent "go.uber.org/fx/fxevent"
@@ -47,33 +49,33 @@ func (m *MocktagService) EXPECT() *MocktagServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MocktagService) Create(arg0 context.Context, arg1 dtx.TX, arg2 tag.TagCreate) (tag.Tag, error) {
+func (m *MocktagService) Create(arg0 context.Context, arg1 tag.TagCreate) (tag.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
 	ret0, _ := ret[0].(tag.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MocktagServiceMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocktagServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktagService)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktagService)(nil).Create), arg0, arg1)
 }
 
 // Delete mocks base method.
-func (m *MocktagService) Delete(arg0 context.Context, arg1 dtx.TX, arg2 tag.TagDelete) (tag.Tag, error) {
+func (m *MocktagService) Delete(arg0 context.Context, arg1 tag.TagDelete) (tag.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(tag.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MocktagServiceMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocktagServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktagService)(nil).Delete), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocktagService)(nil).Delete), arg0, arg1)
 }
 
 // Get mocks base method.
@@ -92,13 +94,12 @@ func (mr *MocktagServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MocktagService) List(arg0 context.Context, arg1 tag.TagFilter) ([]tag.Tag, uint64, error) {
+func (m *MocktagService) List(arg0 context.Context, arg1 tag.TagFilter) (tag.TagList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]tag.Tag)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(tag.TagList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // List indicates an expected call of List.
@@ -107,19 +108,49 @@ func (mr *MocktagServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocktagService)(nil).List), arg0, arg1)
 }
 
+// Purge mocks base method.
+func (m *MocktagService) Purge(arg0 context.Context, arg1 tag.TagPurge) ([]tag.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].([]tag.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MocktagServiceMockRecorder) Purge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MocktagService)(nil).Purge), arg0, arg1)
+}
+
+// Suggest mocks base method.
+func (m *MocktagService) Suggest(arg0 context.Context, arg1 tag.TagSuggest) ([]tag.TagSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].([]tag.TagSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MocktagServiceMockRecorder) Suggest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MocktagService)(nil).Suggest), arg0, arg1)
+}
+
 // Update mocks base method.
-func (m *MocktagService) Update(arg0 context.Context, arg1 dtx.TX, arg2 tag.TagUpdate) (tag.Tag, error) {
+func (m *MocktagService) Update(arg0 context.Context, arg1 tag.TagUpdate) (tag.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(tag.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MocktagServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MocktagServiceMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocktagService)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MocktagService)(nil).Update), arg0, arg1)
 }
 
 // MocktagEventService is a mock of tagEventService interface.
@@ -147,7 +178,7 @@ func (m *MocktagEventService) EXPECT() *MocktagEventServiceMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MocktagEventService) Send(arg0 context.Context, arg1 dtx.TX, arg2 tag.Tag) error {
+func (m *MocktagEventService) Send(arg0 context.Context, arg1 events.Type, arg2 tag.Tag) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -430,16 +461,54 @@ func (m *MockdtxManager) EXPECT() *MockdtxManagerMockRecorder {
 	return m.recorder
 }
 
-// NewTx mocks base method.
-func (m *MockdtxManager) NewTx() dtx.TX {
+// RunInTx mocks base method.
+func (m *MockdtxManager) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, opts, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockdtxManagerMockRecorder) RunInTx(ctx, opts, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockdtxManager)(nil).RunInTx), ctx, opts, fn)
+}
+
+// Mockauthorizer is a mock of authorizer interface.
+type Mockauthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockauthorizerMockRecorder is the mock recorder for Mockauthorizer.
+type MockauthorizerMockRecorder struct {
+	mock *Mockauthorizer
+}
+
+// NewMockauthorizer creates a new mock instance.
+func NewMockauthorizer(ctrl *gomock.Controller) *Mockauthorizer {
+	mock := &Mockauthorizer{ctrl: ctrl}
+	mock.recorder = &MockauthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockauthorizer) EXPECT() *MockauthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *Mockauthorizer) Authorize(arg0 context.Context, arg1 authz.Permission) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewTx")
-	ret0, _ := ret[0].(dtx.TX)
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// NewTx indicates an expected call of NewTx.
-func (mr *MockdtxManagerMockRecorder) NewTx() *gomock.Call {
+// Authorize indicates an expected call of Authorize.
+func (mr *MockauthorizerMockRecorder) Authorize(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTx", reflect.TypeOf((*MockdtxManager)(nil).NewTx))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*Mockauthorizer)(nil).Authorize), arg0, arg1)
 }
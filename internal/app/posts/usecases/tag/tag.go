@@ -4,8 +4,7 @@ import (
 	"context"
 
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
-	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
-	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
@@ -13,6 +12,7 @@ type TagUseCase struct {
 	tagService      tagService
 	tagEventService tagEventService
 	dtxManager      dtxManager
+	authorizer      authorizer
 	logger          logger
 }
 
@@ -20,36 +20,39 @@ func NewTagUseCase(
 	tagService tagService,
 	tagEventService tagEventService,
 	dtxManager dtxManager,
+	authorizer authorizer,
 	logger logger,
 ) *TagUseCase {
 	return &TagUseCase{
 		tagService:      tagService,
 		tagEventService: tagEventService,
 		dtxManager:      dtxManager,
+		authorizer:      authorizer,
 		logger:          logger,
 	}
 }
 func (u *TagUseCase) Create(ctx context.Context, create entities.TagCreate) (entities.Tag, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	tag, err := u.tagService.Create(ctx, tx, create)
-	if err != nil {
-		return entities.Tag{}, err
-	}
-	if err := u.tagEventService.Send(ctx, tx, tag); err != nil {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionTagCreate); err != nil {
 		return entities.Tag{}, err
 	}
-	if err := tx.Commit(); err != nil {
+	var tag entities.Tag
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		tag, err = u.tagService.Create(ctx, create)
+		if err != nil {
+			return err
+		}
+		return u.tagEventService.Send(ctx, events.TypeCreated, tag)
+	})
+	if err != nil {
 		return entities.Tag{}, err
 	}
 	return tag, nil
 }
 func (u *TagUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Tag, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionTagDetail); err != nil {
+		return entities.Tag{}, err
+	}
 	tag, err := u.tagService.Get(ctx, id)
 	if err != nil {
 		return entities.Tag{}, err
@@ -60,50 +63,88 @@ func (u *TagUseCase) Get(ctx context.Context, id uuid.UUID) (entities.Tag, error
 func (u *TagUseCase) List(
 	ctx context.Context,
 	filter entities.TagFilter,
-) ([]entities.Tag, uint64, error) {
-	tags, count, err := u.tagService.List(ctx, filter)
+) (entities.TagList, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionTagList); err != nil {
+		return entities.TagList{}, err
+	}
+	list, err := u.tagService.List(ctx, filter)
 	if err != nil {
-		return nil, 0, err
+		return entities.TagList{}, err
 	}
-	return tags, count, nil
+	return list, nil
 }
-func (u *TagUseCase) Update(ctx context.Context, update entities.TagUpdate) (entities.Tag, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	tag, err := u.tagService.Update(ctx, tx, update)
+
+func (u *TagUseCase) Suggest(
+	ctx context.Context,
+	suggest entities.TagSuggest,
+) ([]entities.TagSuggestion, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionTagList); err != nil {
+		return nil, err
+	}
+	suggestions, err := u.tagService.Suggest(ctx, suggest)
 	if err != nil {
-		return entities.Tag{}, err
+		return nil, err
 	}
-	if err := u.tagEventService.Send(ctx, tx, tag); err != nil {
+	return suggestions, nil
+}
+func (u *TagUseCase) Update(ctx context.Context, update entities.TagUpdate) (entities.Tag, error) {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionTagUpdate); err != nil {
 		return entities.Tag{}, err
 	}
-	if err := tx.Commit(); err != nil {
+	var tag entities.Tag
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		tag, err = u.tagService.Update(ctx, update)
+		if err != nil {
+			return err
+		}
+		return u.tagEventService.Send(ctx, events.TypeUpdated, tag)
+	})
+	if err != nil {
 		return entities.Tag{}, err
 	}
 	return tag, nil
 }
 func (u *TagUseCase) Delete(ctx context.Context, del entities.TagDelete) (entities.Tag, error) {
-	logger := u.logger.WithContext(ctx)
-	tx := u.dtxManager.NewTx()
-	defer func(tx dtx.TX) {
-		if err := tx.Rollback(); err != nil {
-			logger.Error("cant rollback transaction", log.Error(err))
-		}
-	}(tx)
-	tag, err := u.tagService.Delete(ctx, tx, del)
-	if err != nil {
-		return entities.Tag{}, err
-	}
-	if err := u.tagEventService.Send(ctx, tx, tag); err != nil {
+	if err := u.authorizer.Authorize(ctx, entities.PermissionTagDelete); err != nil {
 		return entities.Tag{}, err
 	}
-	if err := tx.Commit(); err != nil {
+	var tag entities.Tag
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		tag, err = u.tagService.Delete(ctx, del)
+		if err != nil {
+			return err
+		}
+		return u.tagEventService.Send(ctx, events.TypeDeleted, tag)
+	})
+	if err != nil {
 		return entities.Tag{}, err
 	}
 	return tag, nil
 }
+
+// Purge - hard deletes a batch of the tags soft deleted before the time.
+func (u *TagUseCase) Purge(
+	ctx context.Context,
+	purge entities.TagPurge,
+) ([]entities.Tag, error) {
+	var tags []entities.Tag
+	err := u.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		tags, err = u.tagService.Purge(ctx, purge)
+		if err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			if err := u.tagEventService.Send(ctx, events.TypePurged, tag); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
@@ -7,10 +7,12 @@ import (
 	"github.com/jaswdr/faker"
 	entities "github.com/mikalai-mitsin/example/internal/app/posts/entities/tag"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 )
 
@@ -20,11 +22,13 @@ func TestNewTagUseCase(t *testing.T) {
 	mockTagService := NewMocktagService(ctrl)
 	mockTagEventService := NewMocktagEventService(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	type args struct {
 		tagService      tagService
 		tagEventService tagEventService
 		dtxManager      dtxManager
+		authorizer      authorizer
 		logger          logger
 	}
 	tests := []struct {
@@ -40,12 +44,14 @@ func TestNewTagUseCase(t *testing.T) {
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			want: &TagUseCase{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 		},
@@ -57,6 +63,7 @@ func TestNewTagUseCase(t *testing.T) {
 				tt.args.tagService,
 				tt.args.tagEventService,
 				tt.args.dtxManager,
+				tt.args.authorizer,
 				tt.args.logger,
 			)
 			assert.Equal(t, tt.want, got)
@@ -71,12 +78,14 @@ func TestTagUseCase_Get(t *testing.T) {
 	mockTagEventService := NewMocktagEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	tag := entities.NewMockTag(t)
 	type fields struct {
 		tagService      tagService
 		tagEventService tagEventService
 		dtxManager      dtxManager
+		authorizer      authorizer
 		logger          logger
 	}
 	type args struct {
@@ -94,6 +103,7 @@ func TestTagUseCase_Get(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagDetail).Return(nil)
 				mockTagService.EXPECT().
 					Get(ctx, tag.ID).
 					Return(tag, nil)
@@ -102,6 +112,7 @@ func TestTagUseCase_Get(t *testing.T) {
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -114,6 +125,7 @@ func TestTagUseCase_Get(t *testing.T) {
 		{
 			name: "Tag not found",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagDetail).Return(nil)
 				mockTagService.EXPECT().
 					Get(ctx, tag.ID).
 					Return(entities.Tag{}, errs.NewEntityNotFoundError())
@@ -122,6 +134,7 @@ func TestTagUseCase_Get(t *testing.T) {
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -131,6 +144,27 @@ func TestTagUseCase_Get(t *testing.T) {
 			want:    entities.Tag{},
 			wantErr: errs.NewEntityNotFoundError(),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionTagDetail).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				id:  uuid.UUID(tag.ID),
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -139,6 +173,7 @@ func TestTagUseCase_Get(t *testing.T) {
 				tagService:      tt.fields.tagService,
 				tagEventService: tt.fields.tagEventService,
 				dtxManager:      tt.fields.dtxManager,
+				authorizer:      tt.fields.authorizer,
 				logger:          tt.fields.logger,
 			}
 			got, err := i.Get(tt.args.ctx, tt.args.id)
@@ -156,14 +191,17 @@ func TestTagUseCase_Create(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	tag := entities.NewMockTag(t)
 	create := entities.NewMockTagCreate(t)
 	type fields struct {
 		tagService      tagService
 		tagEventService tagEventService
 		dtxManager      dtxManager
+		authorizer      authorizer
 		logger          logger
 	}
 	type args struct {
@@ -181,15 +219,18 @@ func TestTagUseCase_Create(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockTagService.EXPECT().Create(ctx, mockTx, create).Return(tag, nil)
-				mockTagEventService.EXPECT().Send(ctx, mockTx, tag).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockTagService.EXPECT().Create(txCtx, create).Return(tag, nil)
+				mockTagEventService.EXPECT().Send(txCtx, events.TypeCreated, tag).Return(nil)
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -202,16 +243,19 @@ func TestTagUseCase_Create(t *testing.T) {
 		{
 			name: "create error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagCreate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockTagService.EXPECT().
-					Create(ctx, mockTx, create).
+					Create(txCtx, create).
 					Return(entities.Tag{}, errs.NewUnexpectedBehaviorError("c u"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -221,6 +265,27 @@ func TestTagUseCase_Create(t *testing.T) {
 			want:    entities.Tag{},
 			wantErr: errs.NewUnexpectedBehaviorError("c u"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionTagCreate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				create: create,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -229,6 +294,7 @@ func TestTagUseCase_Create(t *testing.T) {
 				tagService:      tt.fields.tagService,
 				tagEventService: tt.fields.tagEventService,
 				dtxManager:      tt.fields.dtxManager,
+				authorizer:      tt.fields.authorizer,
 				logger:          tt.fields.logger,
 			}
 			got, err := i.Create(tt.args.ctx, tt.args.create)
@@ -246,14 +312,17 @@ func TestTagUseCase_Update(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	tag := entities.NewMockTag(t)
 	update := entities.NewMockTagUpdate(t)
 	type fields struct {
 		tagService      tagService
 		tagEventService tagEventService
 		dtxManager      dtxManager
+		authorizer      authorizer
 		logger          logger
 	}
 	type args struct {
@@ -271,15 +340,18 @@ func TestTagUseCase_Update(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
-				mockTagService.EXPECT().Update(ctx, mockTx, update).Return(tag, nil)
-				mockTagEventService.EXPECT().Send(ctx, mockTx, tag).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockTagService.EXPECT().Update(txCtx, update).Return(tag, nil)
+				mockTagEventService.EXPECT().Send(txCtx, events.TypeUpdated, tag).Return(nil)
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -292,16 +364,19 @@ func TestTagUseCase_Update(t *testing.T) {
 		{
 			name: "update error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagUpdate).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockTagService.EXPECT().
-					Update(ctx, mockTx, update).
+					Update(txCtx, update).
 					Return(entities.Tag{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -311,6 +386,27 @@ func TestTagUseCase_Update(t *testing.T) {
 			want:    entities.Tag{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionTagUpdate).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				update: update,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -319,6 +415,7 @@ func TestTagUseCase_Update(t *testing.T) {
 				tagService:      tt.fields.tagService,
 				tagEventService: tt.fields.tagEventService,
 				dtxManager:      tt.fields.dtxManager,
+				authorizer:      tt.fields.authorizer,
 				logger:          tt.fields.logger,
 			}
 			got, err := i.Update(tt.args.ctx, tt.args.update)
@@ -336,8 +433,10 @@ func TestTagUseCase_Delete(t *testing.T) {
 	mockLogger := NewMocklogger(ctrl)
 	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	mockTx := dtx.NewMockTX(ctrl)
 	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
 	tag := entities.NewMockTag(t)
 	del := entities.NewMockTagDelete(t)
 	del.ID = tag.ID
@@ -345,6 +444,7 @@ func TestTagUseCase_Delete(t *testing.T) {
 		tagService      tagService
 		tagEventService tagEventService
 		dtxManager      dtxManager
+		authorizer      authorizer
 		logger          logger
 	}
 	type args struct {
@@ -362,17 +462,20 @@ func TestTagUseCase_Delete(t *testing.T) {
 		{
 			name: "ok",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockTagService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(tag, nil)
-				mockTagEventService.EXPECT().Send(ctx, mockTx, tag).Return(nil)
-				mockTx.EXPECT().Rollback().After(mockTx.EXPECT().Commit().Return(nil)).Return(nil)
+				mockTagEventService.EXPECT().Send(txCtx, events.TypeDeleted, tag).Return(nil)
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -385,16 +488,19 @@ func TestTagUseCase_Delete(t *testing.T) {
 		{
 			name: "delete error",
 			setup: func() {
-				mockDtxManager.EXPECT().NewTx().Return(mockTx)
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagDelete).Return(nil)
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
 				mockTagService.EXPECT().
-					Delete(ctx, mockTx, del).
+					Delete(txCtx, del).
 					Return(entities.Tag{}, errs.NewUnexpectedBehaviorError("d 2"))
-				mockTx.EXPECT().Rollback().Return(nil)
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
@@ -404,6 +510,27 @@ func TestTagUseCase_Delete(t *testing.T) {
 			want:    entities.Tag{},
 			wantErr: errs.NewUnexpectedBehaviorError("d 2"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionTagDelete).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx: ctx,
+				del: del,
+			},
+			want:    entities.Tag{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -412,6 +539,7 @@ func TestTagUseCase_Delete(t *testing.T) {
 				tagService:      tt.fields.tagService,
 				tagEventService: tt.fields.tagEventService,
 				dtxManager:      tt.fields.dtxManager,
+				authorizer:      tt.fields.authorizer,
 				logger:          tt.fields.logger,
 			}
 			got, err := i.Delete(tt.args.ctx, tt.args.del)
@@ -428,6 +556,7 @@ func TestTagUseCase_List(t *testing.T) {
 	mockTagEventService := NewMocktagEventService(ctrl)
 	mockLogger := NewMocklogger(ctrl)
 	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
 	ctx := context.Background()
 	filter := entities.NewMockTagFilter(t)
 	count := faker.New().UInt64Between(2, 20)
@@ -435,10 +564,12 @@ func TestTagUseCase_List(t *testing.T) {
 	for i := uint64(0); i < count; i++ {
 		tags = append(tags, entities.NewMockTag(t))
 	}
+	list := entities.TagList{Items: tags, Count: pointer.Of(count), NextCursor: nil}
 	type fields struct {
 		tagService      tagService
 		tagEventService tagEventService
 		dtxManager      dtxManager
+		authorizer      authorizer
 		logger          logger
 	}
 	type args struct {
@@ -450,52 +581,316 @@ func TestTagUseCase_List(t *testing.T) {
 		setup   func()
 		fields  fields
 		args    args
-		want    []entities.Tag
-		want1   uint64
+		want    entities.TagList
 		wantErr error
 	}{
 		{
 			name: "ok",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagList).Return(nil)
 				mockTagService.EXPECT().
 					List(ctx, filter).
-					Return(tags, count, nil)
+					Return(list, nil)
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    tags,
-			want1:   count,
+			want:    list,
 			wantErr: nil,
 		},
 		{
 			name: "list error",
 			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagList).Return(nil)
 				mockTagService.EXPECT().
 					List(ctx, filter).
-					Return(nil, uint64(0), errs.NewUnexpectedBehaviorError("l e"))
+					Return(entities.TagList{}, errs.NewUnexpectedBehaviorError("l e"))
 			},
 			fields: fields{
 				tagService:      mockTagService,
 				tagEventService: mockTagEventService,
 				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
 				logger:          mockLogger,
 			},
 			args: args{
 				ctx:    ctx,
 				filter: filter,
 			},
-			want:    nil,
-			want1:   0,
+			want:    entities.TagList{},
 			wantErr: errs.NewUnexpectedBehaviorError("l e"),
 		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionTagList).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:    ctx,
+				filter: filter,
+			},
+			want:    entities.TagList{},
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := &TagUseCase{
+				tagService:      tt.fields.tagService,
+				tagEventService: tt.fields.tagEventService,
+				dtxManager:      tt.fields.dtxManager,
+				authorizer:      tt.fields.authorizer,
+				logger:          tt.fields.logger,
+			}
+			got, err := i.List(tt.args.ctx, tt.args.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagUseCase_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagService := NewMocktagService(ctrl)
+	mockTagEventService := NewMocktagEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	ctx := context.Background()
+	suggestions := []entities.TagSuggestion{
+		entities.NewMockTagSuggestion(t),
+		entities.NewMockTagSuggestion(t),
+	}
+	type fields struct {
+		tagService      tagService
+		tagEventService tagEventService
+		dtxManager      dtxManager
+		authorizer      authorizer
+		logger          logger
+	}
+	type args struct {
+		ctx     context.Context
+		suggest entities.TagSuggest
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.TagSuggestion
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagList).Return(nil)
+				mockTagService.EXPECT().
+					Suggest(ctx, entities.TagSuggest{Prefix: "postg", Limit: 5}).
+					Return(suggestions, nil)
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: "postg", Limit: 5},
+			},
+			want:    suggestions,
+			wantErr: nil,
+		},
+		{
+			name: "suggest error",
+			setup: func() {
+				mockAuthorizer.EXPECT().Authorize(ctx, entities.PermissionTagList).Return(nil)
+				mockTagService.EXPECT().
+					Suggest(ctx, entities.TagSuggest{Prefix: "postg", Limit: 5}).
+					Return(nil, errs.NewUnexpectedBehaviorError("s e"))
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: "postg", Limit: 5},
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("s e"),
+		},
+		{
+			name: "permission denied",
+			setup: func() {
+				mockAuthorizer.EXPECT().
+					Authorize(ctx, entities.PermissionTagList).
+					Return(errs.NewPermissionDeniedError())
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:     ctx,
+				suggest: entities.TagSuggest{Prefix: "postg", Limit: 5},
+			},
+			want:    nil,
+			wantErr: errs.NewPermissionDeniedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			u := &TagUseCase{
+				tagService:      tt.fields.tagService,
+				tagEventService: tt.fields.tagEventService,
+				dtxManager:      tt.fields.dtxManager,
+				authorizer:      tt.fields.authorizer,
+				logger:          tt.fields.logger,
+			}
+			got, err := u.Suggest(tt.args.ctx, tt.args.suggest)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagUseCase_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTagService := NewMocktagService(ctrl)
+	mockTagEventService := NewMocktagEventService(ctrl)
+	mockLogger := NewMocklogger(ctrl)
+	mockLogger.EXPECT().WithContext(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockAuthorizer := NewMockauthorizer(ctrl)
+	mockTx := dtx.NewMockTX(ctrl)
+	ctx := context.Background()
+	txCtx := dtx.WithTX(ctx, mockTx)
+	tag := entities.NewMockTag(t)
+	purge := entities.NewMockTagPurge(t)
+	type fields struct {
+		tagService      tagService
+		tagEventService tagEventService
+		dtxManager      dtxManager
+		authorizer      authorizer
+		logger          logger
+	}
+	type args struct {
+		ctx   context.Context
+		purge entities.TagPurge
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		fields  fields
+		args    args
+		want    []entities.Tag
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockTagService.EXPECT().
+					Purge(txCtx, purge).
+					Return([]entities.Tag{tag}, nil)
+				mockTagEventService.EXPECT().Send(txCtx, events.TypePurged, tag).Return(nil)
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    []entities.Tag{tag},
+			wantErr: nil,
+		},
+		{
+			name: "purge error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockTagService.EXPECT().
+					Purge(txCtx, purge).
+					Return(nil, errs.NewUnexpectedBehaviorError("p 1"))
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 1"),
+		},
+		{
+			name: "event error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(ctx, nil, gomock.Any()).
+					DoAndReturn(dtx.RunInMockTX(mockTx))
+				mockTagService.EXPECT().
+					Purge(txCtx, purge).
+					Return([]entities.Tag{tag}, nil)
+				mockTagEventService.EXPECT().
+					Send(txCtx, events.TypePurged, tag).
+					Return(errs.NewUnexpectedBehaviorError("p 2"))
+			},
+			fields: fields{
+				tagService:      mockTagService,
+				tagEventService: mockTagEventService,
+				dtxManager:      mockDtxManager,
+				authorizer:      mockAuthorizer,
+				logger:          mockLogger,
+			},
+			args: args{
+				ctx:   ctx,
+				purge: purge,
+			},
+			want:    nil,
+			wantErr: errs.NewUnexpectedBehaviorError("p 2"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -504,12 +899,12 @@ func TestTagUseCase_List(t *testing.T) {
 				tagService:      tt.fields.tagService,
 				tagEventService: tt.fields.tagEventService,
 				dtxManager:      tt.fields.dtxManager,
+				authorizer:      tt.fields.authorizer,
 				logger:          tt.fields.logger,
 			}
-			got, got1, err := i.List(tt.args.ctx, tt.args.filter)
+			got, err := i.Purge(tt.args.ctx, tt.args.purge)
 			assert.ErrorIs(t, err, tt.wantErr)
 			assert.Equal(t, tt.want, got)
-			assert.Equal(t, tt.want1, got1)
 		})
 	}
 }
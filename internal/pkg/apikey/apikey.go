@@ -0,0 +1,313 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/lib/pq"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+// SubjectPrefix - prefix of the subject of the requests made with an API key,
+// the rest of the subject is the id of the key.
+const SubjectPrefix = "apikey:"
+
+// lastUsedPrecision - the use of a key is recorded at most once per the period,
+// so the requests do not write the key every time.
+const lastUsedPrecision = time.Minute
+
+var (
+	errUnknownKey = errors.New("unknown api key")
+	errExpiredKey = errors.New("expired api key")
+)
+
+// Key - API key of a service caller. The scopes are ids of public.permissions,
+// the requests made with the key are granted these permissions only.
+type Key struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+// KeyCreate - a key without expiry is valid until it is revoked.
+type KeyCreate struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (m *KeyCreate) Validate() error {
+	err := validation.ValidateStruct(
+		m,
+		validation.Field(&m.Name, validation.Required),
+		validation.Field(&m.Scopes, validation.Required, validation.Each(validation.Required)),
+		validation.Field(&m.ExpiresAt),
+	)
+	if err != nil {
+		return errs.NewFromValidationError(err)
+	}
+	return nil
+}
+
+type KeyDTO struct {
+	ID         uuid.UUID  `db:"id"`
+	Name       string     `db:"name"`
+	Hash       string     `db:"hash"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+}
+
+type ScopeDTO struct {
+	APIKeyID     uuid.UUID `db:"api_key_id"`
+	PermissionID string    `db:"permission_id"`
+}
+
+// KeyListItemDTO - the key with the aggregated scopes.
+type KeyListItemDTO struct {
+	KeyDTO
+	Scopes pq.StringArray `db:"scopes"`
+}
+
+func (dto KeyListItemDTO) toKey() Key {
+	key := Key{
+		ID:         dto.ID,
+		Name:       dto.Name,
+		Scopes:     []string(dto.Scopes),
+		CreatedAt:  dto.CreatedAt,
+		ExpiresAt:  dto.ExpiresAt,
+		LastUsedAt: dto.LastUsedAt,
+	}
+	if key.Scopes == nil {
+		key.Scopes = []string{}
+	}
+	return key
+}
+
+var (
+	// Table - table of the keys with the columns the store uses.
+	Table = postgres.NewTable("public.api_keys", KeyDTO{})
+	// ScopesTable - table of the scopes of the keys.
+	ScopesTable = postgres.NewTable("public.api_key_scopes", ScopeDTO{})
+)
+
+// Tables - tables of the store.
+func Tables() []postgres.Table {
+	return []postgres.Table{Table, ScopesTable}
+}
+
+// Store - API keys in Postgres, only the SHA-256 hash of the secret of a key is
+// stored so the secret is shown once, when the key is created.
+//
+// The keys are read from the primary, a revoked key must not pass on a replica
+// that lags behind.
+type Store struct {
+	writeDB       database
+	dtxManager    dtxManager
+	clock         clock
+	uuidGenerator uuidGenerator
+	logger        log.Logger
+}
+
+func NewStore(
+	writeDB database,
+	dtxManager dtxManager,
+	clock clock,
+	uuidGenerator uuidGenerator,
+	logger log.Logger,
+) *Store {
+	return &Store{
+		writeDB:       writeDB,
+		dtxManager:    dtxManager,
+		clock:         clock,
+		uuidGenerator: uuidGenerator,
+		logger:        logger,
+	}
+}
+
+// Create - creates the key and returns it with its secret. An unknown scope
+// fails with errs.NewReferenceNotFoundError().
+func (s *Store) Create(ctx context.Context, create KeyCreate) (Key, string, error) {
+	if err := create.Validate(); err != nil {
+		return Key{}, "", err
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return Key{}, "", errs.NewUnexpectedBehaviorError("cant generate api key").WithCause(err)
+	}
+	scopes := slices.Clone(create.Scopes)
+	slices.Sort(scopes)
+	scopes = slices.Compact(scopes)
+	key := Key{
+		ID:        s.uuidGenerator.NewUUID(),
+		Name:      create.Name,
+		Scopes:    scopes,
+		CreatedAt: s.clock.Now().UTC(),
+		ExpiresAt: create.ExpiresAt,
+	}
+	if err := s.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		tx, ok := dtx.TXFromContext(ctx)
+		if !ok {
+			return errs.NewUnexpectedBehaviorError("transaction not found")
+		}
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		query, args := sq.Insert("public.api_keys").
+			Columns("id", "name", "hash", "created_at", "expires_at").
+			Values(key.ID, key.Name, hash(secret), key.CreatedAt, key.ExpiresAt).
+			PlaceholderFormat(sq.Dollar).
+			MustSql()
+		if _, err := tx.GetSQLTx().ExecContext(ctx, query, args...); err != nil {
+			return errs.FromPostgresError(err)
+		}
+		q := sq.Insert("public.api_key_scopes").Columns("api_key_id", "permission_id")
+		for _, scope := range key.Scopes {
+			q = q.Values(key.ID, scope)
+		}
+		query, args = q.PlaceholderFormat(sq.Dollar).MustSql()
+		if _, err := tx.GetSQLTx().ExecContext(ctx, query, args...); err != nil {
+			return errs.FromPostgresError(err)
+		}
+		return nil
+	}); err != nil {
+		return Key{}, "", err
+	}
+	return key, secret, nil
+}
+
+// List - all the keys, the oldest first.
+func (s *Store) List(ctx context.Context) ([]Key, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	query, args := selectKeys().
+		OrderBy("api_keys.created_at ASC", "api_keys.id ASC").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var dtos []KeyListItemDTO
+	if err := s.writeDB.SelectContext(ctx, &dtos, query, args...); err != nil {
+		return nil, errs.FromPostgresError(err)
+	}
+	keys := make([]Key, len(dtos))
+	for i := range dtos {
+		keys[i] = dtos[i].toKey()
+	}
+	return keys, nil
+}
+
+// Revoke - deletes the key with its scopes, the requests made with it fail
+// right away.
+func (s *Store) Revoke(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	query, args := sq.Delete("public.api_keys").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	result, err := s.writeDB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return errs.FromPostgresError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errs.FromPostgresError(err)
+	}
+	if affected == 0 {
+		return errs.NewEntityNotFoundError().WithParam("api_key_id", id.String())
+	}
+	return nil
+}
+
+// Authenticate - the context with the subject and the scopes of the valid key,
+// an unknown or expired key fails with errs.NewBadTokenError().
+func (s *Store) Authenticate(ctx context.Context, secret string) (context.Context, error) {
+	key, err := s.getByHash(ctx, hash(secret))
+	if err != nil {
+		var domainError *errs.Error
+		if errors.As(err, &domainError) && domainError.Code == errs.ErrorCodeNotFound {
+			return nil, errs.NewBadTokenError().WithCause(errUnknownKey)
+		}
+		return nil, err
+	}
+	now := s.clock.Now().UTC()
+	if key.ExpiresAt != nil && !now.Before(*key.ExpiresAt) {
+		return nil, errs.NewBadTokenError().WithCause(errExpiredKey)
+	}
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedPrecision {
+		if err := s.touch(ctx, key.ID, now); err != nil {
+			s.logger.WithContext(ctx).Warn("cant record api key use", log.Error(err))
+		}
+	}
+	return auth.WithScopes(auth.WithSubject(ctx, SubjectPrefix+key.ID.String()), key.Scopes), nil
+}
+
+func (s *Store) getByHash(ctx context.Context, hash string) (Key, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	query, args := selectKeys().
+		Where(sq.Eq{"api_keys.hash": hash}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	dto := KeyListItemDTO{}
+	if err := s.writeDB.GetContext(ctx, &dto, query, args...); err != nil {
+		return Key{}, errs.FromPostgresError(err)
+	}
+	return dto.toKey(), nil
+}
+
+func (s *Store) touch(ctx context.Context, id uuid.UUID, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	query, args := sq.Update("public.api_keys").
+		Set("last_used_at", now).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	if _, err := s.writeDB.ExecContext(ctx, query, args...); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	return nil
+}
+
+func selectKeys() sq.SelectBuilder {
+	return sq.Select(
+		"api_keys.id",
+		"api_keys.name",
+		"api_keys.created_at",
+		"api_keys.expires_at",
+		"api_keys.last_used_at",
+		"array_remove(array_agg(api_key_scopes.permission_id ORDER BY api_key_scopes.permission_id), NULL) AS scopes",
+	).
+		From("public.api_keys").
+		LeftJoin("public.api_key_scopes ON api_key_scopes.api_key_id = api_keys.id").
+		GroupBy("api_keys.id")
+}
+
+// newSecret - 256 random bits, url safe.
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
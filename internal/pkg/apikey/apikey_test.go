@@ -0,0 +1,355 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const selectQuery = "SELECT api_keys.id, api_keys.name, api_keys.created_at, api_keys.expires_at, api_keys.last_used_at, array_remove(array_agg(api_key_scopes.permission_id ORDER BY api_key_scopes.permission_id), NULL) AS scopes FROM public.api_keys LEFT JOIN public.api_key_scopes ON api_key_scopes.api_key_id = api_keys.id"
+
+var keyColumns = []string{"id", "name", "created_at", "expires_at", "last_used_at", "scopes"}
+
+func TestStore_Create(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	mockUUIDGenerator := NewMockuuidGenerator(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	store := NewStore(mockDB, dtx.NewManager(mockDB, &dtx.Config{}), mockClock, mockUUIDGenerator, logger)
+	keyQuery := "INSERT INTO public.api_keys (id,name,hash,created_at,expires_at) VALUES ($1,$2,$3,$4,$5)"
+	scopesQuery := "INSERT INTO public.api_key_scopes (api_key_id,permission_id) VALUES ($1,$2),($3,$4)"
+	ctx := context.Background()
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour)
+	id := uuid.NewUUID()
+	tests := []struct {
+		name    string
+		setup   func()
+		create  KeyCreate
+		want    Key
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockUUIDGenerator.EXPECT().NewUUID().Return(id)
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectBegin()
+				mock.ExpectExec(keyQuery).
+					WithArgs(id, "batch", sqlmock.AnyArg(), now, &expiresAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(scopesQuery).
+					WithArgs(id, "post_create", id, "post_list").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			create: KeyCreate{
+				Name:      "batch",
+				Scopes:    []string{"post_list", "post_create", "post_list"},
+				ExpiresAt: &expiresAt,
+			},
+			want: Key{
+				ID:        id,
+				Name:      "batch",
+				Scopes:    []string{"post_create", "post_list"},
+				CreatedAt: now,
+				ExpiresAt: &expiresAt,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown scope",
+			setup: func() {
+				mockUUIDGenerator.EXPECT().NewUUID().Return(id)
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectBegin()
+				mock.ExpectExec(keyQuery).
+					WithArgs(id, "batch", sqlmock.AnyArg(), now, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(scopesQuery).
+					WithArgs(id, "post_create", id, "unknown").
+					WillReturnError(errors.New("test error"))
+				mock.ExpectRollback()
+			},
+			create: KeyCreate{
+				Name:   "batch",
+				Scopes: []string{"post_create", "unknown"},
+			},
+			want:    Key{},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+		{
+			name:  "without scopes",
+			setup: func() {},
+			create: KeyCreate{
+				Name: "batch",
+			},
+			want:    Key{},
+			wantErr: errs.NewInvalidFormError().WithParam("scopes", "cannot be blank"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got, secret, err := store.Create(ctx, tt.create)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			if tt.wantErr == nil {
+				assert.NotEmpty(t, secret)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStore_List(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	store := NewStore(mockDB, nil, nil, nil, logger)
+	query := selectQuery + " GROUP BY api_keys.id ORDER BY api_keys.created_at ASC, api_keys.id ASC"
+	now := time.Now().UTC()
+	first, second := uuid.NewUUID(), uuid.NewUUID()
+	tests := []struct {
+		name    string
+		setup   func()
+		want    []Key
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows(keyColumns).
+						AddRow(first, "batch", now, nil, now, "{post_create,post_list}").
+						AddRow(second, "empty", now, now, nil, "{}"))
+			},
+			want: []Key{
+				{ID: first, Name: "batch", Scopes: []string{"post_create", "post_list"}, CreatedAt: now, LastUsedAt: &now},
+				{ID: second, Name: "empty", Scopes: []string{}, CreatedAt: now, ExpiresAt: &now},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).WillReturnError(errors.New("test error"))
+			},
+			want:    nil,
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got, err := store.List(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStore_Revoke(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	store := NewStore(mockDB, nil, nil, nil, logger)
+	query := "DELETE FROM public.api_keys WHERE id = $1"
+	id := uuid.NewUUID()
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "not found",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: errs.NewEntityNotFoundError().WithParam("api_key_id", id.String()),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectExec(query).
+					WithArgs(id).
+					WillReturnError(errors.New("test error"))
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			err := store.Revoke(context.Background(), id)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestStore_Authenticate(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	store := NewStore(mockDB, nil, mockClock, nil, logger)
+	query := selectQuery + " WHERE api_keys.hash = $1 GROUP BY api_keys.id"
+	touchQuery := "UPDATE public.api_keys SET last_used_at = $1 WHERE id = $2"
+	now := time.Now().UTC()
+	recently := now.Add(-time.Second)
+	id := uuid.NewUUID()
+	tests := []struct {
+		name       string
+		setup      func()
+		wantScopes []string
+		wantErr    error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(hash("secret")).
+					WillReturnRows(sqlmock.NewRows(keyColumns).
+						AddRow(id, "batch", now, now.Add(time.Hour), nil, "{post_list}"))
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectExec(touchQuery).
+					WithArgs(now, id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantScopes: []string{"post_list"},
+			wantErr:    nil,
+		},
+		{
+			name: "used recently",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(hash("secret")).
+					WillReturnRows(sqlmock.NewRows(keyColumns).
+						AddRow(id, "batch", now, nil, recently, "{post_list}"))
+				mockClock.EXPECT().Now().Return(now)
+			},
+			wantScopes: []string{"post_list"},
+			wantErr:    nil,
+		},
+		{
+			name: "touch error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(hash("secret")).
+					WillReturnRows(sqlmock.NewRows(keyColumns).
+						AddRow(id, "batch", now, nil, nil, "{post_list}"))
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectExec(touchQuery).
+					WithArgs(now, id).
+					WillReturnError(errors.New("test error"))
+			},
+			wantScopes: []string{"post_list"},
+			wantErr:    nil,
+		},
+		{
+			name: "expired",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(hash("secret")).
+					WillReturnRows(sqlmock.NewRows(keyColumns).
+						AddRow(id, "batch", now, now, nil, "{post_list}"))
+				mockClock.EXPECT().Now().Return(now)
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "unknown",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(hash("secret")).
+					WillReturnRows(sqlmock.NewRows(keyColumns))
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs(hash("secret")).
+					WillReturnError(errors.New("test error"))
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			ctx, err := store.Authenticate(context.Background(), "secret")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+			if tt.wantErr != nil {
+				return
+			}
+			subject, ok := auth.SubjectFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, SubjectPrefix+id.String(), subject)
+			scopes, ok := auth.ScopesFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantScopes, scopes)
+		})
+	}
+}
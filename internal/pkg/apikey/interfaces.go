@@ -0,0 +1,28 @@
+package apikey
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type database interface {
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
+type uuidGenerator interface {
+	NewUUID() uuid.UUID
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+)
+
+// placeholderSecret - the example secret of the docs, a token signed with it
+// could be forged by anyone.
+const placeholderSecret = "change-me"
+
+// Authenticator - verifies the bearer tokens and the API keys of the requests.
+type Authenticator struct {
+	config  *Config
+	parser  *jwt.Parser
+	secret  []byte
+	keys    map[string]crypto.PublicKey
+	apiKeys apiKeyAuthenticator
+}
+
+func NewAuthenticator(config *Config, clock clock, apiKeys apiKeyAuthenticator) (*Authenticator, error) {
+	a := &Authenticator{config: config, apiKeys: apiKeys}
+	if !config.Enabled {
+		return a, nil
+	}
+	switch config.Algorithm {
+	case "HS256":
+		if config.Secret == "" {
+			return nil, errs.NewUnexpectedBehaviorError("auth secret is required for HS256")
+		}
+		if config.Secret == placeholderSecret {
+			return nil, errs.NewUnexpectedBehaviorError("auth secret must be changed from the placeholder")
+		}
+		a.secret = []byte(config.Secret)
+	case "RS256", "ES256":
+		keys, err := readJWKS(config.JWKSFile, config.Algorithm)
+		if err != nil {
+			return nil, errs.NewUnexpectedBehaviorError("cant read auth jwks file").WithCause(err)
+		}
+		a.keys = keys
+	default:
+		return nil, errs.NewUnexpectedBehaviorError(
+			fmt.Sprintf("unsupported auth algorithm %q", config.Algorithm),
+		)
+	}
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{config.Algorithm}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(config.Leeway),
+		jwt.WithTimeFunc(clock.Now),
+	}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+	a.parser = jwt.NewParser(options...)
+	return a, nil
+}
+
+// Enabled - reports whether the requests must be authenticated.
+func (a *Authenticator) Enabled() bool {
+	return a.config.Enabled
+}
+
+// Authenticate - the context with the subject of the valid token.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	claims := &jwt.RegisteredClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.key); err != nil {
+		return nil, errs.NewBadTokenError().WithCause(err)
+	}
+	if claims.Subject == "" {
+		return nil, errs.NewBadTokenError().WithCause(jwt.ErrTokenRequiredClaimMissing)
+	}
+	return WithSubject(ctx, claims.Subject), nil
+}
+
+// AuthenticateHeader - the context with the subject of the credentials of the
+// Authorization header value, a bearer token or an API key. A header without
+// them fails with errs.NewUnauthenticatedError().
+func (a *Authenticator) AuthenticateHeader(ctx context.Context, header string) (context.Context, error) {
+	if key, ok := APIKey(header); ok && a.apiKeys != nil {
+		return a.apiKeys.Authenticate(ctx, key)
+	}
+	if token, ok := BearerToken(header); ok {
+		return a.Authenticate(ctx, token)
+	}
+	return nil, errs.NewUnauthenticatedError()
+}
+
+func (a *Authenticator) key(token *jwt.Token) (any, error) {
+	if a.secret != nil {
+		return a.secret, nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	if key, ok := a.keys[kid]; ok {
+		return key, nil
+	}
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+// BearerToken - the token of the Authorization header value.
+func BearerToken(header string) (string, bool) {
+	return credentials(header, "Bearer")
+}
+
+// APIKey - the API key of the Authorization header value.
+func APIKey(header string) (string, bool) {
+	return credentials(header, "ApiKey")
+}
+
+func credentials(header, scheme string) (string, bool) {
+	s, value, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(s, scheme) {
+		return "", false
+	}
+	value = strings.TrimSpace(value)
+	return value, value != ""
+}
@@ -0,0 +1,426 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	now := time.Now().UTC().Truncate(time.Second)
+	mockClock.EXPECT().Now().Return(now).AnyTimes()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ecPoint, err := ecKey.PublicKey.Bytes()
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	jwksPath := path.Join(t.TempDir(), "jwks.json")
+	data, err := json.Marshal(jwks{Keys: []jwk{
+		{
+			Kty: "RSA",
+			Kid: "rsa",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+		},
+		{
+			Kty: "EC",
+			Kid: "ec",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(ecPoint[1:33]),
+			Y:   base64.RawURLEncoding.EncodeToString(ecPoint[33:]),
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	if err := os.WriteFile(jwksPath, data, 0600); err != nil {
+		t.Fatal(err)
+		return
+	}
+	claims := jwt.RegisteredClaims{
+		Subject:   "user",
+		Issuer:    "issuer",
+		Audience:  jwt.ClaimStrings{"example"},
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+	}
+	sign := func(method jwt.SigningMethod, kid string, key any, claims jwt.Claims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	withClaims := func(change func(claims *jwt.RegisteredClaims)) jwt.Claims {
+		c := claims
+		change(&c)
+		return c
+	}
+	hsConfig := &Config{
+		Enabled:   true,
+		Algorithm: "HS256",
+		Secret:    "secret",
+		Issuer:    "issuer",
+		Audience:  "example",
+	}
+	type args struct {
+		config *Config
+		token  string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantSubject string
+		wantErr     error
+	}{
+		{
+			name: "HS256",
+			args: args{
+				config: hsConfig,
+				token:  sign(jwt.SigningMethodHS256, "", []byte("secret"), claims),
+			},
+			wantSubject: "user",
+			wantErr:     nil,
+		},
+		{
+			name: "RS256",
+			args: args{
+				config: &Config{Enabled: true, Algorithm: "RS256", JWKSFile: jwksPath},
+				token:  sign(jwt.SigningMethodRS256, "rsa", rsaKey, claims),
+			},
+			wantSubject: "user",
+			wantErr:     nil,
+		},
+		{
+			name: "ES256 without kid",
+			args: args{
+				config: &Config{Enabled: true, Algorithm: "ES256", JWKSFile: jwksPath},
+				token:  sign(jwt.SigningMethodES256, "", ecKey, claims),
+			},
+			wantSubject: "user",
+			wantErr:     nil,
+		},
+		{
+			name: "unknown kid",
+			args: args{
+				config: &Config{Enabled: true, Algorithm: "RS256", JWKSFile: jwksPath},
+				token:  sign(jwt.SigningMethodRS256, "other", rsaKey, claims),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "bad signature",
+			args: args{
+				config: hsConfig,
+				token:  sign(jwt.SigningMethodHS256, "", []byte("other"), claims),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "unexpected algorithm",
+			args: args{
+				config: hsConfig,
+				token:  sign(jwt.SigningMethodHS384, "", []byte("secret"), claims),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "expired",
+			args: args{
+				config: hsConfig,
+				token: sign(jwt.SigningMethodHS256, "", []byte("secret"), withClaims(func(c *jwt.RegisteredClaims) {
+					c.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+				})),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "without expiry",
+			args: args{
+				config: hsConfig,
+				token: sign(jwt.SigningMethodHS256, "", []byte("secret"), withClaims(func(c *jwt.RegisteredClaims) {
+					c.ExpiresAt = nil
+				})),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "other issuer",
+			args: args{
+				config: hsConfig,
+				token: sign(jwt.SigningMethodHS256, "", []byte("secret"), withClaims(func(c *jwt.RegisteredClaims) {
+					c.Issuer = "other"
+				})),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "other audience",
+			args: args{
+				config: hsConfig,
+				token: sign(jwt.SigningMethodHS256, "", []byte("secret"), withClaims(func(c *jwt.RegisteredClaims) {
+					c.Audience = jwt.ClaimStrings{"other"}
+				})),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "without subject",
+			args: args{
+				config: hsConfig,
+				token: sign(jwt.SigningMethodHS256, "", []byte("secret"), withClaims(func(c *jwt.RegisteredClaims) {
+					c.Subject = ""
+				})),
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name: "malformed",
+			args: args{
+				config: hsConfig,
+				token:  "token",
+			},
+			wantErr: errs.NewBadTokenError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAuthenticator(tt.args.config, mockClock, nil)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+			ctx, err := a.Authenticate(context.Background(), tt.args.token)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			subject, ok := SubjectFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantSubject, subject)
+		})
+	}
+}
+
+func TestAuthenticator_AuthenticateHeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	now := time.Now().UTC().Truncate(time.Second)
+	mockClock.EXPECT().Now().Return(now).AnyTimes()
+	mockAPIKeys := NewMockapiKeyAuthenticator(ctrl)
+	a, err := NewAuthenticator(&Config{Enabled: true, Algorithm: "HS256", Secret: "secret"}, mockClock, mockAPIKeys)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "user",
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		setup       func()
+		header      string
+		wantSubject string
+		wantErr     error
+	}{
+		{
+			name:        "bearer token",
+			setup:       func() {},
+			header:      "Bearer " + token,
+			wantSubject: "user",
+			wantErr:     nil,
+		},
+		{
+			name: "api key",
+			setup: func() {
+				mockAPIKeys.EXPECT().
+					Authenticate(ctx, "key").
+					Return(WithSubject(ctx, "apikey:id"), nil)
+			},
+			header:      "ApiKey key",
+			wantSubject: "apikey:id",
+			wantErr:     nil,
+		},
+		{
+			name: "bad api key",
+			setup: func() {
+				mockAPIKeys.EXPECT().
+					Authenticate(ctx, "key").
+					Return(nil, errs.NewBadTokenError())
+			},
+			header:  "ApiKey key",
+			wantErr: errs.NewBadTokenError(),
+		},
+		{
+			name:    "empty",
+			setup:   func() {},
+			header:  "",
+			wantErr: errs.NewUnauthenticatedError(),
+		},
+		{
+			name:    "other scheme",
+			setup:   func() {},
+			header:  "Basic dXNlcg==",
+			wantErr: errs.NewUnauthenticatedError(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got, err := a.AuthenticateHeader(ctx, tt.header)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			subject, ok := SubjectFromContext(got)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantSubject, subject)
+		})
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	jwksPath := path.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksPath, []byte(`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`), 0600); err != nil {
+		t.Fatal(err)
+		return
+	}
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:    "disabled",
+			config:  &Config{Enabled: false},
+			wantErr: false,
+		},
+		{
+			name:    "HS256 without secret",
+			config:  &Config{Enabled: true, Algorithm: "HS256"},
+			wantErr: true,
+		},
+		{
+			name:    "HS256 with the placeholder secret",
+			config:  &Config{Enabled: true, Algorithm: "HS256", Secret: "change-me"},
+			wantErr: true,
+		},
+		{
+			name:    "HS256 with secret",
+			config:  &Config{Enabled: true, Algorithm: "HS256", Secret: "secret"},
+			wantErr: false,
+		},
+		{
+			name:    "missing jwks file",
+			config:  &Config{Enabled: true, Algorithm: "RS256", JWKSFile: path.Join(t.TempDir(), "missing.json")},
+			wantErr: true,
+		},
+		{
+			name:    "jwks without keys of the algorithm",
+			config:  &Config{Enabled: true, Algorithm: "ES256", JWKSFile: jwksPath},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported algorithm",
+			config:  &Config{Enabled: true, Algorithm: "none"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAuthenticator(tt.config, mockClock, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.config.Enabled, got.Enabled())
+		})
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+		wantOk bool
+	}{
+		{name: "ok", header: "Bearer token", want: "token", wantOk: true},
+		{name: "case insensitive scheme", header: "bearer token", want: "token", wantOk: true},
+		{name: "empty", header: "", want: "", wantOk: false},
+		{name: "other scheme", header: "Basic dXNlcg==", want: "", wantOk: false},
+		{name: "without token", header: "Bearer ", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := BearerToken(tt.header)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
+
+func TestAPIKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+		wantOk bool
+	}{
+		{name: "ok", header: "ApiKey key", want: "key", wantOk: true},
+		{name: "case insensitive scheme", header: "apikey key", want: "key", wantOk: true},
+		{name: "empty", header: "", want: "", wantOk: false},
+		{name: "bearer", header: "Bearer token", want: "", wantOk: false},
+		{name: "without key", header: "ApiKey ", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := APIKey(tt.header)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+}
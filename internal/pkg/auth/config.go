@@ -0,0 +1,16 @@
+package auth
+
+import "time"
+
+// Config - Secret signs the HS256 tokens, the RS256 and ES256 tokens are
+// verified with the keys of the JWKS file. Empty Issuer and Audience are not
+// checked.
+type Config struct {
+	Enabled   bool          `env:"AUTH_ENABLED"   toml:"enabled"   env-default:"false"`
+	Algorithm string        `env:"AUTH_ALGORITHM" toml:"algorithm" env-default:"HS256"`
+	Secret    string        `env:"AUTH_SECRET"    toml:"secret"`
+	JWKSFile  string        `env:"AUTH_JWKS_FILE" toml:"jwks_file"`
+	Issuer    string        `env:"AUTH_ISSUER"    toml:"issuer"`
+	Audience  string        `env:"AUTH_AUDIENCE"  toml:"audience"`
+	Leeway    time.Duration `env:"AUTH_LEEWAY"    toml:"leeway"    env-default:"30s"`
+}
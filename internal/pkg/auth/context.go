@@ -0,0 +1,46 @@
+package auth
+
+import "context"
+
+type subjectKey struct{}
+
+// WithSubject - the context of the request made by the authenticated subject.
+func WithSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// SubjectFromContext - the authenticated subject of the request, false for an
+// anonymous one.
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(string)
+	return subject, ok && subject != ""
+}
+
+type scopesKey struct{}
+
+// WithScopes - the context of the request limited to the scopes, e.g. of an
+// API key, instead of the permissions of the roles of the subject.
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey{}, scopes)
+}
+
+// ScopesFromContext - the scopes of the request, false for a request limited by
+// the roles of the subject.
+func ScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(scopesKey{}).([]string)
+	return scopes, ok
+}
+
+type systemKey struct{}
+
+// WithSystem - the context of the trusted internal work, e.g. of the purge
+// worker, which is granted every permission without a subject.
+func WithSystem(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// IsSystem - reports whether the context is of the trusted internal work.
+func IsSystem(ctx context.Context) bool {
+	system, _ := ctx.Value(systemKey{}).(bool)
+	return system
+}
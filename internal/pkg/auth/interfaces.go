@@ -0,0 +1,17 @@
+package auth
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+	"time"
+)
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
+
+// apiKeyAuthenticator - verifies the API keys of the requests.
+type apiKeyAuthenticator interface {
+	Authenticate(ctx context.Context, key string) (context.Context, error)
+}
@@ -0,0 +1,77 @@
+package authz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+)
+
+// Permission - id of a row of public.permissions, e.g. post_create.
+type Permission string
+
+// Authorizer - checks the permissions granted to the subject of the context
+// through the roles of public.user_roles, or through the scopes of the context.
+//
+// A context without a subject is denied, the trusted internal work, e.g. of the
+// purge worker, runs with auth.WithSystem instead. The roles are read from the
+// primary, so a revoked role stops working at once.
+type Authorizer struct {
+	db     database
+	logger log.Logger
+}
+
+func NewAuthorizer(db database, logger log.Logger) *Authorizer {
+	return &Authorizer{db: db, logger: logger}
+}
+
+// Authorize - fails with errs.NewPermissionDeniedError() if none of the roles
+// of the subject grants the permission and with errs.NewUnauthenticatedError()
+// without a subject. A context with scopes, e.g. of an API
+// key, is granted the permissions of the scopes only.
+func (a *Authorizer) Authorize(ctx context.Context, permission Permission) error {
+	if auth.IsSystem(ctx) {
+		return nil
+	}
+	subject, ok := auth.SubjectFromContext(ctx)
+	if !ok {
+		a.logger.WithContext(ctx).Info("unauthenticated", log.String("permission", string(permission)))
+		return errs.NewUnauthenticatedError()
+	}
+	var granted bool
+	if scopes, ok := auth.ScopesFromContext(ctx); ok {
+		granted = slices.Contains(scopes, string(permission))
+	} else {
+		var err error
+		granted, err = a.granted(ctx, subject, permission)
+		if err != nil {
+			return err
+		}
+	}
+	if !granted {
+		a.logger.WithContext(ctx).Info("permission denied", log.String("permission", string(permission)))
+		return errs.NewPermissionDeniedError().WithParam("permission", string(permission))
+	}
+	return nil
+}
+
+func (a *Authorizer) granted(ctx context.Context, subject string, permission Permission) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	q := sq.Select("1").
+		From("public.user_roles").
+		Join("public.role_permissions ON role_permissions.role_id = user_roles.role_id").
+		Where(sq.Eq{"user_roles.user_id": subject, "role_permissions.permission_id": string(permission)}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	var granted bool
+	if err := a.db.GetContext(ctx, &granted, query, args...); err != nil {
+		return false, errs.FromPostgresError(err)
+	}
+	return granted, nil
+}
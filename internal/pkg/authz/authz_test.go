@@ -0,0 +1,109 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "SELECT EXISTS ( SELECT 1 FROM public.user_roles JOIN public.role_permissions ON role_permissions.role_id = user_roles.role_id WHERE role_permissions.permission_id = $1 AND user_roles.user_id = $2 )"
+	ctx := auth.WithSubject(context.Background(), "user")
+	dbErr := errors.New("test error")
+	tests := []struct {
+		name       string
+		setup      func()
+		ctx        context.Context
+		permission Permission
+		wantErr    error
+	}{
+		{
+			name: "granted",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs("post_create", "user").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			ctx:        ctx,
+			permission: "post_create",
+			wantErr:    nil,
+		},
+		{
+			name: "denied",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs("post_create", "user").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+			ctx:        ctx,
+			permission: "post_create",
+			wantErr:    errs.NewPermissionDeniedError().WithParam("permission", "post_create"),
+		},
+		{
+			name:       "scope granted",
+			setup:      func() {},
+			ctx:        auth.WithScopes(ctx, []string{"post_list", "post_create"}),
+			permission: "post_create",
+			wantErr:    nil,
+		},
+		{
+			name:       "scope denied",
+			setup:      func() {},
+			ctx:        auth.WithScopes(ctx, []string{"post_list"}),
+			permission: "post_create",
+			wantErr:    errs.NewPermissionDeniedError().WithParam("permission", "post_create"),
+		},
+		{
+			name:       "without subject",
+			setup:      func() {},
+			ctx:        context.Background(),
+			permission: "post_create",
+			wantErr:    errs.NewUnauthenticatedError(),
+		},
+		{
+			name:       "system",
+			setup:      func() {},
+			ctx:        auth.WithSystem(context.Background()),
+			permission: "post_create",
+			wantErr:    nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs("post_create", "user").
+					WillReturnError(dbErr)
+			},
+			ctx:        ctx,
+			permission: "post_create",
+			wantErr:    errs.FromPostgresError(dbErr),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			a := NewAuthorizer(mockDB, logger)
+			err := a.Authorize(tt.ctx, tt.permission)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
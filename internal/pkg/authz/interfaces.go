@@ -0,0 +1,8 @@
+package authz
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import "context"
+
+type database interface {
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+}
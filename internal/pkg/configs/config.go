@@ -2,21 +2,31 @@ package configs
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/grpc"
 	"github.com/mikalai-mitsin/example/internal/pkg/http"
+	"github.com/mikalai-mitsin/example/internal/pkg/inbox"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/outbox"
 	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/purge"
 	"github.com/mikalai-mitsin/example/internal/pkg/uptrace"
 )
 
 type Config struct {
-	LogLevel string           `env:"LOG_LEVEL" toml:"log_level" env-default:"debug"`
-	Database *postgres.Config `                toml:"database"`
-	Otel     *uptrace.Config  `                toml:"otel"`
-	Kafka    *kafka.Config    `                toml:"kafka"`
-	HTTP     *http.Config     `                toml:"http"`
-	GRPC     *grpc.Config     `                toml:"grpc"`
+	LogLevel     string           `env:"LOG_LEVEL" toml:"log_level" env-default:"debug"`
+	Database     *postgres.Config `                toml:"database"`
+	Transactions *dtx.Config      `                toml:"transactions"`
+	Otel         *uptrace.Config  `                toml:"otel"`
+	Kafka        *kafka.Config    `                toml:"kafka"`
+	Outbox       *outbox.Config   `                toml:"outbox"`
+	Inbox        *inbox.Config    `                toml:"inbox"`
+	Purge        *purge.Config    `                toml:"purge"`
+	HTTP         *http.Config     `                toml:"http"`
+	GRPC         *grpc.Config     `                toml:"grpc"`
+	Auth         *auth.Config     `                toml:"auth"`
 }
 
 func ParseConfig(configPath string) (*Config, error) {
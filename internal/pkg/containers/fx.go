@@ -3,16 +3,24 @@ package containers
 import (
 	"context"
 
+	"github.com/jmoiron/sqlx"
+	access "github.com/mikalai-mitsin/example/internal/app/access"
 	articles "github.com/mikalai-mitsin/example/internal/app/articles"
 	posts "github.com/mikalai-mitsin/example/internal/app/posts"
+	"github.com/mikalai-mitsin/example/internal/pkg/apikey"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/authz"
 	"github.com/mikalai-mitsin/example/internal/pkg/clock"
 	"github.com/mikalai-mitsin/example/internal/pkg/configs"
 	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
 	"github.com/mikalai-mitsin/example/internal/pkg/grpc"
 	"github.com/mikalai-mitsin/example/internal/pkg/http"
+	"github.com/mikalai-mitsin/example/internal/pkg/inbox"
 	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/outbox"
 	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/purge"
 	"github.com/mikalai-mitsin/example/internal/pkg/uptrace"
 	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
 	"go.uber.org/fx"
@@ -27,32 +35,124 @@ var FXModule = fx.Options(fx.WithLogger(func(logger log.Logger) fxevent.Logger {
 	return config.Otel
 }, func(config *configs.Config) *postgres.Config {
 	return config.Database
-}, postgres.NewDatabase, postgres.NewMigrateManager, dtx.NewManager, kafka.NewConsumer, kafka.NewProducer, func(config *configs.Config) *kafka.Config {
+}, func(config *configs.Config) *dtx.Config {
+	return config.Transactions
+}, postgres.NewDatabase, postgres.NewMigrateManager, dtx.NewManager, func(config *kafka.Config, producer *kafka.Producer, clock *clock.Clock, logger log.Logger) (*kafka.Consumer, error) {
+	return kafka.NewConsumer(config, producer, clock, logger)
+}, kafka.NewProducer, func(config *configs.Config) *kafka.Config {
 	return config.Kafka
-}, uptrace.NewProvider, posts.NewApp, articles.NewApp))
+}, func(clock *clock.Clock, uuidGenerator *uuid.UUIDv7Generator) *outbox.Outbox {
+	return outbox.NewOutbox(clock, uuidGenerator)
+}, func(config *inbox.Config, db *sqlx.DB, dtxManager *dtx.Manager, clock *clock.Clock, logger log.Logger) *inbox.Inbox {
+	return inbox.NewInbox(config, db, dtxManager, clock, logger)
+}, func(config *configs.Config) *outbox.Config {
+	return config.Outbox
+}, func(config *configs.Config) *inbox.Config {
+	return config.Inbox
+}, func(config *configs.Config) *purge.Config {
+	return config.Purge
+}, func(config *purge.Config, dtxManager *dtx.Manager, clock *clock.Clock, logger log.Logger) *purge.Worker {
+	return purge.NewWorker(config, dtxManager, clock, logger)
+}, fx.Annotate(func(writeDB postgres.Database, logger log.Logger) *authz.Authorizer {
+	return authz.NewAuthorizer(writeDB, logger)
+}, fx.ParamTags(`name:"writeDB"`)), fx.Annotate(func(writeDB postgres.Database, dtxManager *dtx.Manager, clock *clock.Clock, uuidGenerator *uuid.UUIDv7Generator, logger log.Logger) *apikey.Store {
+	return apikey.NewStore(writeDB, dtxManager, clock, uuidGenerator, logger)
+}, fx.ParamTags(`name:"writeDB"`)), func(config *configs.Config) *auth.Config {
+	return config.Auth
+}, func(config *auth.Config, clock *clock.Clock, apiKeys *apikey.Store) (*auth.Authenticator, error) {
+	return auth.NewAuthenticator(config, clock, apiKeys)
+}, uptrace.NewProvider, postgres.NewReplicaSet, postgres.NewCursorCodec, fx.Annotate(func(db *sqlx.DB) postgres.Database {
+	return db
+}, fx.ResultTags(`name:"writeDB"`)), fx.Annotate(func(replicaSet *postgres.ReplicaSet) postgres.Database {
+	return replicaSet
+}, fx.ResultTags(`name:"readDB"`)), fx.Annotate(posts.NewApp, fx.ParamTags(`name:"readDB"`, `name:"writeDB"`)), fx.Annotate(articles.NewApp, fx.ParamTags(`name:"readDB"`, `name:"writeDB"`)), fx.Annotate(access.NewApp, fx.ParamTags(`name:"readDB"`, `name:"writeDB"`))))
 
-func NewMigrateContainer(config string) *fx.App {
+// NewMigrateContainer - runs the migration command while the container is
+// built, the command error is returned by app.Err().
+func NewMigrateContainer(
+	config string,
+	command func(ctx context.Context, manager *postgres.MigrateManager) error,
+) *fx.App {
 	app := fx.New(fx.Provide(func() string {
 		return config
-	}), FXModule, fx.Invoke(func(lifecycle fx.Lifecycle, logger log.Logger, manager *postgres.MigrateManager, shutdowner fx.Shutdowner) {
-		lifecycle.Append(fx.Hook{OnStart: func(ctx context.Context) error {
-			go func() {
-				err := manager.Up(ctx)
-				if err != nil {
-					logger.Error("shutdown", log.Any("error", err))
-				}
-			}()
-			_ = shutdowner.Shutdown()
-			return nil
-		}})
+	}), FXModule, fx.Invoke(func(ctx context.Context, manager *postgres.MigrateManager) error {
+		return command(ctx, manager)
+	}))
+	return app
+}
+
+// NewSchemaContainer - verifies the database schema while the container is
+// built, the drift is returned by app.Err().
+func NewSchemaContainer(config string) *fx.App {
+	app := fx.New(fx.Provide(func() string {
+		return config
+	}), FXModule, fx.Invoke(func(ctx context.Context, db *sqlx.DB) error {
+		return postgres.VerifySchema(ctx, db, Tables()...)
 	}))
 	return app
 }
+
+// NewPurgeContainer - runs the purge command while the container is built, the
+// command error is returned by app.Err().
+func NewPurgeContainer(
+	config string,
+	command func(ctx context.Context, worker *purge.Worker) error,
+) *fx.App {
+	app := fx.New(fx.Provide(func() string {
+		return config
+	}), FXModule, fx.Invoke(func(posts *posts.App, articles *articles.App, worker *purge.Worker) error {
+		if err := posts.RegisterPurge(worker); err != nil {
+			return err
+		}
+		return articles.RegisterPurge(worker)
+	}), fx.Invoke(func(ctx context.Context, worker *purge.Worker) error {
+		return command(ctx, worker)
+	}))
+	return app
+}
+
+// NewAPIKeyContainer - runs the API key command while the container is built,
+// the command error is returned by app.Err().
+func NewAPIKeyContainer(
+	config string,
+	command func(ctx context.Context, store *apikey.Store) error,
+) *fx.App {
+	app := fx.New(fx.Provide(func() string {
+		return config
+	}), FXModule, fx.Invoke(func(ctx context.Context, store *apikey.Store) error {
+		return command(ctx, store)
+	}))
+	return app
+}
+
+// Tables - tables of all the repositories.
+func Tables() []postgres.Table {
+	tables := []postgres.Table{outbox.Table, inbox.Table}
+	tables = append(tables, posts.Tables()...)
+	tables = append(tables, articles.Tables()...)
+	tables = append(tables, access.Tables()...)
+	tables = append(tables, apikey.Tables()...)
+	return tables
+}
 func NewServerContainer(config string) *fx.App {
 	app := fx.New(fx.Provide(func() string {
 		return config
-	}), FXModule, fx.Invoke(func(lifecycle fx.Lifecycle, server *uptrace.Provider, config *configs.Config) {
+	}), FXModule, fx.Invoke(func(lifecycle fx.Lifecycle, db *sqlx.DB, config *postgres.Config) {
+		lifecycle.Append(fx.Hook{OnStart: func(ctx context.Context) error {
+			if !config.VerifySchema {
+				return nil
+			}
+			return postgres.VerifySchema(ctx, db, Tables()...)
+		}})
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, replicaSet *postgres.ReplicaSet) {
+		lifecycle.Append(fx.Hook{OnStart: replicaSet.Start, OnStop: replicaSet.Stop})
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, server *uptrace.Provider, config *configs.Config) {
 		lifecycle.Append(fx.Hook{OnStart: server.Start, OnStop: server.Stop})
+	}), fx.Invoke(func(consumer *kafka.Consumer, inbox *inbox.Inbox) {
+		consumer.AddMiddleware(inbox.Middleware)
+		consumer.AddBatchMiddleware(inbox.BatchMiddleware)
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, inbox *inbox.Inbox) {
+		lifecycle.Append(fx.Hook{OnStart: inbox.Start, OnStop: inbox.Stop})
 	}), fx.Invoke(func(lifecycle fx.Lifecycle, app *posts.App, consumer *kafka.Consumer) {
 		lifecycle.Append(fx.Hook{OnStart: func(_ context.Context) error {
 			if err := app.RegisterKafka(consumer); err != nil {
@@ -69,6 +169,26 @@ func NewServerContainer(config string) *fx.App {
 		}})
 	}), fx.Invoke(func(lifecycle fx.Lifecycle, producer *kafka.Producer) {
 		lifecycle.Append(fx.Hook{OnStart: producer.Start, OnStop: producer.Stop})
+	}), fx.Provide(func(config *outbox.Config, db *sqlx.DB, producer *kafka.Producer, clock *clock.Clock, logger log.Logger) *outbox.Relay {
+		return outbox.NewRelay(config, db, producer, clock, logger)
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, relay *outbox.Relay) {
+		lifecycle.Append(fx.Hook{OnStart: relay.Start, OnStop: relay.Stop})
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, app *posts.App, worker *purge.Worker) {
+		lifecycle.Append(fx.Hook{OnStart: func(_ context.Context) error {
+			if err := app.RegisterPurge(worker); err != nil {
+				return err
+			}
+			return nil
+		}})
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, app *articles.App, worker *purge.Worker) {
+		lifecycle.Append(fx.Hook{OnStart: func(_ context.Context) error {
+			if err := app.RegisterPurge(worker); err != nil {
+				return err
+			}
+			return nil
+		}})
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, worker *purge.Worker) {
+		lifecycle.Append(fx.Hook{OnStart: worker.Start, OnStop: worker.Stop})
 	}), fx.Invoke(func(lifecycle fx.Lifecycle, logger log.Logger, consumer *kafka.Consumer, shutdowner fx.Shutdowner) {
 		lifecycle.Append(fx.Hook{OnStart: func(ctx context.Context) error {
 			go func() {
@@ -96,6 +216,13 @@ func NewServerContainer(config string) *fx.App {
 			}
 			return nil
 		}})
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, app *access.App, server *grpc.Server) {
+		lifecycle.Append(fx.Hook{OnStart: func(_ context.Context) error {
+			if err := app.RegisterGRPC(server); err != nil {
+				return err
+			}
+			return nil
+		}})
 	}), fx.Invoke(func(lifecycle fx.Lifecycle, logger log.Logger, server *grpc.Server, shutdowner fx.Shutdowner) {
 		lifecycle.Append(fx.Hook{OnStart: func(ctx context.Context) error {
 			go func() {
@@ -123,6 +250,13 @@ func NewServerContainer(config string) *fx.App {
 			}
 			return nil
 		}})
+	}), fx.Invoke(func(lifecycle fx.Lifecycle, app *access.App, server *http.Server) {
+		lifecycle.Append(fx.Hook{OnStart: func(_ context.Context) error {
+			if err := app.RegisterHTTP(server); err != nil {
+				return err
+			}
+			return nil
+		}})
 	}), fx.Invoke(func(lifecycle fx.Lifecycle, logger log.Logger, server *http.Server, shutdowner fx.Shutdowner) {
 		lifecycle.Append(fx.Hook{OnStart: func(ctx context.Context) error {
 			go func() {
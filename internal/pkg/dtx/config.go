@@ -0,0 +1,40 @@
+package dtx
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type Config struct {
+	Isolation   Isolation     `env:"TRANSACTIONS_ISOLATION"    toml:"isolation"    env-default:"read_committed"`
+	MaxAttempts uint          `env:"TRANSACTIONS_MAX_ATTEMPTS" toml:"max_attempts" env-default:"5"`
+	Backoff     time.Duration `env:"TRANSACTIONS_BACKOFF"      toml:"backoff"      env-default:"10ms"`
+	MaxBackoff  time.Duration `env:"TRANSACTIONS_MAX_BACKOFF"  toml:"max_backoff"  env-default:"1s"`
+}
+
+var isolationLevels = map[string]sql.IsolationLevel{
+	"default":          sql.LevelDefault,
+	"read_uncommitted": sql.LevelReadUncommitted,
+	"read_committed":   sql.LevelReadCommitted,
+	"repeatable_read":  sql.LevelRepeatableRead,
+	"serializable":     sql.LevelSerializable,
+}
+
+// Isolation - isolation level of the transactions which are run without
+// options, e.g. repeatable_read or serializable.
+type Isolation sql.IsolationLevel
+
+func (i *Isolation) UnmarshalText(text []byte) error {
+	level, ok := isolationLevels[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown isolation level %q", text)
+	}
+	*i = Isolation(level)
+	return nil
+}
+
+// SetValue - parses the isolation level from the environment.
+func (i *Isolation) SetValue(value string) error {
+	return i.UnmarshalText([]byte(value))
+}
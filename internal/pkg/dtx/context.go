@@ -0,0 +1,49 @@
+package dtx
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+)
+
+type txKey struct{}
+
+type savepointKey struct{}
+
+// WithTX - binds the transaction to the context, RunInTx with this context
+// joins it instead of beginning a new one.
+func WithTX(ctx context.Context, tx TX) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TXFromContext - transaction bound to the context.
+func TXFromContext(ctx context.Context) (TX, bool) {
+	tx, ok := ctx.Value(txKey{}).(TX)
+	return tx, ok
+}
+
+// mapper - maps the struct fields to the columns the same way sqlx.DB does.
+var mapper = reflectx.NewMapperFunc("db", sqlx.NameMapper)
+
+// Database - the transaction bound to the context, so the repositories read and
+// write within it, db if the context has no transaction.
+func Database(ctx context.Context, db postgres.Database) postgres.Database {
+	tx, ok := TXFromContext(ctx)
+	if !ok {
+		return db
+	}
+	return &sqlx.Tx{Tx: tx.GetSQLTx(), Mapper: mapper}
+}
+
+func withSavepoint(ctx context.Context) context.Context {
+	return context.WithValue(ctx, savepointKey{}, savepointFromContext(ctx)+1)
+}
+
+// savepointFromContext - depth of the savepoint the context runs in, zero
+// outside of savepoints.
+func savepointFromContext(ctx context.Context) int {
+	depth, _ := ctx.Value(savepointKey{}).(int)
+	return depth
+}
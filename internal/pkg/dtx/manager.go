@@ -1,18 +1,152 @@
 package dtx
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
 	"github.com/jmoiron/sqlx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Manager struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	config *Config
+}
+
+func NewManager(db *sqlx.DB, config *Config) *Manager {
+	return &Manager{db: db, config: config}
+}
+
+// NewTx - begins a new transaction, the caller commits or rolls it back.
+func (m *Manager) NewTx(ctx context.Context, opts *sql.TxOptions) (TX, error) {
+	if opts == nil || !opts.ReadOnly {
+		postgres.MarkWrite(ctx)
+	}
+	tx, err := m.db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, errs.FromPostgresError(err)
+	}
+	return NewTXWithSQL(tx), nil
+}
+
+// RunInTx - runs fn within a transaction bound to the context, the
+// transaction is committed if fn succeeds and rolled back otherwise.
+//
+// A transaction aborted by a serialization failure or a deadlock is run again
+// from scratch with a jittered backoff, so fn must not have side effects
+// outside of the transaction. The retries are recorded in the span of the
+// context.
+//
+// A nested call joins the transaction of the context within a savepoint, so a
+// failed nested call rolls back only its own changes. The options of a nested
+// call are ignored and a nested call is never retried, the outer transaction
+// is.
+func (m *Manager) RunInTx(
+	ctx context.Context,
+	opts *sql.TxOptions,
+	fn func(ctx context.Context) error,
+) error {
+	if tx, ok := TXFromContext(ctx); ok {
+		return runInSavepoint(ctx, tx, fn)
+	}
+	if opts == nil {
+		opts = &sql.TxOptions{Isolation: sql.IsolationLevel(m.config.Isolation), ReadOnly: false}
+	}
+	span := trace.SpanFromContext(ctx)
+	attempts := max(m.config.MaxAttempts, 1)
+	for attempt := uint(1); ; attempt++ {
+		err := m.runInTx(ctx, opts, fn)
+		if err == nil || attempt >= attempts || !isAborted(err) {
+			if attempt > 1 {
+				span.SetAttributes(attribute.Int("db.transaction.retries", int(attempt-1)))
+			}
+			return err
+		}
+		span.AddEvent("db.transaction.retry", trace.WithAttributes(
+			attribute.Int("db.transaction.attempt", int(attempt)),
+			attribute.String("error", err.Error()),
+		))
+		timer := time.NewTimer(m.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+func (m *Manager) runInTx(
+	ctx context.Context,
+	opts *sql.TxOptions,
+	fn func(ctx context.Context) error,
+) error {
+	tx, err := m.NewTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(WithTX(ctx, tx)); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Join(err, errs.FromPostgresError(rollbackErr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	return nil
+}
+
+// backoff - exponential delay before the next attempt, half of it is random
+// to spread the conflicting transactions.
+func (m *Manager) backoff(attempt uint) time.Duration {
+	if m.config.Backoff <= 0 {
+		return 0
+	}
+	delay := m.config.Backoff << min(attempt-1, 32)
+	if delay <= 0 || (m.config.MaxBackoff > 0 && delay > m.config.MaxBackoff) {
+		delay = m.config.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
 }
 
-func NewManager(db *sqlx.DB) *Manager {
-	return &Manager{db: db}
+// isAborted - reports whether the transaction is aborted by a conflict with a
+// concurrent one.
+func isAborted(err error) bool {
+	var domainError *errs.Error
+	return errors.As(err, &domainError) && domainError.Code == errs.ErrorCodeAborted
 }
 
-func (m *Manager) NewTx() TX {
-	tx, _ := m.db.Begin()
-	return NewTXWithSQL(tx)
+func runInSavepoint(ctx context.Context, tx TX, fn func(ctx context.Context) error) error {
+	ctx = withSavepoint(ctx)
+	name := fmt.Sprintf("dtx_savepoint_%d", savepointFromContext(ctx))
+	if _, err := tx.GetSQLTx().ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	if err := fn(ctx); err != nil {
+		if _, rollbackErr := tx.GetSQLTx().ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rollbackErr != nil {
+			return errors.Join(err, errs.FromPostgresError(rollbackErr))
+		}
+		return err
+	}
+	if _, err := tx.GetSQLTx().ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	return nil
 }
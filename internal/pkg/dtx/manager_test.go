@@ -0,0 +1,295 @@
+package dtx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_RunInTx(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	m := NewManager(mockDB, &Config{})
+	testErr := errs.NewUnexpectedBehaviorError("test error")
+	type args struct {
+		opts *sql.TxOptions
+		fn   func(ctx context.Context) error
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectCommit()
+			},
+			args: args{
+				opts: nil,
+				fn: func(ctx context.Context) error {
+					_, ok := TXFromContext(ctx)
+					assert.True(t, ok)
+					return nil
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "fn error",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			args: args{
+				opts: nil,
+				fn: func(_ context.Context) error {
+					return testErr
+				},
+			},
+			wantErr: testErr,
+		},
+		{
+			name: "begin error",
+			setup: func() {
+				mock.ExpectBegin().WillReturnError(errors.New("test error"))
+			},
+			args: args{
+				opts: nil,
+				fn: func(_ context.Context) error {
+					t.Fatal("fn must not be called")
+					return nil
+				},
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+		{
+			name: "commit error",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectCommit().WillReturnError(errors.New("test error"))
+			},
+			args: args{
+				opts: nil,
+				fn: func(_ context.Context) error {
+					return nil
+				},
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+		{
+			name: "nested calls use savepoints",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec("SAVEPOINT dtx_savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("SAVEPOINT dtx_savepoint_2").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("ROLLBACK TO SAVEPOINT dtx_savepoint_2").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("RELEASE SAVEPOINT dtx_savepoint_1").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			args: args{
+				opts: &sql.TxOptions{Isolation: sql.LevelSerializable},
+				fn: func(ctx context.Context) error {
+					return m.RunInTx(ctx, nil, func(ctx context.Context) error {
+						err := m.RunInTx(ctx, nil, func(_ context.Context) error {
+							return testErr
+						})
+						assert.ErrorIs(t, err, testErr)
+						return nil
+					})
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			err := m.RunInTx(context.Background(), tt.args.opts, tt.args.fn)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestManager_RunInTx_panic(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	assert.Panics(t, func() {
+		_ = NewManager(mockDB, &Config{}).RunInTx(context.Background(), nil, func(_ context.Context) error {
+			panic("test panic")
+		})
+	})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestManager_RunInTx_retry(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	m := NewManager(mockDB, &Config{
+		Isolation:   Isolation(sql.LevelSerializable),
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+	conflict := &pq.Error{Code: "40001", Message: "could not serialize access"}
+	tests := []struct {
+		name         string
+		setup        func()
+		failures     int
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name: "retried until success",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+				mock.ExpectBegin()
+				mock.ExpectCommit()
+			},
+			failures:     2,
+			wantAttempts: 3,
+			wantErr:      nil,
+		},
+		{
+			name: "attempts are over",
+			setup: func() {
+				for range 3 {
+					mock.ExpectBegin()
+					mock.ExpectRollback()
+				}
+			},
+			failures:     3,
+			wantAttempts: 3,
+			wantErr:      errs.FromPostgresError(conflict),
+		},
+		{
+			name: "commit conflict",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectCommit().WillReturnError(conflict)
+				mock.ExpectBegin()
+				mock.ExpectCommit()
+			},
+			failures:     0,
+			wantAttempts: 2,
+			wantErr:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			attempts := 0
+			err := m.RunInTx(context.Background(), nil, func(_ context.Context) error {
+				attempts++
+				if attempts <= tt.failures {
+					return errs.FromPostgresError(conflict)
+				}
+				return nil
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantAttempts, attempts)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestManager_RunInTx_contention(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	replicaDB, replica, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer replicaDB.Close()
+	m := NewManager(mockDB, &Config{
+		Isolation:   Isolation(sql.LevelSerializable),
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+	conflict := &pq.Error{Code: "40001", Message: "could not serialize access"}
+	const (
+		selectQuery = "SELECT views FROM public.counters WHERE id = $1 FOR UPDATE"
+		updateQuery = "UPDATE public.counters SET views = $1 WHERE id = $2"
+	)
+	// The first attempt loses to a concurrent transaction which has committed
+	// 5 views, the retry must read them within its own transaction.
+	mock.ExpectBegin()
+	mock.ExpectQuery(selectQuery).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"views"}).AddRow(1))
+	mock.ExpectExec(updateQuery).WithArgs(2, 1).WillReturnError(conflict)
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectQuery(selectQuery).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"views"}).AddRow(5))
+	mock.ExpectExec(updateQuery).WithArgs(6, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	attempts := 0
+	err = m.RunInTx(context.Background(), nil, func(ctx context.Context) error {
+		attempts++
+		var views int
+		if err := Database(ctx, replicaDB).GetContext(ctx, &views, selectQuery, 1); err != nil {
+			return errs.FromPostgresError(err)
+		}
+		if _, err := Database(ctx, mockDB).ExecContext(ctx, updateQuery, views+1, 1); err != nil {
+			return errs.FromPostgresError(err)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, replica.ExpectationsWereMet())
+}
+
+func TestManager_backoff(t *testing.T) {
+	m := NewManager(nil, &Config{Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond})
+	for attempt, want := range map[uint]time.Duration{
+		1: 10 * time.Millisecond,
+		2: 20 * time.Millisecond,
+		3: 40 * time.Millisecond,
+		4: 50 * time.Millisecond,
+		9: 50 * time.Millisecond,
+	} {
+		got := m.backoff(attempt)
+		assert.GreaterOrEqual(t, got, want/2)
+		assert.LessOrEqual(t, got, want)
+	}
+}
@@ -0,0 +1,13 @@
+package dtx
+
+import (
+	"context"
+	"database/sql"
+)
+
+// RunInMockTX - RunInTx of a mocked manager, runs fn within the transaction.
+func RunInMockTX(tx TX) func(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error {
+	return func(ctx context.Context, _ *sql.TxOptions, fn func(ctx context.Context) error) error {
+		return fn(WithTX(ctx, tx))
+	}
+}
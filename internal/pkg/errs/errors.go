@@ -78,13 +78,46 @@ func NewEntityNotFoundError() *Error {
 	return NewError(ErrorCodeNotFound, "Name not found.")
 }
 func NewBadTokenError() *Error {
-	return NewError(ErrorCodePermissionDenied, "Bad token.")
+	return NewError(ErrorCodeUnauthenticated, "Bad token.")
 }
 func NewPermissionDeniedError() *Error {
 	return NewError(ErrorCodePermissionDenied, "Permission denied.")
 }
 func NewUnauthenticatedError() *Error {
-	return NewError(ErrorCodeFailedPrecondition, "Unauthenticated error.")
+	return NewError(ErrorCodeUnauthenticated, "Unauthenticated error.")
+}
+
+// NewAlreadyExistsError - the entity conflicts with an existing one on a unique
+// key.
+func NewAlreadyExistsError() *Error {
+	return NewError(ErrorCodeAlreadyExists, "Entity already exists.")
+}
+
+// NewReferenceNotFoundError - the entity refers to another one which does not
+// exist or is deleted.
+func NewReferenceNotFoundError() *Error {
+	return NewError(ErrorCodeFailedPrecondition, "Referenced entity not found.")
+}
+
+// IsTemporary - reports whether the operation may succeed if retried.
+func IsTemporary(err error) bool {
+	if err == nil {
+		return false
+	}
+	var domainError *Error
+	if !errors.As(err, &domainError) {
+		return true
+	}
+	switch domainError.Code {
+	case ErrorCodeUnknown,
+		ErrorCodeDeadlineExceeded,
+		ErrorCodeAborted,
+		ErrorCodeInternal,
+		ErrorCodeUnavailable:
+		return true
+	default:
+		return false
+	}
 }
 func (e *Error) Cause() error {
 	return e.Err
@@ -503,3 +503,47 @@ func TestNewUnexpectedBehaviorErrorAsJson(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTemporary(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "not a domain error",
+			err:  errors.New("test error"),
+			want: true,
+		},
+		{
+			name: "internal",
+			err:  NewUnexpectedBehaviorError("test error"),
+			want: true,
+		},
+		{
+			name: "aborted",
+			err:  NewError(ErrorCodeAborted, "Aborted."),
+			want: true,
+		},
+		{
+			name: "invalid form",
+			err:  NewInvalidFormError(),
+			want: false,
+		},
+		{
+			name: "not found",
+			err:  NewEntityNotFoundError(),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTemporary(tt.err))
+		})
+	}
+}
@@ -4,11 +4,23 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/lib/pq"
 )
 
-const sqlConflictCode = "23505"
+const (
+	sqlConflictCode             = "23505"
+	sqlForeignKeyViolationCode  = "23503"
+	sqlSerializationFailureCode = "40001"
+	sqlDeadlockDetectedCode     = "40P01"
+)
+
+// keyDetail - detail of the foreign key or the unique violation with the
+// columns and the values, e.g. Key (post_id)=(...) is not present in table
+// "posts" or Key (post_id, user_id)=(..., ...) already exists.
+var keyDetail = regexp.MustCompile(`^Key \(([^)]+)\)=\(([^)]*)\)`)
 
 func FromPostgresError(err error) *Error {
 	e := &Error{Code: ErrorCodeInternal, Message: "Unexpected behavior.", Params: nil, Err: err}
@@ -17,8 +29,16 @@ func FromPostgresError(err error) *Error {
 		e.AddParam("details", pqErr.Detail)
 		e.AddParam("message", pqErr.Message)
 		e.AddParam("postgres_code", fmt.Sprint(pqErr.Code))
-		if pqErr.Code == sqlConflictCode {
-			e = NewInvalidFormError().WithCause(err)
+		switch pqErr.Code {
+		case sqlConflictCode:
+			e = NewAlreadyExistsError().WithCause(err)
+			addKeyParams(e, pqErr.Detail)
+		case sqlForeignKeyViolationCode:
+			e = NewReferenceNotFoundError().WithCause(err)
+			addKeyParams(e, pqErr.Detail)
+		case sqlSerializationFailureCode, sqlDeadlockDetectedCode:
+			e.Code = ErrorCodeAborted
+			e.Message = "Concurrent update, please retry."
 		}
 	}
 	e.AddParam("error", err.Error())
@@ -27,3 +47,19 @@ func FromPostgresError(err error) *Error {
 	}
 	return e
 }
+
+// addKeyParams - adds the columns of the key of the violation with their values.
+func addKeyParams(e *Error, detail string) {
+	match := keyDetail.FindStringSubmatch(detail)
+	if match == nil {
+		return
+	}
+	columns := strings.Split(match[1], ", ")
+	values := strings.Split(match[2], ", ")
+	if len(columns) != len(values) {
+		return
+	}
+	for i := range columns {
+		e.AddParam(columns[i], values[i])
+	}
+}
@@ -1,8 +1,12 @@
 package errs
 
 import (
+	"database/sql"
+	"errors"
 	"testing"
 
+	"github.com/lib/pq"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,13 +20,124 @@ func TestFromPostgresError(t *testing.T) {
 		args  args
 		want  *Error
 	}{
-		// TODO: Add test cases.
+		{
+			name:  "unexpected error",
+			setup: func() {},
+			args: args{
+				err: errors.New("test error"),
+			},
+			want: &Error{
+				Code:    ErrorCodeInternal,
+				Message: "Unexpected behavior.",
+				Params:  Params{{Key: "error", Value: "test error"}},
+				Err:     errors.New("test error"),
+			},
+		},
+		{
+			name:  "no rows",
+			setup: func() {},
+			args: args{
+				err: sql.ErrNoRows,
+			},
+			want: NewEntityNotFoundError(),
+		},
+		{
+			name:  "serialization failure",
+			setup: func() {},
+			args: args{
+				err: &pq.Error{Code: "40001", Message: "could not serialize access"},
+			},
+			want: &Error{
+				Code:    ErrorCodeAborted,
+				Message: "Concurrent update, please retry.",
+				Params: Params{
+					{Key: "details", Value: ""},
+					{Key: "message", Value: "could not serialize access"},
+					{Key: "postgres_code", Value: "40001"},
+					{Key: "error", Value: "pq: could not serialize access"},
+				},
+				Err: &pq.Error{Code: "40001", Message: "could not serialize access"},
+			},
+		},
+		{
+			name:  "deadlock",
+			setup: func() {},
+			args: args{
+				err: &pq.Error{Code: "40P01", Message: "deadlock detected"},
+			},
+			want: &Error{
+				Code:    ErrorCodeAborted,
+				Message: "Concurrent update, please retry.",
+				Params: Params{
+					{Key: "details", Value: ""},
+					{Key: "message", Value: "deadlock detected"},
+					{Key: "postgres_code", Value: "40P01"},
+					{Key: "error", Value: "pq: deadlock detected"},
+				},
+				Err: &pq.Error{Code: "40P01", Message: "deadlock detected"},
+			},
+		},
+		{
+			name:  "foreign key violation",
+			setup: func() {},
+			args: args{
+				err: &pq.Error{
+					Code:    "23503",
+					Message: `insert or update on table "tags" violates foreign key constraint "tags_post_id_fk"`,
+					Detail:  `Key (post_id)=(0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d) is not present in table "posts".`,
+				},
+			},
+			want: &Error{
+				Code:    ErrorCodeFailedPrecondition,
+				Message: "Referenced entity not found.",
+				Params: Params{
+					{Key: "post_id", Value: "0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d"},
+					{
+						Key:   "error",
+						Value: `pq: insert or update on table "tags" violates foreign key constraint "tags_post_id_fk"`,
+					},
+				},
+				Err: &pq.Error{
+					Code:    "23503",
+					Message: `insert or update on table "tags" violates foreign key constraint "tags_post_id_fk"`,
+					Detail:  `Key (post_id)=(0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d) is not present in table "posts".`,
+				},
+			},
+		},
+		{
+			name:  "unique violation",
+			setup: func() {},
+			args: args{
+				err: &pq.Error{
+					Code:    "23505",
+					Message: `duplicate key value violates unique constraint "likes_post_id_user_id_key"`,
+					Detail:  `Key (post_id, user_id)=(0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d, 0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8e) already exists.`,
+				},
+			},
+			want: &Error{
+				Code:    ErrorCodeAlreadyExists,
+				Message: "Entity already exists.",
+				Params: Params{
+					{Key: "post_id", Value: "0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d"},
+					{Key: "user_id", Value: "0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8e"},
+					{
+						Key:   "error",
+						Value: `pq: duplicate key value violates unique constraint "likes_post_id_user_id_key"`,
+					},
+				},
+				Err: &pq.Error{
+					Code:    "23505",
+					Message: `duplicate key value violates unique constraint "likes_post_id_user_id_key"`,
+					Detail:  `Key (post_id, user_id)=(0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8d, 0190a1d4-8f6e-7c3a-9b1e-2f4d5a6b7c8e) already exists.`,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 			got := FromPostgresError(tt.args.err)
-			assert.Equal(t, got, tt.want)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
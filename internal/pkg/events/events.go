@@ -0,0 +1,16 @@
+package events
+
+// Type - kind of the change that happened to an aggregate.
+type Type string
+
+const (
+	TypeCreated  Type = "created"
+	TypeUpdated  Type = "updated"
+	TypeDeleted  Type = "deleted"
+	TypeRestored Type = "restored"
+	TypePurged   Type = "purged"
+)
+
+func (t Type) String() string {
+	return string(t)
+}
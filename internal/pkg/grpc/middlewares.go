@@ -3,18 +3,26 @@ package grpc
 import (
 	"context"
 	"errors"
+	"strings"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// publicServices - services called without authentication.
+var publicServices = []string{"/grpc.health.v1.Health/", "/grpc.reflection."}
+
 func defaultMessageProducer(
 	ctx context.Context,
 	msg string,
@@ -63,6 +71,78 @@ func unaryErrorServerInterceptor(ctx context.Context, req interface {
 	resp, err := handler(ctx, req)
 	return resp, handleUnaryServerError(ctx, req, info, err)
 }
+
+// unaryReadYourWritesServerInterceptor - sends the reads of the call to the
+// primary once the call makes a write.
+func unaryReadYourWritesServerInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(postgres.WithReadYourWrites(ctx), req)
+}
+
+// unaryAuthServerInterceptor - authenticates the calls with the bearer token or
+// the API key of the authorization metadata, the subject of them goes into the
+// context.
+func unaryAuthServerInterceptor(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := authenticate(ctx, authenticator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamAuthServerInterceptor - authenticates the streams the same way as
+// unaryAuthServerInterceptor does the calls.
+func streamAuthServerInterceptor(authenticator *auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(stream.Context(), authenticator, info.FullMethod)
+		if err != nil {
+			return handleUnaryServerError(stream.Context(), nil, nil, err)
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+func authenticate(
+	ctx context.Context,
+	authenticator *auth.Authenticator,
+	method string,
+) (context.Context, error) {
+	for _, service := range publicServices {
+		if strings.HasPrefix(method, service) {
+			return ctx, nil
+		}
+	}
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		return nil, errs.NewUnauthenticatedError()
+	}
+	ctx, err := authenticator.AuthenticateHeader(ctx, values[0])
+	if err != nil {
+		return nil, err
+	}
+	if subject, ok := auth.SubjectFromContext(ctx); ok {
+		ctxzap.AddFields(ctx, zap.String("subject", subject))
+	}
+	return ctx, nil
+}
 func handleUnaryServerError(_ context.Context, _ any, _ *grpc.UnaryServerInfo, err error) error {
 	if err == nil {
 		return nil
@@ -5,6 +5,7 @@ import (
 	"net"
 
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -14,31 +15,45 @@ import (
 )
 
 type Server struct {
-	logger            log.Logger
-	server            *grpc.Server
-	config            *Config
-	handlers          map[*grpc.ServiceDesc]any
-	unaryInterceptors []grpc.UnaryServerInterceptor
+	logger             log.Logger
+	server             *grpc.Server
+	config             *Config
+	handlers           map[*grpc.ServiceDesc]any
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
 }
 
-func NewServer(logger log.Logger, config *Config) *Server {
-	return &Server{
+func NewServer(logger log.Logger, config *Config, authenticator *auth.Authenticator) *Server {
+	server := &Server{
 		logger:   logger,
 		server:   nil,
 		config:   config,
 		handlers: map[*grpc.ServiceDesc]any{},
 		unaryInterceptors: []grpc.UnaryServerInterceptor{
 			unaryErrorServerInterceptor,
+			unaryReadYourWritesServerInterceptor,
 			grpc_zap.UnaryServerInterceptor(
 				logger.Logger(),
 				grpc_zap.WithMessageProducer(defaultMessageProducer),
 			),
 		},
 	}
+	if authenticator.Enabled() {
+		server.unaryInterceptors = append(
+			server.unaryInterceptors,
+			unaryAuthServerInterceptor(authenticator),
+		)
+		server.streamInterceptors = append(
+			server.streamInterceptors,
+			streamAuthServerInterceptor(authenticator),
+		)
+	}
+	return server
 }
 func (s *Server) Start(_ context.Context) error {
 	s.server = grpc.NewServer(
 		grpc.ChainUnaryInterceptor(s.unaryInterceptors...),
+		grpc.ChainStreamInterceptor(s.streamInterceptors...),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
 	for sd, ss := range s.handlers {
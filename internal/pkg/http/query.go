@@ -0,0 +1,40 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+// ParseUUIDs - values of the repeated query parameter, e.g. ?id=...&id=...
+func ParseUUIDs(r *http.Request, key string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, value := range r.URL.Query()[key] {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, errs.NewInvalidFormError().
+				WithParam(key, fmt.Sprintf("Invalid %s.", key)).
+				WithCause(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ParseTime - RFC 3339 timestamp of the query parameter, nil if it is missing.
+func ParseTime(r *http.Request, key string) (*time.Time, error) {
+	if !r.URL.Query().Has(key) {
+		return nil, nil
+	}
+	value, err := time.Parse(time.RFC3339, r.URL.Query().Get(key))
+	if err != nil {
+		return nil, errs.NewInvalidFormError().
+			WithParam(key, fmt.Sprintf("Invalid %s.", key)).
+			WithCause(err)
+	}
+	return pointer.Of(value), nil
+}
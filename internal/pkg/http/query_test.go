@@ -0,0 +1,83 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/pointer"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUUIDs(t *testing.T) {
+	first, second := uuid.NewUUID(), uuid.NewUUID()
+	tests := []struct {
+		name    string
+		target  string
+		want    []uuid.UUID
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			target:  "/?id=" + first.String() + "&id=" + second.String(),
+			want:    []uuid.UUID{first, second},
+			wantErr: nil,
+		},
+		{
+			name:    "missing",
+			target:  "/",
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name:    "invalid",
+			target:  "/?id=" + first.String() + "&id=invalid",
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("id", "Invalid id."),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseUUIDs(httptest.NewRequest("GET", tt.target, nil), "id")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		want    *time.Time
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			target:  "/?created_after=2026-01-02T03:04:05Z",
+			want:    pointer.Of(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+			wantErr: nil,
+		},
+		{
+			name:    "missing",
+			target:  "/",
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name:    "invalid",
+			target:  "/?created_after=yesterday",
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("created_after", "Invalid created_after."),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTime(httptest.NewRequest("GET", tt.target, nil), "created_after")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
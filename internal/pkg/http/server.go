@@ -7,7 +7,10 @@ import (
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
 	"github.com/riandyrn/otelchi"
 )
 
@@ -25,10 +28,17 @@ type Server struct {
 // @BasePath /
 // @version 0.0.0
 // @securitydefinitions.BearerAuth BearerAuth
-func NewServer(config *Config, logger log.Logger) *Server {
+// @securitydefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+func NewServer(config *Config, logger log.Logger, authenticator *auth.Authenticator) *Server {
 	router := chi.NewRouter()
 	router.Use(otelchi.Middleware("example"))
 	router.Use(loggerMiddleware(logger))
+	if authenticator.Enabled() {
+		router.Use(authMiddleware(authenticator))
+	}
+	router.Use(readYourWritesMiddleware)
 	server := &http.Server{Addr: config.Address, Handler: router}
 	return &Server{server: server, config: config, router: router, logger: logger}
 }
@@ -52,3 +62,40 @@ func loggerMiddleware(logger log.Logger) func(next http.Handler) http.Handler {
 		})
 	}
 }
+
+// authMiddleware - authenticates the requests with the bearer token or the API
+// key of the Authorization header, the subject of them goes into the context.
+func authMiddleware(authenticator *auth.Authenticator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			ctx, err := authenticator.AuthenticateHeader(r.Context(), header)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", challenge(header))
+				errs.RenderToHTTPResponse(err, w, r)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// challenge - WWW-Authenticate value of the request failed to authenticate
+// with the Authorization header value.
+func challenge(header string) string {
+	if _, ok := auth.APIKey(header); ok {
+		return `ApiKey error="invalid_token"`
+	}
+	if _, ok := auth.BearerToken(header); ok {
+		return `Bearer error="invalid_token"`
+	}
+	return "Bearer, ApiKey"
+}
+
+// readYourWritesMiddleware - sends the reads of the request to the primary
+// once the request makes a write.
+func readYourWritesMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(postgres.WithReadYourWrites(r.Context())))
+	})
+}
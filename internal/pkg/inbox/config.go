@@ -0,0 +1,11 @@
+package inbox
+
+import "time"
+
+// Config - Retention is how long the processed messages are recorded, a message
+// redelivered after it is processed again. Zero CleanupInterval disables the
+// cleanup.
+type Config struct {
+	CleanupInterval time.Duration `env:"INBOX_CLEANUP_INTERVAL" toml:"cleanup_interval" env-default:"1h"`
+	Retention       time.Duration `env:"INBOX_RETENTION"        toml:"retention"        env-default:"168h"`
+}
@@ -0,0 +1,196 @@
+package inbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+)
+
+type messageDTO struct {
+	GroupID     string    `db:"group_id"`
+	Topic       string    `db:"topic"`
+	MessageID   string    `db:"message_id"`
+	ProcessedAt time.Time `db:"processed_at"`
+}
+
+// Table - inbox table with the columns the middleware uses.
+var Table = postgres.NewTable("public.inbox", messageDTO{})
+
+// Inbox - skips messages which are already processed by the consumer group.
+//
+// A message is recorded in the same transaction as the changes made by its
+// handler, the transaction is bound to the handler context and the use cases
+// join it within savepoints, so a redelivered message is either skipped or
+// processed again from scratch. The records older than the retention are
+// removed periodically.
+type Inbox struct {
+	config     *Config
+	db         *sqlx.DB
+	dtxManager dtxManager
+	clock      clock
+	logger     log.Logger
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func NewInbox(
+	config *Config,
+	db *sqlx.DB,
+	dtxManager dtxManager,
+	clock clock,
+	logger log.Logger,
+) *Inbox {
+	return &Inbox{config: config, db: db, dtxManager: dtxManager, clock: clock, logger: logger}
+}
+
+func (i *Inbox) Start(_ context.Context) error {
+	if i.config.CleanupInterval <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	i.cancel = cancel
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+		ticker := time.NewTicker(i.config.CleanupInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := i.Cleanup(ctx); err != nil {
+					i.logger.Error("inbox cleanup error", log.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (i *Inbox) Stop(_ context.Context) error {
+	if i.cancel != nil {
+		i.cancel()
+	}
+	i.wg.Wait()
+	return nil
+}
+
+// Cleanup - removes messages processed before retention.
+func (i *Inbox) Cleanup(ctx context.Context) error {
+	q := sq.Delete("public.inbox").
+		Where(sq.Lt{"processed_at": i.clock.Now().UTC().Add(-i.config.Retention)})
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if _, err := i.db.ExecContext(ctx, query, args...); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	return nil
+}
+
+// Middleware - kafka.Middleware which deduplicates the handler messages.
+func (i *Inbox) Middleware(handler kafka.Handler, next kafka.HandlerFunc) kafka.HandlerFunc {
+	messageID := handler.MessageID
+	if messageID == nil {
+		messageID = kafka.OffsetID
+	}
+	return func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		logger := i.logger.WithContext(ctx)
+		topic := kafka.OriginalTopic(msg)
+		id := messageID(msg)
+		return i.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+			processed, err := i.record(ctx, handler.GroupID, topic, id)
+			if err != nil {
+				return err
+			}
+			if !processed {
+				logger.Info(
+					"skipped duplicate message",
+					log.String("group", handler.GroupID),
+					log.String("topic", topic),
+					log.String("message_id", id),
+				)
+				return nil
+			}
+			return next(ctx, msg)
+		})
+	}
+}
+
+// BatchMiddleware - kafka.BatchMiddleware which deduplicates the messages of
+// the handler batches. The batch is handled without the recorded messages in
+// the transaction of the records, so a failed batch records nothing.
+func (i *Inbox) BatchMiddleware(
+	handler kafka.Handler,
+	next kafka.BatchHandlerFunc,
+) kafka.BatchHandlerFunc {
+	messageID := handler.MessageID
+	if messageID == nil {
+		messageID = kafka.OffsetID
+	}
+	return func(ctx context.Context, msgs []*sarama.ConsumerMessage) error {
+		logger := i.logger.WithContext(ctx)
+		return i.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+			fresh := make([]*sarama.ConsumerMessage, 0, len(msgs))
+			for _, msg := range msgs {
+				topic := kafka.OriginalTopic(msg)
+				id := messageID(msg)
+				processed, err := i.record(ctx, handler.GroupID, topic, id)
+				if err != nil {
+					return err
+				}
+				if !processed {
+					logger.Info(
+						"skipped duplicate message",
+						log.String("group", handler.GroupID),
+						log.String("topic", topic),
+						log.String("message_id", id),
+					)
+					continue
+				}
+				fresh = append(fresh, msg)
+			}
+			if len(fresh) == 0 {
+				return nil
+			}
+			return next(ctx, fresh)
+		})
+	}
+}
+
+// record - returns false if the message is already recorded.
+func (i *Inbox) record(
+	ctx context.Context,
+	groupID string,
+	topic string,
+	messageID string,
+) (bool, error) {
+	tx, ok := dtx.TXFromContext(ctx)
+	if !ok {
+		return false, errs.NewUnexpectedBehaviorError("inbox message outside of a transaction")
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	q := sq.Insert("public.inbox").
+		Columns("group_id", "topic", "message_id", "processed_at").
+		Values(groupID, topic, messageID, i.clock.Now().UTC()).
+		Suffix("ON CONFLICT DO NOTHING")
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	result, err := tx.GetSQLTx().ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, errs.FromPostgresError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, errs.FromPostgresError(err)
+	}
+	return affected > 0, nil
+}
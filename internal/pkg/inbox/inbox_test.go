@@ -0,0 +1,316 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInbox_Middleware(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "INSERT INTO public.inbox (group_id,topic,message_id,processed_at) VALUES ($1,$2,$3,$4) ON CONFLICT DO NOTHING"
+	now := time.Now().UTC()
+	msg := &sarama.ConsumerMessage{Topic: "topic", Partition: 2, Offset: 7}
+	tests := []struct {
+		name       string
+		setup      func()
+		handlerErr error
+		wantCalled bool
+		wantErr    error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			handlerErr: nil,
+			wantCalled: true,
+			wantErr:    nil,
+		},
+		{
+			name: "duplicate",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			handlerErr: nil,
+			wantCalled: false,
+			wantErr:    nil,
+		},
+		{
+			name: "handler error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			handlerErr: errs.NewUnexpectedBehaviorError("test error"),
+			wantCalled: true,
+			wantErr:    errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnError(errors.New("test error"))
+				mock.ExpectRollback()
+			},
+			handlerErr: nil,
+			wantCalled: false,
+			wantErr:    errs.FromPostgresError(errors.New("test error")),
+		},
+		{
+			name: "begin error",
+			setup: func() {
+				mock.ExpectBegin().WillReturnError(errors.New("test error"))
+			},
+			handlerErr: nil,
+			wantCalled: false,
+			wantErr:    errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			called := false
+			next := func(ctx context.Context, _ *sarama.ConsumerMessage) error {
+				called = true
+				_, ok := dtx.TXFromContext(ctx)
+				assert.True(t, ok)
+				return tt.handlerErr
+			}
+			i := NewInbox(&Config{}, mockDB, dtx.NewManager(mockDB, &dtx.Config{}), mockClock, logger)
+			handler := kafka.NewHandler("topic", "group", next)
+			err := i.Middleware(handler, next)(context.Background(), msg)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantCalled, called)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInbox_BatchMiddleware(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "INSERT INTO public.inbox (group_id,topic,message_id,processed_at) VALUES ($1,$2,$3,$4) ON CONFLICT DO NOTHING"
+	now := time.Now().UTC()
+	first := &sarama.ConsumerMessage{Topic: "topic", Partition: 2, Offset: 7}
+	second := &sarama.ConsumerMessage{Topic: "topic", Partition: 2, Offset: 8}
+	tests := []struct {
+		name       string
+		setup      func()
+		handlerErr error
+		wantMsgs   []*sarama.ConsumerMessage
+		wantErr    error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now).Times(2)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/8", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			handlerErr: nil,
+			wantMsgs:   []*sarama.ConsumerMessage{first, second},
+			wantErr:    nil,
+		},
+		{
+			name: "duplicate",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now).Times(2)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/8", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			handlerErr: nil,
+			wantMsgs:   []*sarama.ConsumerMessage{second},
+			wantErr:    nil,
+		},
+		{
+			name: "all duplicates",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now).Times(2)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/8", now).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			handlerErr: nil,
+			wantMsgs:   nil,
+			wantErr:    nil,
+		},
+		{
+			name: "handler error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now).Times(2)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/8", now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			handlerErr: errs.NewUnexpectedBehaviorError("test error"),
+			wantMsgs:   []*sarama.ConsumerMessage{first, second},
+			wantErr:    errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs("group", "topic", "topic/2/7", now).
+					WillReturnError(errors.New("test error"))
+				mock.ExpectRollback()
+			},
+			handlerErr: nil,
+			wantMsgs:   nil,
+			wantErr:    errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			var got []*sarama.ConsumerMessage
+			next := func(ctx context.Context, msgs []*sarama.ConsumerMessage) error {
+				got = msgs
+				_, ok := dtx.TXFromContext(ctx)
+				assert.True(t, ok)
+				return tt.handlerErr
+			}
+			i := NewInbox(&Config{}, mockDB, dtx.NewManager(mockDB, &dtx.Config{}), mockClock, logger)
+			handler := kafka.NewBatchHandler("topic", "group", next)
+			err := i.BatchMiddleware(handler, next)(
+				context.Background(),
+				[]*sarama.ConsumerMessage{first, second},
+			)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantMsgs, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInbox_Cleanup(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	config := &Config{Retention: time.Hour}
+	query := "DELETE FROM public.inbox WHERE processed_at < $1"
+	now := time.Now().UTC()
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectExec(query).
+					WithArgs(now.Add(-time.Hour)).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectExec(query).
+					WithArgs(now.Add(-time.Hour)).
+					WillReturnError(errors.New("test error"))
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			i := NewInbox(config, mockDB, dtx.NewManager(mockDB, &dtx.Config{}), mockClock, logger)
+			err := i.Cleanup(context.Background())
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
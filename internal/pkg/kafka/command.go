@@ -0,0 +1,250 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CommandAuthorization - metadata key of the command with the credentials of
+// its caller, the same value as of the Authorization header.
+const CommandAuthorization = "authorization"
+
+// replyToPattern - commands reply only to the results topics.
+var replyToPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+(\.[a-zA-Z0-9_-]+)*\.results\.v1$`)
+
+// CommandExecutor - executes the decoded command, the result is sent in the
+// reply to the command.
+type CommandExecutor func(ctx context.Context, command *examplepb.Command) (proto.Message, error)
+
+// CommandHandler - executes commands and publishes their results for the
+// callers, the result is written to the outbox in the transaction of the
+// command.
+type CommandHandler struct {
+	resultsTopic  string
+	authenticator authenticator
+	dtxManager    dtxManager
+	outbox        outbox
+	clock         clock
+	logger        log.Logger
+}
+
+func NewCommandHandler(
+	resultsTopic string,
+	authenticator authenticator,
+	dtxManager dtxManager,
+	outbox outbox,
+	clock clock,
+	logger log.Logger,
+) *CommandHandler {
+	return &CommandHandler{
+		resultsTopic:  resultsTopic,
+		authenticator: authenticator,
+		dtxManager:    dtxManager,
+		outbox:        outbox,
+		clock:         clock,
+		logger:        logger,
+	}
+}
+
+// Handle - decodes the command, executes it on behalf of its caller and
+// replies with the result. Temporary errors are returned to retry the
+// message, the rest of the errors are replied as failed results.
+func (h *CommandHandler) Handle(
+	ctx context.Context,
+	msg *sarama.ConsumerMessage,
+	execute CommandExecutor,
+) error {
+	logger := h.logger.WithContext(ctx)
+	command, err := DecodeCommand(msg)
+	if err != nil {
+		return err
+	}
+	logger.Info(
+		"received command",
+		log.String("topic", msg.Topic),
+		log.Int32("partition", msg.Partition),
+		log.Int64("offset", msg.Offset),
+		log.String("key", string(msg.Key)),
+		log.String("command_id", command.GetCommandId()),
+		log.String("correlation_id", command.GetCorrelationId()),
+		log.String("type", string(command.GetPayload().MessageName())),
+	)
+	return h.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var result proto.Message
+		authenticated, err := AuthenticateCommand(ctx, h.authenticator, command)
+		if err == nil {
+			result, err = execute(authenticated, command)
+		}
+		if errs.IsTemporary(err) {
+			return err
+		}
+		reply, err := NewCommandResult(command, h.clock.Now().UTC(), result, err)
+		if err != nil {
+			return err
+		}
+		message, err := NewCommandResultMessage(command, reply, h.resultsTopic)
+		if err != nil {
+			return err
+		}
+		return h.outbox.Send(ctx, message)
+	})
+}
+
+// DecodeCommand - decodes the command envelope from a consumed message.
+func DecodeCommand(msg *sarama.ConsumerMessage) (*examplepb.Command, error) {
+	command := &examplepb.Command{}
+	if err := proto.Unmarshal(msg.Value, command); err != nil {
+		return nil, errs.NewInvalidFormError().
+			WithParam("value", "Invalid command envelope.").
+			WithCause(err)
+	}
+	if command.GetPayload() == nil {
+		return nil, errs.NewInvalidFormError().WithParam("payload", "Empty command payload.")
+	}
+	if err := validateReplyTo(command.GetReplyTo()); err != nil {
+		return nil, err
+	}
+	if command.GetCorrelationId() == "" {
+		command.CorrelationId = command.GetCommandId()
+	}
+	return command, nil
+}
+
+// validateReplyTo - a command could reply to a results topic only, otherwise
+// the caller could write to any topic of the service.
+func validateReplyTo(topic string) error {
+	if topic != "" && !replyToPattern.MatchString(topic) {
+		return errs.NewInvalidFormError().WithParam("reply_to", "Invalid reply topic.")
+	}
+	return nil
+}
+
+// CommandID - identity of the message by its command id, falls back to the
+// offset for messages which are not commands.
+func CommandID(msg *sarama.ConsumerMessage) string {
+	command, err := DecodeCommand(msg)
+	if err != nil || command.GetCommandId() == "" {
+		return OffsetID(msg)
+	}
+	return command.GetCommandId()
+}
+
+// AuthenticateCommand - the context with the subject of the credentials of the
+// command, a command without them fails with errs.NewUnauthenticatedError().
+// With the authentication disabled the command runs without a subject, so the
+// authorizer denies it.
+func AuthenticateCommand(
+	ctx context.Context,
+	authenticator authenticator,
+	command *examplepb.Command,
+) (context.Context, error) {
+	if !authenticator.Enabled() {
+		return ctx, nil
+	}
+	return authenticator.AuthenticateHeader(ctx, command.GetMetadata()[CommandAuthorization])
+}
+
+// ParseCommandUUID - id of the command field, invalid form error if it is
+// malformed.
+func ParseCommandUUID(key, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.UUID{}, errs.NewInvalidFormError().
+			WithParam(key, fmt.Sprintf("Invalid %s.", key)).
+			WithCause(err)
+	}
+	return id, nil
+}
+
+// DecodeCommandPayload - decodes the command payload into the given message.
+func DecodeCommandPayload(command *examplepb.Command, payload proto.Message) error {
+	if err := command.GetPayload().UnmarshalTo(payload); err != nil {
+		return errs.NewInvalidFormError().
+			WithParam("payload", "Invalid command payload.").
+			WithCause(err)
+	}
+	return nil
+}
+
+// NewCommandResult - builds the reply to the command from the execution result.
+func NewCommandResult(
+	command *examplepb.Command,
+	processedAt time.Time,
+	result proto.Message,
+	err error,
+) (*examplepb.CommandResult, error) {
+	reply := &examplepb.CommandResult{
+		CommandId:     command.GetCommandId(),
+		CorrelationId: command.GetCorrelationId(),
+		Status:        examplepb.CommandStatus_COMMAND_STATUS_SUCCEEDED,
+		ProcessedAt:   timestamppb.New(processedAt),
+		Result:        nil,
+		Error:         nil,
+	}
+	if err != nil {
+		reply.Status = examplepb.CommandStatus_COMMAND_STATUS_FAILED
+		reply.Error = encodeCommandError(err)
+		return reply, nil
+	}
+	if result != nil {
+		data, err := anypb.New(result)
+		if err != nil {
+			return nil, errs.NewUnexpectedBehaviorError("cant encode command result").WithCause(err)
+		}
+		reply.Result = data
+	}
+	return reply, nil
+}
+
+// NewCommandResultMessage - builds the reply message, replies go to the command
+// reply_to topic or to the default one. The reply_to topic must be a results
+// topic.
+func NewCommandResultMessage(
+	command *examplepb.Command,
+	reply *examplepb.CommandResult,
+	defaultTopic string,
+) (*Message, error) {
+	data, err := proto.Marshal(reply)
+	if err != nil {
+		return nil, errs.NewUnexpectedBehaviorError("cant encode command result").WithCause(err)
+	}
+	if err := validateReplyTo(command.GetReplyTo()); err != nil {
+		return nil, err
+	}
+	topic := command.GetReplyTo()
+	if topic == "" {
+		topic = defaultTopic
+	}
+	return &Message{Topic: topic, Value: data, Key: reply.GetCorrelationId()}, nil
+}
+
+func encodeCommandError(err error) *examplepb.CommandError {
+	var domainError *errs.Error
+	if !errors.As(err, &domainError) {
+		domainError = errs.NewUnexpectedBehaviorError(err.Error())
+	}
+	commandError := &examplepb.CommandError{
+		Code:    uint32(domainError.Code),
+		Message: domainError.Message,
+		Params:  make([]*examplepb.CommandErrorParam, 0, len(domainError.Params)),
+	}
+	for _, param := range domainError.Params {
+		commandError.Params = append(
+			commandError.Params,
+			&examplepb.CommandErrorParam{Key: param.Key, Value: param.Value},
+		)
+	}
+	return commandError
+}
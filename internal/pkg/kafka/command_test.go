@@ -0,0 +1,364 @@
+package kafka
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestDecodeCommand(t *testing.T) {
+	payload, err := anypb.New(&examplepb.PostDelete{Id: uuid.NewUUID().String()})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	command := &examplepb.Command{CommandId: "command", Payload: payload}
+	data, err := proto.Marshal(command)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	empty, err := proto.Marshal(&examplepb.Command{CommandId: "command"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	foreign, err := proto.Marshal(
+		&examplepb.Command{CommandId: "command", Payload: payload, ReplyTo: "example.posts.post.v1"},
+	)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	tests := []struct {
+		name    string
+		msg     *sarama.ConsumerMessage
+		want    *examplepb.Command
+		wantErr error
+	}{
+		{
+			name: "ok",
+			msg:  &sarama.ConsumerMessage{Value: data},
+			want: &examplepb.Command{
+				CommandId:     "command",
+				CorrelationId: "command",
+				Payload:       payload,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid envelope",
+			msg:     &sarama.ConsumerMessage{Value: []byte("invalid")},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("value", "Invalid command envelope."),
+		},
+		{
+			name:    "empty payload",
+			msg:     &sarama.ConsumerMessage{Value: empty},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("payload", "Empty command payload."),
+		},
+		{
+			name:    "reply to a foreign topic",
+			msg:     &sarama.ConsumerMessage{Value: foreign},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("reply_to", "Invalid reply topic."),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeCommand(tt.msg)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.True(t, proto.Equal(tt.want, got))
+		})
+	}
+}
+
+func TestNewCommandResultMessage(t *testing.T) {
+	now := time.Now().UTC()
+	command := &examplepb.Command{CommandId: "command", CorrelationId: "correlation"}
+	result := &examplepb.Post{Id: uuid.NewUUID().String()}
+	reply, err := NewCommandResult(command, now, result, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, examplepb.CommandStatus_COMMAND_STATUS_SUCCEEDED, reply.GetStatus())
+	post := &examplepb.Post{}
+	assert.NoError(t, reply.GetResult().UnmarshalTo(post))
+	assert.True(t, proto.Equal(result, post))
+	message, err := NewCommandResultMessage(command, reply, "results")
+	assert.NoError(t, err)
+	assert.Equal(t, "results", message.Topic)
+	assert.Equal(t, "correlation", message.Key)
+	command.ReplyTo = "example.posts.post.results.v1"
+	failed, err := NewCommandResult(
+		command,
+		now,
+		nil,
+		errs.NewEntityNotFoundError().WithParam("id", "1"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, examplepb.CommandStatus_COMMAND_STATUS_FAILED, failed.GetStatus())
+	assert.Equal(t, uint32(errs.ErrorCodeNotFound), failed.GetError().GetCode())
+	assert.Equal(t, "id", failed.GetError().GetParams()[0].GetKey())
+	unexpected, err := NewCommandResult(command, now, nil, errors.New("test error"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(errs.ErrorCodeInternal), unexpected.GetError().GetCode())
+	message, err = NewCommandResultMessage(command, failed, "results")
+	assert.NoError(t, err)
+	assert.Equal(t, "example.posts.post.results.v1", message.Topic)
+	command.ReplyTo = "example.posts.post.v1"
+	_, err = NewCommandResultMessage(command, failed, "results")
+	assert.ErrorIs(t, err, errs.NewInvalidFormError().WithParam("reply_to", "Invalid reply topic."))
+}
+
+func TestAuthenticateCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthenticator := NewMockauthenticator(ctrl)
+	ctx := context.Background()
+	authenticated := context.WithValue(ctx, struct{}{}, "subject")
+	command := &examplepb.Command{
+		CommandId: "command",
+		Metadata:  map[string]string{CommandAuthorization: "Bearer token"},
+	}
+	tests := []struct {
+		name    string
+		setup   func()
+		command *examplepb.Command
+		want    context.Context
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockAuthenticator.EXPECT().Enabled().Return(true)
+				mockAuthenticator.EXPECT().
+					AuthenticateHeader(ctx, "Bearer token").
+					Return(authenticated, nil)
+			},
+			command: command,
+			want:    authenticated,
+			wantErr: nil,
+		},
+		{
+			name: "without credentials",
+			setup: func() {
+				mockAuthenticator.EXPECT().Enabled().Return(true)
+				mockAuthenticator.EXPECT().
+					AuthenticateHeader(ctx, "").
+					Return(nil, errs.NewUnauthenticatedError())
+			},
+			command: &examplepb.Command{CommandId: "command"},
+			want:    nil,
+			wantErr: errs.NewUnauthenticatedError(),
+		},
+		{
+			name: "disabled",
+			setup: func() {
+				mockAuthenticator.EXPECT().Enabled().Return(false)
+			},
+			command: command,
+			want:    ctx,
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got, err := AuthenticateCommand(ctx, mockAuthenticator, tt.command)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandHandler_Handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthenticator := NewMockauthenticator(ctrl)
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockOutbox := NewMockoutbox(ctrl)
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := context.Background()
+	now := time.Now().UTC()
+	payload, err := anypb.New(&examplepb.PostDelete{Id: uuid.NewUUID().String()})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	command := &examplepb.Command{
+		CommandId:     "command",
+		CorrelationId: "correlation",
+		Payload:       payload,
+		ReplyTo:       "example.posts.post.results.v1",
+		Metadata:      map[string]string{CommandAuthorization: "Bearer token"},
+	}
+	data, err := proto.Marshal(command)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	result := &examplepb.Post{Id: uuid.NewUUID().String()}
+	runInTx := func() {
+		mockDtxManager.EXPECT().
+			RunInTx(ctx, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ *sql.TxOptions, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+	}
+	reply := func(t *testing.T, want *examplepb.CommandResult) {
+		mockClock.EXPECT().Now().Return(now)
+		mockOutbox.EXPECT().
+			Send(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, message *Message) error {
+				assert.Equal(t, "example.posts.post.results.v1", message.Topic)
+				assert.Equal(t, "correlation", message.Key)
+				got := &examplepb.CommandResult{}
+				assert.NoError(t, proto.Unmarshal(message.Value, got))
+				assert.True(t, proto.Equal(want, got), "got %v", got)
+				return nil
+			})
+	}
+	type args struct {
+		msg     *sarama.ConsumerMessage
+		execute CommandExecutor
+	}
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T)
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockAuthenticator.EXPECT().Enabled().Return(true)
+				mockAuthenticator.EXPECT().AuthenticateHeader(ctx, "Bearer token").Return(ctx, nil)
+				want, err := NewCommandResult(command, now, result, nil)
+				assert.NoError(t, err)
+				reply(t, want)
+			},
+			args: args{
+				msg: &sarama.ConsumerMessage{Value: data},
+				execute: func(context.Context, *examplepb.Command) (proto.Message, error) {
+					return result, nil
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "decode error",
+			setup: func(t *testing.T) {},
+			args: args{
+				msg:     &sarama.ConsumerMessage{Value: []byte("invalid")},
+				execute: nil,
+			},
+			wantErr: errs.NewInvalidFormError().WithParam("value", "Invalid command envelope."),
+		},
+		{
+			name: "unauthenticated",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockAuthenticator.EXPECT().Enabled().Return(true)
+				mockAuthenticator.EXPECT().
+					AuthenticateHeader(ctx, "Bearer token").
+					Return(nil, errs.NewUnauthenticatedError())
+				want, err := NewCommandResult(command, now, nil, errs.NewUnauthenticatedError())
+				assert.NoError(t, err)
+				reply(t, want)
+			},
+			args: args{
+				msg: &sarama.ConsumerMessage{Value: data},
+				execute: func(context.Context, *examplepb.Command) (proto.Message, error) {
+					t.Fatal("unauthenticated command is executed")
+					return nil, nil
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "failed",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockAuthenticator.EXPECT().Enabled().Return(false)
+				want, err := NewCommandResult(
+					command,
+					now,
+					nil,
+					errs.NewInvalidFormError().WithParam("id", "Invalid id."),
+				)
+				assert.NoError(t, err)
+				reply(t, want)
+			},
+			args: args{
+				msg: &sarama.ConsumerMessage{Value: data},
+				execute: func(context.Context, *examplepb.Command) (proto.Message, error) {
+					return nil, errs.NewInvalidFormError().WithParam("id", "Invalid id.")
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "temporary error",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockAuthenticator.EXPECT().Enabled().Return(false)
+			},
+			args: args{
+				msg: &sarama.ConsumerMessage{Value: data},
+				execute: func(context.Context, *examplepb.Command) (proto.Message, error) {
+					return nil, errs.NewUnexpectedBehaviorError("database is down")
+				},
+			},
+			wantErr: errs.NewUnexpectedBehaviorError("database is down"),
+		},
+		{
+			name: "outbox error",
+			setup: func(t *testing.T) {
+				runInTx()
+				mockAuthenticator.EXPECT().Enabled().Return(false)
+				mockClock.EXPECT().Now().Return(now)
+				mockOutbox.EXPECT().Send(ctx, gomock.Any()).Return(errs.NewUnexpectedBehaviorError("test error"))
+			},
+			args: args{
+				msg: &sarama.ConsumerMessage{Value: data},
+				execute: func(context.Context, *examplepb.Command) (proto.Message, error) {
+					return result, nil
+				},
+			},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(t)
+			h := NewCommandHandler(
+				"example.posts.post.results.v1",
+				mockAuthenticator,
+				mockDtxManager,
+				mockOutbox,
+				mockClock,
+				logger,
+			)
+			err := h.Handle(ctx, tt.args.msg, tt.args.execute)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
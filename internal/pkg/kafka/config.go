@@ -1,5 +1,23 @@
 package kafka
 
+import "time"
+
 type Config struct {
-	Brokers []string
+	Brokers      []string
+	Workers      uint          `env:"KAFKA_WORKERS"       toml:"workers"       env-default:"1"`
+	BatchSize    uint          `env:"KAFKA_BATCH_SIZE"    toml:"batch_size"    env-default:"100"`
+	BatchTimeout time.Duration `env:"KAFKA_BATCH_TIMEOUT" toml:"batch_timeout" env-default:"1s"`
+	Retry        RetryPolicy   `                          toml:"retry"`
+}
+
+// RetryPolicy - how a failed message is retried before it goes to the dead-letter topic.
+//
+// A message is first retried in place ImmediateRetries times, then it is moved
+// through RetryTopics retry topics, each one delaying it twice as long as the
+// previous one, starting from Backoff and capped by MaxBackoff.
+type RetryPolicy struct {
+	ImmediateRetries uint          `env:"KAFKA_RETRY_IMMEDIATE_RETRIES" toml:"immediate_retries" env-default:"2"`
+	RetryTopics      uint          `env:"KAFKA_RETRY_TOPICS"            toml:"retry_topics"      env-default:"3"`
+	Backoff          time.Duration `env:"KAFKA_RETRY_BACKOFF"           toml:"backoff"           env-default:"1s"`
+	MaxBackoff       time.Duration `env:"KAFKA_RETRY_MAX_BACKOFF"       toml:"max_backoff"       env-default:"1m"`
 }
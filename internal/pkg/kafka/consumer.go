@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
@@ -10,34 +11,115 @@ import (
 )
 
 type HandlerFunc func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+// BatchHandlerFunc - handles a batch of messages from one partition at once.
+//
+// Batches are wrapped by the batch middlewares, the middlewares wrap the
+// single messages. If a batch fails, its messages are handled one by one and
+// the failed ones are retried as single messages.
+type BatchHandlerFunc func(ctx context.Context, msgs []*sarama.ConsumerMessage) error
+
+// Middleware - wraps the handler func of the handler.
+type Middleware func(handler Handler, next HandlerFunc) HandlerFunc
+
+// BatchMiddleware - wraps the batch handler func of the handler.
+type BatchMiddleware func(handler Handler, next BatchHandlerFunc) BatchHandlerFunc
+
+// MessageIDFunc - extracts the identity of a message, redeliveries of the same
+// message must have the same identity.
+type MessageIDFunc func(msg *sarama.ConsumerMessage) string
 type Handler struct {
-	Topic        string
-	GroupID      string
-	HandlerFunc  HandlerFunc
-	groupHandler sarama.ConsumerGroupHandler
-	group        sarama.ConsumerGroup
+	Topic            string
+	GroupID          string
+	HandlerFunc      HandlerFunc
+	BatchHandlerFunc BatchHandlerFunc
+	RetryPolicy      *RetryPolicy
+	DeadLetterTopic  string
+	MessageID        MessageIDFunc
+	Workers          uint
+	BatchSize        uint
+	BatchTimeout     time.Duration
 }
 
 func NewHandler(topic string, groupID string, handlerFunc HandlerFunc) Handler {
 	return Handler{
-		Topic:        topic,
-		GroupID:      groupID,
-		HandlerFunc:  handlerFunc,
-		groupHandler: nil,
-		group:        nil,
+		Topic:            topic,
+		GroupID:          groupID,
+		HandlerFunc:      handlerFunc,
+		BatchHandlerFunc: nil,
+		RetryPolicy:      nil,
+		DeadLetterTopic:  DeadLetterTopic(topic),
+		MessageID:        OffsetID,
+		Workers:          0,
+		BatchSize:        0,
+		BatchTimeout:     0,
 	}
 }
 
+func NewBatchHandler(topic string, groupID string, batchHandlerFunc BatchHandlerFunc) Handler {
+	handler := NewHandler(topic, groupID, nil)
+	handler.BatchHandlerFunc = batchHandlerFunc
+	return handler
+}
+
+// WithRetryPolicy - overrides the consumer retry policy for the handler.
+func (h Handler) WithRetryPolicy(policy RetryPolicy) Handler {
+	h.RetryPolicy = &policy
+	return h
+}
+
+// WithMessageID - overrides the default offset based identity of the handler messages.
+func (h Handler) WithMessageID(messageID MessageIDFunc) Handler {
+	h.MessageID = messageID
+	return h
+}
+
+// WithDeadLetterTopic - overrides the default dead-letter topic of the handler.
+func (h Handler) WithDeadLetterTopic(topic string) Handler {
+	h.DeadLetterTopic = topic
+	return h
+}
+
+// WithWorkers - overrides the consumer number of workers per partition.
+func (h Handler) WithWorkers(workers uint) Handler {
+	h.Workers = workers
+	return h
+}
+
+// WithBatch - overrides the consumer batch size and timeout of a batch handler.
+func (h Handler) WithBatch(size uint, timeout time.Duration) Handler {
+	h.BatchSize = size
+	h.BatchTimeout = timeout
+	return h
+}
+
+type consumerGroup struct {
+	id           string
+	topics       []string
+	group        sarama.ConsumerGroup
+	groupHandler *GroupHandler
+}
+
 type Consumer struct {
-	config     *Config
-	client     sarama.Client
-	handlers   map[string]Handler
-	logger     log.Logger
-	cancel     context.CancelFunc
-	errorGroup *errgroup.Group
+	config           *Config
+	client           sarama.Client
+	producer         producer
+	clock            clock
+	handlers         []Handler
+	groups           []consumerGroup
+	middlewares      []Middleware
+	batchMiddlewares []BatchMiddleware
+	logger           log.Logger
+	cancel           context.CancelFunc
+	errorGroup       *errgroup.Group
 }
 
-func NewConsumer(cfg *Config, logger log.Logger) (*Consumer, error) {
+func NewConsumer(
+	cfg *Config,
+	producer producer,
+	clock clock,
+	logger log.Logger,
+) (*Consumer, error) {
 	config := sarama.NewConfig()
 	config.Version = sarama.V2_1_0_0
 	client, err := sarama.NewClient(cfg.Brokers, config)
@@ -46,38 +128,76 @@ func NewConsumer(cfg *Config, logger log.Logger) (*Consumer, error) {
 	}
 	return &Consumer{
 		config:   cfg,
-		handlers: make(map[string]Handler),
+		handlers: nil,
 		client:   client,
+		producer: producer,
+		clock:    clock,
 		logger:   logger,
 	}, nil
 }
+
+// AddHandler - adds a handler, handlers with the same group id share one
+// consumer group, each of them consumes its own topic.
 func (c *Consumer) AddHandler(handler Handler) {
-	c.handlers[handler.GroupID] = handler
+	c.handlers = append(c.handlers, handler)
+}
+
+// AddMiddleware - wraps all the handlers, the first added middleware is the outermost.
+func (c *Consumer) AddMiddleware(middleware Middleware) {
+	c.middlewares = append(c.middlewares, middleware)
+}
+
+// AddBatchMiddleware - wraps all the batch handlers, the first added batch
+// middleware is the outermost. A middleware which has to see every message,
+// like the deduplication, must be added as a batch middleware too.
+func (c *Consumer) AddBatchMiddleware(middleware BatchMiddleware) {
+	c.batchMiddlewares = append(c.batchMiddlewares, middleware)
 }
 func (c *Consumer) Start(ctx context.Context) error {
 	logger := c.logger
-	for id, handler := range c.handlers {
-		consumerGroup, err := sarama.NewConsumerGroupFromClient(handler.GroupID, c.client)
+	groupIDs := make([]string, 0)
+	handlers := make(map[string][]Handler)
+	for _, handler := range c.handlers {
+		if _, ok := handlers[handler.GroupID]; !ok {
+			groupIDs = append(groupIDs, handler.GroupID)
+		}
+		handlers[handler.GroupID] = append(handlers[handler.GroupID], c.prepare(handler))
+	}
+	for _, groupID := range groupIDs {
+		groupHandler, err := NewGroupHandler(
+			groupID,
+			handlers[groupID],
+			c.producer,
+			c.clock,
+			logger,
+		)
+		if err != nil {
+			return err
+		}
+		group, err := sarama.NewConsumerGroupFromClient(groupID, c.client)
 		if err != nil {
 			return errs.NewUnexpectedBehaviorError("cant build kafka consumer").WithCause(err)
 		}
-		handler.group = consumerGroup
-		handler.groupHandler = NewGroupHandler(handler.HandlerFunc, logger)
-		c.handlers[id] = handler
+		c.groups = append(c.groups, consumerGroup{
+			id:           groupID,
+			topics:       groupHandler.Topics(),
+			group:        group,
+			groupHandler: groupHandler,
+		})
 	}
 	consumeCtx, cancel := context.WithCancel(context.Background())
 	c.cancel = cancel
 	errorGroup, consumeCtx := errgroup.WithContext(consumeCtx)
 	c.errorGroup = errorGroup
-	for _, handler := range c.handlers {
+	for _, group := range c.groups {
 		errorGroup.Go(func() error {
 			for {
-				if err := handler.group.Consume(consumeCtx, []string{handler.Topic}, handler.groupHandler); err != nil {
+				if err := group.group.Consume(consumeCtx, group.topics, group.groupHandler); err != nil {
 					logger.Error(
 						"consume error",
 						log.Error(err),
-						log.String("group", handler.GroupID),
-						log.String("topic", handler.Topic),
+						log.String("group", group.id),
+						log.Strings("topics", group.topics),
 					)
 				}
 				if err := consumeCtx.Err(); err != nil {
@@ -94,48 +214,44 @@ func (c *Consumer) Stop(ctx context.Context) error {
 	if err != nil {
 		c.logger.Error("error group wait", log.Error(err))
 	}
-	for _, handler := range c.handlers {
-		if err := handler.group.Close(); err != nil {
+	for _, group := range c.groups {
+		if err := group.group.Close(); err != nil {
 			return err
 		}
 	}
 	return c.client.Close()
 }
 
-type GroupHandler struct {
-	handlerFunc HandlerFunc
-	logger      log.Logger
-}
-
-func NewGroupHandler(handlerFunc HandlerFunc, logger log.Logger) *GroupHandler {
-	return &GroupHandler{handlerFunc: handlerFunc, logger: logger}
-}
-func (h *GroupHandler) Setup(_ sarama.ConsumerGroupSession) error {
-	return nil
-}
-func (h *GroupHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (h *GroupHandler) ConsumeClaim(
-	session sarama.ConsumerGroupSession,
-	claim sarama.ConsumerGroupClaim,
-) error {
-	for msg := range claim.Messages() {
-		ctx := context.Background()
-		logger := h.logger.WithContext(ctx)
-		logger.Info(
-			"received message",
-			log.String("topic", msg.Topic),
-			log.Int32("partition", msg.Partition),
-			log.Int64("offset", msg.Offset),
-			log.String("key", string(msg.Key)),
-		)
-		if err := h.handlerFunc(context.Background(), msg); err != nil {
-			logger.Error("handled message error", log.Error(err))
-			continue
+// prepare - fills the handler settings from the consumer config and applies
+// the middlewares and the batch middlewares.
+func (c *Consumer) prepare(handler Handler) Handler {
+	policy := c.config.Retry
+	if handler.RetryPolicy != nil {
+		policy = *handler.RetryPolicy
+	}
+	handler.RetryPolicy = &policy
+	if handler.Workers == 0 {
+		handler.Workers = max(c.config.Workers, 1)
+	}
+	if handler.BatchSize == 0 {
+		handler.BatchSize = max(c.config.BatchSize, 1)
+	}
+	if handler.BatchTimeout == 0 {
+		handler.BatchTimeout = c.config.BatchTimeout
+	}
+	if handler.HandlerFunc == nil && handler.BatchHandlerFunc != nil {
+		batchHandlerFunc := handler.BatchHandlerFunc
+		handler.HandlerFunc = func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+			return batchHandlerFunc(ctx, []*sarama.ConsumerMessage{msg})
 		}
-		session.MarkMessage(msg, "")
 	}
-	return nil
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler.HandlerFunc = c.middlewares[i](handler, handler.HandlerFunc)
+	}
+	if handler.BatchHandlerFunc != nil {
+		for i := len(c.batchMiddlewares) - 1; i >= 0; i-- {
+			handler.BatchHandlerFunc = c.batchMiddlewares[i](handler, handler.BatchHandlerFunc)
+		}
+	}
+	return handler
 }
@@ -0,0 +1,99 @@
+package kafka
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EventSchemaVersion - version of the examplepb.Event envelope.
+const EventSchemaVersion uint32 = 1
+
+var eventTypes = map[events.Type]examplepb.EventType{
+	events.TypeCreated:  examplepb.EventType_EVENT_TYPE_CREATED,
+	events.TypeUpdated:  examplepb.EventType_EVENT_TYPE_UPDATED,
+	events.TypeDeleted:  examplepb.EventType_EVENT_TYPE_DELETED,
+	events.TypeRestored: examplepb.EventType_EVENT_TYPE_RESTORED,
+	events.TypePurged:   examplepb.EventType_EVENT_TYPE_PURGED,
+}
+
+// NewEvent - wraps payload into the event envelope.
+func NewEvent(
+	id uuid.UUID,
+	eventType events.Type,
+	occurredAt time.Time,
+	aggregateID uuid.UUID,
+	payload proto.Message,
+) (*examplepb.Event, error) {
+	data, err := anypb.New(payload)
+	if err != nil {
+		return nil, errs.NewUnexpectedBehaviorError("cant encode event payload").WithCause(err)
+	}
+	event := &examplepb.Event{
+		EventId:       id.String(),
+		EventType:     eventTypes[eventType],
+		OccurredAt:    timestamppb.New(occurredAt),
+		AggregateId:   aggregateID.String(),
+		SchemaVersion: EventSchemaVersion,
+		Producer:      example.Name + "/" + example.Version,
+		Metadata:      map[string]string{},
+		Payload:       data,
+	}
+	return event, nil
+}
+
+// EventType - domain type of the envelope.
+func EventType(event *examplepb.Event) events.Type {
+	for eventType, value := range eventTypes {
+		if value == event.GetEventType() {
+			return eventType
+		}
+	}
+	return ""
+}
+
+// DecodeEvent - decodes the event envelope from a consumed message.
+func DecodeEvent(msg *sarama.ConsumerMessage) (*examplepb.Event, error) {
+	event := &examplepb.Event{}
+	if err := proto.Unmarshal(msg.Value, event); err != nil {
+		return nil, errs.NewInvalidFormError().
+			WithParam("value", "Invalid event envelope.").
+			WithCause(err)
+	}
+	if event.GetSchemaVersion() > EventSchemaVersion {
+		return nil, errs.NewInvalidFormError().
+			WithParam("schema_version", "Unsupported event schema version.")
+	}
+	return event, nil
+}
+
+// DecodeEventPayload - decodes the envelope payload into the given message.
+func DecodeEventPayload(event *examplepb.Event, payload proto.Message) error {
+	if event.GetPayload() == nil {
+		return errs.NewInvalidFormError().WithParam("payload", "Empty event payload.")
+	}
+	if err := event.GetPayload().UnmarshalTo(payload); err != nil {
+		return errs.NewInvalidFormError().
+			WithParam("payload", "Invalid event payload.").
+			WithCause(err)
+	}
+	return nil
+}
+
+// EventID - identity of the message by its event id, falls back to the offset
+// for messages which are not events.
+func EventID(msg *sarama.ConsumerMessage) string {
+	event, err := DecodeEvent(msg)
+	if err != nil || event.GetEventId() == "" {
+		return OffsetID(msg)
+	}
+	return event.GetEventId()
+}
@@ -0,0 +1,142 @@
+package kafka
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/events"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	examplepb "github.com/mikalai-mitsin/example/pkg/examplepb/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	id := uuid.NewUUID()
+	aggregateID := uuid.NewUUID()
+	now := time.Now().UTC()
+	payload := &examplepb.Post{Id: aggregateID.String(), Body: "body"}
+	event, err := NewEvent(id, events.TypeUpdated, now, aggregateID, payload)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	data, err := proto.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	future, err := proto.Marshal(&examplepb.Event{SchemaVersion: EventSchemaVersion + 1})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	tests := []struct {
+		name    string
+		msg     *sarama.ConsumerMessage
+		want    *examplepb.Event
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			msg:     &sarama.ConsumerMessage{Value: data},
+			want:    event,
+			wantErr: nil,
+		},
+		{
+			name:    "invalid envelope",
+			msg:     &sarama.ConsumerMessage{Value: []byte("invalid")},
+			want:    nil,
+			wantErr: errs.NewInvalidFormError().WithParam("value", "Invalid event envelope."),
+		},
+		{
+			name: "unsupported schema version",
+			msg:  &sarama.ConsumerMessage{Value: future},
+			want: nil,
+			wantErr: errs.NewInvalidFormError().
+				WithParam("schema_version", "Unsupported event schema version."),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeEvent(tt.msg)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.True(t, proto.Equal(tt.want, got))
+		})
+	}
+}
+
+func TestDecodeEventPayload(t *testing.T) {
+	aggregateID := uuid.NewUUID()
+	payload := &examplepb.Post{Id: aggregateID.String(), Body: "body"}
+	event, err := NewEvent(uuid.NewUUID(), events.TypeCreated, time.Now(), aggregateID, payload)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	assert.Equal(t, events.TypeCreated, EventType(event))
+	assert.Equal(t, aggregateID.String(), event.GetAggregateId())
+	post := &examplepb.Post{}
+	assert.NoError(t, DecodeEventPayload(event, post))
+	assert.True(t, proto.Equal(payload, post))
+	assert.Error(t, DecodeEventPayload(event, &examplepb.Tag{}))
+	assert.ErrorIs(
+		t,
+		DecodeEventPayload(&examplepb.Event{}, post),
+		errs.NewInvalidFormError().WithParam("payload", "Empty event payload."),
+	)
+}
+
+// TestEventTypes - every type declared in the events package is mapped to an
+// envelope type, otherwise it would be published as EVENT_TYPE_UNSPECIFIED.
+func TestEventTypes(t *testing.T) {
+	packages, err := parser.ParseDir(token.NewFileSet(), "../events", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	var declared []events.Type
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					value := spec.(*ast.ValueSpec)
+					if ident, ok := value.Type.(*ast.Ident); !ok || ident.Name != "Type" {
+						continue
+					}
+					for _, expr := range value.Values {
+						name, err := strconv.Unquote(expr.(*ast.BasicLit).Value)
+						if err != nil {
+							t.Fatal(err)
+							return
+						}
+						declared = append(declared, events.Type(name))
+					}
+				}
+			}
+		}
+	}
+	assert.NotEmpty(t, declared)
+	seen := make(map[examplepb.EventType]events.Type, len(declared))
+	for _, eventType := range declared {
+		value, ok := eventTypes[eventType]
+		if !assert.Truef(t, ok, "event type %q is not mapped", eventType) {
+			continue
+		}
+		assert.NotEqual(t, examplepb.EventType_EVENT_TYPE_UNSPECIFIED, value)
+		assert.NotContainsf(t, seen, value, "event type %q is mapped twice", value)
+		seen[value] = eventType
+		event := &examplepb.Event{EventType: value}
+		assert.Equal(t, eventType, EventType(event))
+	}
+}
@@ -0,0 +1,417 @@
+package kafka
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+)
+
+// topicHandler - settings of the handler a claimed topic is dispatched to.
+type topicHandler struct {
+	topic            string
+	deadLetterTopic  string
+	policy           RetryPolicy
+	handlerFunc      HandlerFunc
+	batchHandlerFunc BatchHandlerFunc
+	workers          uint
+	batchSize        uint
+	batchTimeout     time.Duration
+}
+
+func newTopicHandler(handler Handler) *topicHandler {
+	policy := RetryPolicy{}
+	if handler.RetryPolicy != nil {
+		policy = *handler.RetryPolicy
+	}
+	return &topicHandler{
+		topic:            handler.Topic,
+		deadLetterTopic:  handler.DeadLetterTopic,
+		policy:           policy,
+		handlerFunc:      handler.HandlerFunc,
+		batchHandlerFunc: handler.BatchHandlerFunc,
+		workers:          max(handler.Workers, 1),
+		batchSize:        max(handler.BatchSize, 1),
+		batchTimeout:     handler.BatchTimeout,
+	}
+}
+
+// GroupHandler - dispatches the claimed messages of a consumer group to the
+// handlers of their topics.
+//
+// A failed message is retried in place, then moved through the retry topics
+// and finally to the dead-letter topic. Messages of a partition are handled by
+// a pool of workers, messages with the same key go to the same worker to keep
+// their order. An offset is marked only once all the messages before it are
+// handled or routed.
+type GroupHandler struct {
+	groupID  string
+	topics   []string
+	handlers map[string]*topicHandler
+	producer producer
+	clock    clock
+	logger   log.Logger
+}
+
+func NewGroupHandler(
+	groupID string,
+	handlers []Handler,
+	producer producer,
+	clock clock,
+	logger log.Logger,
+) (*GroupHandler, error) {
+	groupHandler := &GroupHandler{
+		groupID:  groupID,
+		topics:   nil,
+		handlers: make(map[string]*topicHandler),
+		producer: producer,
+		clock:    clock,
+		logger:   logger,
+	}
+	for _, handler := range handlers {
+		topicHandler := newTopicHandler(handler)
+		for _, topic := range topicHandler.policy.Topics(handler.Topic) {
+			if _, ok := groupHandler.handlers[topic]; ok {
+				return nil, errs.NewUnexpectedBehaviorError("duplicate kafka handler").
+					WithParam("group", groupID).
+					WithParam("topic", topic)
+			}
+			groupHandler.handlers[topic] = topicHandler
+			groupHandler.topics = append(groupHandler.topics, topic)
+		}
+	}
+	return groupHandler, nil
+}
+
+// Topics - topics of the handlers with their retry topics.
+func (h *GroupHandler) Topics() []string {
+	return h.topics
+}
+func (h *GroupHandler) Setup(_ sarama.ConsumerGroupSession) error {
+	return nil
+}
+func (h *GroupHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *GroupHandler) ConsumeClaim(
+	session sarama.ConsumerGroupSession,
+	claim sarama.ConsumerGroupClaim,
+) error {
+	handler, ok := h.handlers[claim.Topic()]
+	if !ok {
+		return errs.NewUnexpectedBehaviorError("unknown kafka topic").
+			WithParam("topic", claim.Topic())
+	}
+	switch {
+	case handler.batchHandlerFunc != nil:
+		return h.consumeBatches(session, claim, handler)
+	case handler.workers > 1:
+		return h.consumeConcurrently(session, claim, handler)
+	default:
+		return h.consume(session, claim, handler)
+	}
+}
+
+func (h *GroupHandler) consume(
+	session sarama.ConsumerGroupSession,
+	claim sarama.ConsumerGroupClaim,
+	handler *topicHandler,
+) error {
+	for msg := range claim.Messages() {
+		if !h.wait(session.Context(), msg) {
+			return nil
+		}
+		if err := h.process(session.Context(), handler, msg); err != nil {
+			return err
+		}
+		session.MarkMessage(msg, "")
+	}
+	return nil
+}
+
+// consumeConcurrently - spreads the messages over the workers by key, messages
+// without a key are spread by offset.
+func (h *GroupHandler) consumeConcurrently(
+	session sarama.ConsumerGroupSession,
+	claim sarama.ConsumerGroupClaim,
+	handler *topicHandler,
+) error {
+	tracker := newOffsetTracker(session)
+	group, ctx := errgroup.WithContext(session.Context())
+	queues := make([]chan *sarama.ConsumerMessage, handler.workers)
+	for i := range queues {
+		queue := make(chan *sarama.ConsumerMessage)
+		queues[i] = queue
+		group.Go(func() error {
+			for msg := range queue {
+				if !h.wait(ctx, msg) {
+					return nil
+				}
+				if err := h.process(ctx, handler, msg); err != nil {
+					return err
+				}
+				tracker.Done(msg)
+			}
+			return nil
+		})
+	}
+	group.Go(func() error {
+		defer func() {
+			for _, queue := range queues {
+				close(queue)
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case msg, ok := <-claim.Messages():
+				if !ok {
+					return nil
+				}
+				tracker.Add(msg)
+				select {
+				case <-ctx.Done():
+					return nil
+				case queues[worker(msg, handler.workers)] <- msg:
+				}
+			}
+		}
+	})
+	return group.Wait()
+}
+
+// consumeBatches - collects messages until the batch is full or the batch
+// timeout is over.
+func (h *GroupHandler) consumeBatches(
+	session sarama.ConsumerGroupSession,
+	claim sarama.ConsumerGroupClaim,
+	handler *topicHandler,
+) error {
+	batch := make([]*sarama.ConsumerMessage, 0, handler.batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := h.processBatch(session.Context(), handler, batch); err != nil {
+			return err
+		}
+		session.MarkMessage(batch[len(batch)-1], "")
+		batch = make([]*sarama.ConsumerMessage, 0, handler.batchSize)
+		return nil
+	}
+	ticker := time.NewTicker(max(handler.batchTimeout, time.Millisecond))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return flush()
+			}
+			if !h.wait(session.Context(), msg) {
+				return nil
+			}
+			batch = append(batch, msg)
+			if uint(len(batch)) >= handler.batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// processBatch - handles the batch within a consumer span linked to the traces
+// of the messages, a failed batch is handled message by message. The context
+// of the session is canceled when its claims are revoked.
+func (h *GroupHandler) processBatch(
+	ctx context.Context,
+	handler *topicHandler,
+	msgs []*sarama.ConsumerMessage,
+) error {
+	batchCtx, span := startBatchSpan(ctx, h.groupID, handler.topic, msgs)
+	defer span.End()
+	logger := h.logger.WithContext(batchCtx)
+	var err error
+	for retry := uint(0); retry <= handler.policy.ImmediateRetries; retry++ {
+		err = handler.batchHandlerFunc(batchCtx, msgs)
+		if err == nil {
+			return nil
+		}
+		span.RecordError(err)
+		if !errs.IsTemporary(err) {
+			break
+		}
+	}
+	logger.Warn(
+		"handled batch error, handling messages one by one",
+		log.Error(err),
+		log.String("topic", handler.topic),
+		log.Int("size", len(msgs)),
+	)
+	for _, msg := range msgs {
+		if err := h.process(ctx, handler, msg); err != nil {
+			recordSpanError(span, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// process - handles the message within the consumer span which continues the
+// trace of the producer. The context of the session is canceled when its
+// claims are revoked.
+func (h *GroupHandler) process(
+	ctx context.Context,
+	handler *topicHandler,
+	msg *sarama.ConsumerMessage,
+) error {
+	ctx, span := startConsumerSpan(ctx, h.groupID, msg)
+	defer span.End()
+	logger := h.logger.WithContext(ctx)
+	logger.Info(
+		"received message",
+		log.String("topic", msg.Topic),
+		log.Int32("partition", msg.Partition),
+		log.Int64("offset", msg.Offset),
+		log.String("key", string(msg.Key)),
+	)
+	if err := h.handle(ctx, handler, msg); err != nil {
+		recordSpanError(span, err)
+		logger.Error(
+			"cant route failed message",
+			log.Error(err),
+			log.String("topic", msg.Topic),
+			log.Int64("offset", msg.Offset),
+		)
+		return err
+	}
+	return nil
+}
+
+// wait - holds a message from a retry topic until its delay is over, returns
+// false if the session is closed in the meantime.
+func (h *GroupHandler) wait(ctx context.Context, msg *sarama.ConsumerMessage) bool {
+	delay := retryNotBefore(msg).Sub(h.clock.Now())
+	if delay <= 0 {
+		return true
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// handle - runs the handler and routes the message to the next retry topic or
+// to the dead-letter topic when all the attempts are failed, permanent errors
+// go to the dead-letter topic right away.
+func (h *GroupHandler) handle(
+	ctx context.Context,
+	handler *topicHandler,
+	msg *sarama.ConsumerMessage,
+) error {
+	logger := h.logger.WithContext(ctx)
+	attempts := headerUint(msg, HeaderAttempts)
+	var err error
+	for retry := uint(0); retry <= handler.policy.ImmediateRetries; retry++ {
+		attempts++
+		err = handler.handlerFunc(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		trace.SpanFromContext(ctx).RecordError(err)
+		logger.Warn(
+			"handled message error",
+			log.Error(err),
+			log.String("topic", msg.Topic),
+			log.Int64("offset", msg.Offset),
+			log.Uint64("attempt", uint64(attempts)),
+		)
+		if !errs.IsTemporary(err) {
+			break
+		}
+	}
+	stage := headerUint(msg, HeaderRetryStage) + 1
+	if errs.IsTemporary(err) && stage <= handler.policy.RetryTopics {
+		notBefore := h.clock.Now().UTC().Add(handler.policy.Delay(stage))
+		return h.producer.Send(
+			ctx,
+			failedMessage(msg, RetryTopic(handler.topic, stage), attempts, stage, notBefore, err),
+		)
+	}
+	recordSpanError(trace.SpanFromContext(ctx), err)
+	logger.Error(
+		"message is moved to the dead-letter topic",
+		log.Error(err),
+		log.String("topic", msg.Topic),
+		log.String("dead_letter_topic", handler.deadLetterTopic),
+		log.Uint64("attempts", uint64(attempts)),
+	)
+	return h.producer.Send(
+		ctx,
+		failedMessage(msg, handler.deadLetterTopic, attempts, stage-1, time.Time{}, err),
+	)
+}
+
+func worker(msg *sarama.ConsumerMessage, workers uint) uint {
+	if len(msg.Key) == 0 {
+		return uint(msg.Offset) % workers
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write(msg.Key)
+	return uint(hash.Sum32()) % workers
+}
+
+// offsetTracker - marks the offset of a partition only once all the messages
+// before it are done.
+type offsetTracker struct {
+	mu      sync.Mutex
+	session sarama.ConsumerGroupSession
+	pending []*sarama.ConsumerMessage
+	done    map[int64]struct{}
+}
+
+func newOffsetTracker(session sarama.ConsumerGroupSession) *offsetTracker {
+	return &offsetTracker{session: session, done: make(map[int64]struct{})}
+}
+
+func (t *offsetTracker) Add(msg *sarama.ConsumerMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = append(t.pending, msg)
+}
+
+func (t *offsetTracker) Done(msg *sarama.ConsumerMessage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.done[msg.Offset] = struct{}{}
+	var last *sarama.ConsumerMessage
+	for len(t.pending) > 0 {
+		if _, ok := t.done[t.pending[0].Offset]; !ok {
+			break
+		}
+		delete(t.done, t.pending[0].Offset)
+		last = t.pending[0]
+		t.pending = t.pending[1:]
+	}
+	if last != nil {
+		t.session.MarkMessage(last, "")
+	}
+}
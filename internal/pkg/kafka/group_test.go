@@ -0,0 +1,260 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type testSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	mu     sync.Mutex
+	marked []int64
+}
+
+func (s *testSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type testClaim struct {
+	sarama.ConsumerGroupClaim
+	topic    string
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *testClaim) Topic() string {
+	return c.topic
+}
+
+func (c *testClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func newTestClaim(topic string, msgs ...*sarama.ConsumerMessage) *testClaim {
+	claim := &testClaim{topic: topic, messages: make(chan *sarama.ConsumerMessage, len(msgs))}
+	for _, msg := range msgs {
+		claim.messages <- msg
+	}
+	close(claim.messages)
+	return claim
+}
+
+func TestNewGroupHandler(t *testing.T) {
+	handle := func(_ context.Context, _ *sarama.ConsumerMessage) error { return nil }
+	policy := RetryPolicy{RetryTopics: 1}
+	h, err := NewGroupHandler(
+		"group",
+		[]Handler{
+			NewHandler("first", "group", handle).WithRetryPolicy(policy),
+			NewHandler("second", "group", handle).WithRetryPolicy(policy),
+		},
+		nil,
+		nil,
+		nil,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "first.retry.1", "second", "second.retry.1"}, h.Topics())
+	_, err = NewGroupHandler(
+		"group",
+		[]Handler{
+			NewHandler("first", "group", handle).WithRetryPolicy(policy),
+			NewHandler("first", "group", handle).WithRetryPolicy(policy),
+		},
+		nil,
+		nil,
+		nil,
+	)
+	assert.ErrorIs(
+		t,
+		err,
+		errs.NewUnexpectedBehaviorError("duplicate kafka handler").
+			WithParam("group", "group").
+			WithParam("topic", "first"),
+	)
+}
+
+func TestGroupHandler_ConsumeClaim(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	mockClock.EXPECT().Now().Return(time.Now()).AnyTimes()
+	mockProducer := NewMockproducer(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	msgs := []*sarama.ConsumerMessage{
+		{Topic: "topic", Offset: 1, Key: []byte("a")},
+		{Topic: "topic", Offset: 2, Key: []byte("b")},
+		{Topic: "topic", Offset: 3, Key: []byte("a")},
+		{Topic: "topic", Offset: 4, Key: []byte("c")},
+		{Topic: "topic", Offset: 5, Key: []byte("b")},
+		{Topic: "topic", Offset: 6, Key: []byte("a")},
+	}
+	t.Run("workers keep the order of a key", func(t *testing.T) {
+		var mu sync.Mutex
+		handled := make(map[string][]int64)
+		handler := NewHandler("topic", "group", func(_ context.Context, msg *sarama.ConsumerMessage) error {
+			mu.Lock()
+			defer mu.Unlock()
+			handled[string(msg.Key)] = append(handled[string(msg.Key)], msg.Offset)
+			return nil
+		}).WithWorkers(3)
+		h, err := NewGroupHandler("group", []Handler{handler}, mockProducer, mockClock, logger)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		session := &testSession{ctx: context.Background()}
+		assert.NoError(t, h.ConsumeClaim(session, newTestClaim("topic", msgs...)))
+		assert.Equal(
+			t,
+			map[string][]int64{"a": {1, 3, 6}, "b": {2, 5}, "c": {4}},
+			handled,
+		)
+		assert.Equal(t, int64(6), session.marked[len(session.marked)-1])
+	})
+	t.Run("batches", func(t *testing.T) {
+		var batches [][]int64
+		handler := NewBatchHandler("topic", "group", func(_ context.Context, msgs []*sarama.ConsumerMessage) error {
+			batch := make([]int64, 0, len(msgs))
+			for _, msg := range msgs {
+				batch = append(batch, msg.Offset)
+			}
+			batches = append(batches, batch)
+			return nil
+		}).WithBatch(4, time.Minute)
+		h, err := NewGroupHandler("group", []Handler{handler}, mockProducer, mockClock, logger)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		session := &testSession{ctx: context.Background()}
+		assert.NoError(t, h.ConsumeClaim(session, newTestClaim("topic", msgs...)))
+		assert.Equal(t, [][]int64{{1, 2, 3, 4}, {5, 6}}, batches)
+		assert.Equal(t, []int64{4, 6}, session.marked)
+	})
+	t.Run("failed batch is handled one by one", func(t *testing.T) {
+		var handled []int
+		handler := NewBatchHandler("topic", "group", func(_ context.Context, msgs []*sarama.ConsumerMessage) error {
+			handled = append(handled, len(msgs))
+			if len(msgs) > 1 {
+				return errs.NewInvalidFormError()
+			}
+			return nil
+		}).WithBatch(3, time.Minute)
+		consumer := &Consumer{config: &Config{}}
+		h, err := NewGroupHandler(
+			"group",
+			[]Handler{consumer.prepare(handler)},
+			mockProducer,
+			mockClock,
+			logger,
+		)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		session := &testSession{ctx: context.Background()}
+		assert.NoError(t, h.ConsumeClaim(session, newTestClaim("topic", msgs[:3]...)))
+		assert.Equal(t, []int{3, 1, 1, 1}, handled)
+		assert.Equal(t, []int64{3}, session.marked)
+	})
+	t.Run("batch middlewares wrap batches", func(t *testing.T) {
+		var batches [][]int64
+		handler := NewBatchHandler("topic", "group", func(_ context.Context, msgs []*sarama.ConsumerMessage) error {
+			batch := make([]int64, 0, len(msgs))
+			for _, msg := range msgs {
+				batch = append(batch, msg.Offset)
+			}
+			batches = append(batches, batch)
+			return nil
+		}).WithBatch(3, time.Minute)
+		consumer := &Consumer{config: &Config{}}
+		consumer.AddBatchMiddleware(func(_ Handler, next BatchHandlerFunc) BatchHandlerFunc {
+			return func(ctx context.Context, msgs []*sarama.ConsumerMessage) error {
+				return next(ctx, msgs[1:])
+			}
+		})
+		h, err := NewGroupHandler(
+			"group",
+			[]Handler{consumer.prepare(handler)},
+			mockProducer,
+			mockClock,
+			logger,
+		)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		session := &testSession{ctx: context.Background()}
+		assert.NoError(t, h.ConsumeClaim(session, newTestClaim("topic", msgs[:3]...)))
+		assert.Equal(t, [][]int64{{2, 3}}, batches)
+		assert.Equal(t, []int64{3}, session.marked)
+	})
+	t.Run("handlers run within the session context", func(t *testing.T) {
+		type key struct{}
+		var values []any
+		handler := NewHandler("topic", "group", func(ctx context.Context, _ *sarama.ConsumerMessage) error {
+			values = append(values, ctx.Value(key{}))
+			return nil
+		})
+		batchHandler := NewBatchHandler("batches", "group", func(ctx context.Context, _ []*sarama.ConsumerMessage) error {
+			values = append(values, ctx.Value(key{}))
+			return nil
+		}).WithBatch(3, time.Minute)
+		h, err := NewGroupHandler("group", []Handler{handler, batchHandler}, mockProducer, mockClock, logger)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		session := &testSession{ctx: context.WithValue(context.Background(), key{}, "session")}
+		assert.NoError(t, h.ConsumeClaim(session, newTestClaim("topic", msgs[0])))
+		assert.NoError(t, h.ConsumeClaim(session, newTestClaim("batches", msgs[1])))
+		assert.Equal(t, []any{"session", "session"}, values)
+	})
+	t.Run("unknown topic", func(t *testing.T) {
+		h, err := NewGroupHandler("group", nil, mockProducer, mockClock, logger)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		session := &testSession{ctx: context.Background()}
+		assert.Error(t, h.ConsumeClaim(session, newTestClaim("topic", msgs...)))
+	})
+	t.Run("routing error stops the claim", func(t *testing.T) {
+		handler := NewHandler("topic", "group", func(_ context.Context, msg *sarama.ConsumerMessage) error {
+			if msg.Offset == 3 {
+				return errs.NewInvalidFormError()
+			}
+			return nil
+		}).WithWorkers(2)
+		mockProducer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("kafka is down"))
+		h, err := NewGroupHandler("group", []Handler{handler}, mockProducer, mockClock, logger)
+		if err != nil {
+			t.Fatal(err)
+			return
+		}
+		session := &testSession{ctx: context.Background()}
+		assert.Error(t, h.ConsumeClaim(session, newTestClaim("topic", msgs...)))
+		for _, offset := range session.marked {
+			assert.Less(t, offset, int64(3))
+		}
+	})
+}
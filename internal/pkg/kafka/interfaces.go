@@ -0,0 +1,33 @@
+package kafka
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type producer interface {
+	Send(ctx context.Context, message *Message) error
+}
+
+// authenticator - verifies the credentials of the commands.
+type authenticator interface {
+	Enabled() bool
+	AuthenticateHeader(ctx context.Context, header string) (context.Context, error)
+}
+
+// dtxManager - runs the command and its reply in one transaction.
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+
+// outbox - stores the command replies within the transaction of the command.
+type outbox interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
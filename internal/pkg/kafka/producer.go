@@ -8,10 +8,15 @@ import (
 	"github.com/mikalai-mitsin/example/internal/pkg/log"
 )
 
-type Message struct {
-	Topic string
-	Value []byte
+type Header struct {
 	Key   string
+	Value []byte
+}
+type Message struct {
+	Topic   string
+	Value   []byte
+	Key     string
+	Headers []Header
 }
 type Producer struct {
 	config   *Config
@@ -31,14 +36,33 @@ func NewProducer(cfg *Config, logger log.Logger) (*Producer, error) {
 	}
 	return &Producer{config: cfg, producer: producer, logger: logger}, nil
 }
-func (p *Producer) Send(_ context.Context, message *Message) error {
+func (p *Producer) Send(ctx context.Context, message *Message) error {
+	message = &Message{
+		Topic:   message.Topic,
+		Value:   message.Value,
+		Key:     message.Key,
+		Headers: append([]Header(nil), message.Headers...),
+	}
+	_, span := startProducerSpan(ctx, message)
+	defer span.End()
 	msg := &sarama.ProducerMessage{
 		Topic: message.Topic,
 		Key:   sarama.StringEncoder(message.Key),
 		Value: sarama.ByteEncoder(message.Value),
 	}
-	_, _, err := p.producer.SendMessage(msg)
-	return err
+	for _, header := range message.Headers {
+		msg.Headers = append(
+			msg.Headers,
+			sarama.RecordHeader{Key: []byte(header.Key), Value: header.Value},
+		)
+	}
+	partition, offset, err := p.producer.SendMessage(msg)
+	if err != nil {
+		recordSpanError(span, err)
+		return err
+	}
+	setSpanPosition(span, partition, offset)
+	return nil
 }
 func (p *Producer) Start(ctx context.Context) error {
 	return nil
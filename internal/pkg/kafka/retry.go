@@ -0,0 +1,160 @@
+package kafka
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderAttempts          = "x-attempts"
+	HeaderRetryStage        = "x-retry-stage"
+	HeaderRetryNotBefore    = "x-retry-not-before"
+	HeaderError             = "x-error"
+)
+
+// RetryTopic - name of the retry topic for the given stage, stages start from 1.
+func RetryTopic(topic string, stage uint) string {
+	return fmt.Sprintf("%s.retry.%d", topic, stage)
+}
+
+// DeadLetterTopic - default name of the dead-letter topic.
+func DeadLetterTopic(topic string) string {
+	return topic + ".dlq"
+}
+
+// Topics - the topic itself followed by its retry topics.
+func (p RetryPolicy) Topics(topic string) []string {
+	topics := make([]string, 0, p.RetryTopics+1)
+	topics = append(topics, topic)
+	for stage := uint(1); stage <= p.RetryTopics; stage++ {
+		topics = append(topics, RetryTopic(topic, stage))
+	}
+	return topics
+}
+
+// Delay - how long a message waits in the retry topic of the given stage.
+func (p RetryPolicy) Delay(stage uint) time.Duration {
+	delay := p.Backoff
+	for i := uint(1); i < stage; i++ {
+		if delay >= p.MaxBackoff {
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
+
+// HeaderValue - value of the last header with the given key.
+func HeaderValue(msg *sarama.ConsumerMessage, key string) (string, bool) {
+	for i := len(msg.Headers) - 1; i >= 0; i-- {
+		if msg.Headers[i] != nil && string(msg.Headers[i].Key) == key {
+			return string(msg.Headers[i].Value), true
+		}
+	}
+	return "", false
+}
+
+// OriginalTopic - topic the message was initially consumed from, before any retries.
+func OriginalTopic(msg *sarama.ConsumerMessage) string {
+	if topic, ok := HeaderValue(msg, HeaderOriginalTopic); ok {
+		return topic
+	}
+	return msg.Topic
+}
+
+// OffsetID - identity of the message by its original topic, partition and offset.
+func OffsetID(msg *sarama.ConsumerMessage) string {
+	partition, offset := originalPosition(msg)
+	return fmt.Sprintf("%s/%s/%s", OriginalTopic(msg), partition, offset)
+}
+
+func originalPosition(msg *sarama.ConsumerMessage) (string, string) {
+	partition := strconv.FormatInt(int64(msg.Partition), 10)
+	if value, ok := HeaderValue(msg, HeaderOriginalPartition); ok {
+		partition = value
+	}
+	offset := strconv.FormatInt(msg.Offset, 10)
+	if value, ok := HeaderValue(msg, HeaderOriginalOffset); ok {
+		offset = value
+	}
+	return partition, offset
+}
+
+func headerUint(msg *sarama.ConsumerMessage, key string) uint {
+	value, ok := HeaderValue(msg, key)
+	if !ok {
+		return 0
+	}
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return uint(number)
+}
+
+func retryNotBefore(msg *sarama.ConsumerMessage) time.Time {
+	value, ok := HeaderValue(msg, HeaderRetryNotBefore)
+	if !ok {
+		return time.Time{}
+	}
+	notBefore, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return notBefore
+}
+
+// failedMessage - copy of the consumed message for a retry or dead-letter topic,
+// keeps the original headers and records where the message came from, how many
+// times it was attempted and why the last attempt failed.
+func failedMessage(
+	msg *sarama.ConsumerMessage,
+	topic string,
+	attempts uint,
+	stage uint,
+	notBefore time.Time,
+	err error,
+) *Message {
+	reserved := map[string]struct{}{
+		HeaderOriginalTopic:     {},
+		HeaderOriginalPartition: {},
+		HeaderOriginalOffset:    {},
+		HeaderAttempts:          {},
+		HeaderRetryStage:        {},
+		HeaderRetryNotBefore:    {},
+		HeaderError:             {},
+	}
+	headers := make([]Header, 0, len(msg.Headers)+len(reserved))
+	for _, header := range msg.Headers {
+		if header == nil {
+			continue
+		}
+		if _, ok := reserved[string(header.Key)]; ok {
+			continue
+		}
+		headers = append(headers, Header{Key: string(header.Key), Value: header.Value})
+	}
+	partition, offset := originalPosition(msg)
+	headers = append(
+		headers,
+		Header{Key: HeaderOriginalTopic, Value: []byte(OriginalTopic(msg))},
+		Header{Key: HeaderOriginalPartition, Value: []byte(partition)},
+		Header{Key: HeaderOriginalOffset, Value: []byte(offset)},
+		Header{Key: HeaderAttempts, Value: []byte(strconv.FormatUint(uint64(attempts), 10))},
+		Header{Key: HeaderRetryStage, Value: []byte(strconv.FormatUint(uint64(stage), 10))},
+		Header{Key: HeaderError, Value: []byte(err.Error())},
+	)
+	if !notBefore.IsZero() {
+		headers = append(
+			headers,
+			Header{Key: HeaderRetryNotBefore, Value: []byte(notBefore.Format(time.RFC3339Nano))},
+		)
+	}
+	return &Message{Topic: topic, Key: string(msg.Key), Value: msg.Value, Headers: headers}
+}
@@ -0,0 +1,201 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+	assert.Equal(t, 4*time.Second, policy.Delay(3))
+	assert.Equal(t, 5*time.Second, policy.Delay(4))
+	assert.Equal(t, 5*time.Second, policy.Delay(40))
+	assert.Equal(
+		t,
+		[]string{"topic", "topic.retry.1", "topic.retry.2"},
+		RetryPolicy{RetryTopics: 2}.Topics("topic"),
+	)
+}
+
+func TestGroupHandler_handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockProducer := NewMockproducer(ctrl)
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	now := time.Now().UTC()
+	policy := RetryPolicy{
+		ImmediateRetries: 1,
+		RetryTopics:      2,
+		Backoff:          time.Second,
+		MaxBackoff:       time.Minute,
+	}
+	msg := &sarama.ConsumerMessage{
+		Topic:     "topic",
+		Partition: 1,
+		Offset:    10,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Headers:   []*sarama.RecordHeader{{Key: []byte("trace"), Value: []byte("id")}},
+	}
+	retried := &sarama.ConsumerMessage{
+		Topic:     "topic.retry.2",
+		Partition: 0,
+		Offset:    3,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("trace"), Value: []byte("id")},
+			{Key: []byte(HeaderOriginalTopic), Value: []byte("topic")},
+			{Key: []byte(HeaderOriginalPartition), Value: []byte("1")},
+			{Key: []byte(HeaderOriginalOffset), Value: []byte("10")},
+			{Key: []byte(HeaderAttempts), Value: []byte("4")},
+			{Key: []byte(HeaderRetryStage), Value: []byte("2")},
+		},
+	}
+	temporary := errors.New("database is down")
+	permanent := errs.NewInvalidFormError().WithParam("payload", "Unknown command.")
+	tests := []struct {
+		name    string
+		msg     *sarama.ConsumerMessage
+		results []error
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			msg:     msg,
+			results: []error{nil},
+			setup:   func() {},
+			wantErr: nil,
+		},
+		{
+			name:    "immediate retry",
+			msg:     msg,
+			results: []error{temporary, nil},
+			setup:   func() {},
+			wantErr: nil,
+		},
+		{
+			name:    "moved to the retry topic",
+			msg:     msg,
+			results: []error{temporary, temporary},
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockProducer.EXPECT().
+					Send(gomock.Any(), &Message{
+						Topic: "topic.retry.1",
+						Key:   "key",
+						Value: []byte("value"),
+						Headers: []Header{
+							{Key: "trace", Value: []byte("id")},
+							{Key: HeaderOriginalTopic, Value: []byte("topic")},
+							{Key: HeaderOriginalPartition, Value: []byte("1")},
+							{Key: HeaderOriginalOffset, Value: []byte("10")},
+							{Key: HeaderAttempts, Value: []byte("2")},
+							{Key: HeaderRetryStage, Value: []byte("1")},
+							{Key: HeaderError, Value: []byte("database is down")},
+							{
+								Key:   HeaderRetryNotBefore,
+								Value: []byte(now.Add(time.Second).Format(time.RFC3339Nano)),
+							},
+						},
+					}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "permanent error is moved to the dead-letter topic",
+			msg:     msg,
+			results: []error{permanent},
+			setup: func() {
+				mockProducer.EXPECT().
+					Send(gomock.Any(), &Message{
+						Topic: "topic.dlq",
+						Key:   "key",
+						Value: []byte("value"),
+						Headers: []Header{
+							{Key: "trace", Value: []byte("id")},
+							{Key: HeaderOriginalTopic, Value: []byte("topic")},
+							{Key: HeaderOriginalPartition, Value: []byte("1")},
+							{Key: HeaderOriginalOffset, Value: []byte("10")},
+							{Key: HeaderAttempts, Value: []byte("1")},
+							{Key: HeaderRetryStage, Value: []byte("0")},
+							{Key: HeaderError, Value: []byte(permanent.Error())},
+						},
+					}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "retries are exhausted",
+			msg:     retried,
+			results: []error{temporary, temporary},
+			setup: func() {
+				mockProducer.EXPECT().
+					Send(gomock.Any(), &Message{
+						Topic: "topic.dlq",
+						Key:   "key",
+						Value: []byte("value"),
+						Headers: []Header{
+							{Key: "trace", Value: []byte("id")},
+							{Key: HeaderOriginalTopic, Value: []byte("topic")},
+							{Key: HeaderOriginalPartition, Value: []byte("1")},
+							{Key: HeaderOriginalOffset, Value: []byte("10")},
+							{Key: HeaderAttempts, Value: []byte("6")},
+							{Key: HeaderRetryStage, Value: []byte("2")},
+							{Key: HeaderError, Value: []byte("database is down")},
+						},
+					}).
+					Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "dead-letter topic is unavailable",
+			msg:     msg,
+			results: []error{permanent},
+			setup: func() {
+				mockProducer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					Return(errors.New("kafka is down"))
+			},
+			wantErr: errors.New("kafka is down"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			calls := 0
+			handler := NewHandler("topic", "group", func(_ context.Context, _ *sarama.ConsumerMessage) error {
+				err := tt.results[calls]
+				calls++
+				return err
+			}).WithRetryPolicy(policy)
+			h, err := NewGroupHandler("group", []Handler{handler}, mockProducer, mockClock, logger)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+			err = h.handle(context.Background(), h.handlers["topic"], tt.msg)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, len(tt.results), calls)
+		})
+	}
+}
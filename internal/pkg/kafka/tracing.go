@@ -0,0 +1,172 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/mikalai-mitsin/example/internal/pkg/kafka"
+
+// messageCarrier - propagation.TextMapCarrier over the headers of a produced message.
+type messageCarrier struct {
+	message *Message
+}
+
+func (c messageCarrier) Get(key string) string {
+	for i := len(c.message.Headers) - 1; i >= 0; i-- {
+		if c.message.Headers[i].Key == key {
+			return string(c.message.Headers[i].Value)
+		}
+	}
+	return ""
+}
+
+func (c messageCarrier) Set(key string, value string) {
+	headers := make([]Header, 0, len(c.message.Headers)+1)
+	for _, header := range c.message.Headers {
+		if header.Key != key {
+			headers = append(headers, header)
+		}
+	}
+	c.message.Headers = append(headers, Header{Key: key, Value: []byte(value)})
+}
+
+func (c messageCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.message.Headers))
+	for _, header := range c.message.Headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+// consumerMessageCarrier - propagation.TextMapCarrier over the headers of a consumed message.
+type consumerMessageCarrier struct {
+	msg *sarama.ConsumerMessage
+}
+
+func (c consumerMessageCarrier) Get(key string) string {
+	value, _ := HeaderValue(c.msg, key)
+	return value
+}
+
+func (c consumerMessageCarrier) Set(key string, value string) {
+	c.msg.Headers = append(
+		c.msg.Headers,
+		&sarama.RecordHeader{Key: []byte(key), Value: []byte(value)},
+	)
+}
+
+func (c consumerMessageCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, header := range c.msg.Headers {
+		if header != nil {
+			keys = append(keys, string(header.Key))
+		}
+	}
+	return keys
+}
+
+// InjectTraceContext - puts the trace context of ctx into the message headers.
+func InjectTraceContext(ctx context.Context, message *Message) {
+	otel.GetTextMapPropagator().Inject(ctx, messageCarrier{message: message})
+}
+
+// startProducerSpan - starts the publish span, a message without a span in ctx
+// (e.g. relayed from the outbox) continues the trace stored in its headers.
+// The span context is injected into the message headers.
+func startProducerSpan(ctx context.Context, message *Message) (context.Context, trace.Span) {
+	carrier := messageCarrier{message: message}
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	}
+	ctx, span := otel.Tracer(tracerName).Start(
+		ctx,
+		message.Topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingOperationName("publish"),
+			semconv.MessagingDestinationName(message.Topic),
+			semconv.MessagingKafkaMessageKey(message.Key),
+			semconv.MessagingMessageBodySize(len(message.Value)),
+		),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return ctx, span
+}
+
+// startConsumerSpan - starts the process span as a child of the trace context
+// from the message headers.
+func startConsumerSpan(
+	ctx context.Context,
+	groupID string,
+	msg *sarama.ConsumerMessage,
+) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, consumerMessageCarrier{msg: msg})
+	return otel.Tracer(tracerName).Start(
+		ctx,
+		msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingOperationName("process"),
+			semconv.MessagingDestinationName(msg.Topic),
+			semconv.MessagingDestinationPartitionID(strconv.FormatInt(int64(msg.Partition), 10)),
+			semconv.MessagingKafkaConsumerGroup(groupID),
+			semconv.MessagingKafkaMessageKey(string(msg.Key)),
+			semconv.MessagingKafkaMessageOffset(int(msg.Offset)),
+			semconv.MessagingMessageBodySize(len(msg.Value)),
+		),
+	)
+}
+
+func recordSpanError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+func setSpanPosition(span trace.Span, partition int32, offset int64) {
+	span.SetAttributes(
+		semconv.MessagingDestinationPartitionID(strconv.FormatInt(int64(partition), 10)),
+		semconv.MessagingKafkaMessageOffset(int(offset)),
+	)
+}
+
+// startBatchSpan - starts the process span of a batch linked to the trace
+// contexts of its messages.
+func startBatchSpan(
+	ctx context.Context,
+	groupID string,
+	topic string,
+	msgs []*sarama.ConsumerMessage,
+) (context.Context, trace.Span) {
+	links := make([]trace.Link, 0, len(msgs))
+	for _, msg := range msgs {
+		msgCtx := otel.GetTextMapPropagator().Extract(ctx, consumerMessageCarrier{msg: msg})
+		if link := trace.LinkFromContext(msgCtx); link.SpanContext.IsValid() {
+			links = append(links, link)
+		}
+	}
+	return otel.Tracer(tracerName).Start(
+		ctx,
+		topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(links...),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingOperationName("process"),
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingKafkaConsumerGroup(groupID),
+			semconv.MessagingBatchMessageCount(len(msgs)),
+		),
+	)
+}
@@ -0,0 +1,56 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContextPropagation(t *testing.T) {
+	propagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagator)
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := trace.ContextWithSpanContext(
+		context.Background(),
+		trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}),
+	)
+	message := &Message{Topic: "topic", Headers: []Header{{Key: "key", Value: []byte("value")}}}
+	InjectTraceContext(ctx, message)
+	assert.Equal(
+		t,
+		[]Header{
+			{Key: "key", Value: []byte("value")},
+			{Key: "traceparent", Value: []byte("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")},
+		},
+		message.Headers,
+	)
+	relayCtx, producerSpan := startProducerSpan(context.Background(), message)
+	defer producerSpan.End()
+	assert.Equal(t, traceID, trace.SpanContextFromContext(relayCtx).TraceID())
+	msg := &sarama.ConsumerMessage{Topic: "topic"}
+	for _, header := range message.Headers {
+		msg.Headers = append(msg.Headers, &sarama.RecordHeader{Key: []byte(header.Key), Value: header.Value})
+	}
+	consumerCtx, consumerSpan := startConsumerSpan(context.Background(), "group", msg)
+	defer consumerSpan.End()
+	assert.Equal(t, traceID, trace.SpanContextFromContext(consumerCtx).TraceID())
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
 	"github.com/mikalai-mitsin/example/internal/pkg/errs"
 	"go.uber.org/fx/fxevent"
 )
@@ -140,14 +141,19 @@ func (l *Log) With(fields ...Field) Logger {
 }
 
 func (l *Log) WithContext(ctx context.Context) Logger {
+	var fields []Field
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
-		return l.With(
+		fields = append(
+			fields,
 			String("trace_id", span.SpanContext().TraceID().String()),
 			String("span_id", span.SpanContext().SpanID().String()),
 		)
 	}
-	return l
+	if subject, ok := auth.SubjectFromContext(ctx); ok {
+		fields = append(fields, String("subject", subject))
+	}
+	return l.With(fields...)
 }
 
 func (l *Log) SetLevel(lvl string) error {
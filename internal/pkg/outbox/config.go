@@ -0,0 +1,11 @@
+package outbox
+
+import "time"
+
+type Config struct {
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" toml:"poll_interval" env-default:"1s"`
+	BatchSize    uint64        `env:"OUTBOX_BATCH_SIZE"    toml:"batch_size"    env-default:"100"`
+	MaxBackoff   time.Duration `env:"OUTBOX_MAX_BACKOFF"   toml:"max_backoff"   env-default:"1m"`
+	MaxAttempts  uint          `env:"OUTBOX_MAX_ATTEMPTS"  toml:"max_attempts"  env-default:"10"`
+	Retention    time.Duration `env:"OUTBOX_RETENTION"     toml:"retention"     env-default:"168h"`
+}
@@ -0,0 +1,22 @@
+package outbox
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type producer interface {
+	Send(ctx context.Context, message *kafka.Message) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
+type uuidGenerator interface {
+	NewUUID() uuid.UUID
+}
@@ -0,0 +1,88 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+)
+
+// Outbox - stores messages in the same transaction as the domain changes,
+// Relay publishes them to kafka after commit.
+type Outbox struct {
+	clock clock
+	uuid  uuidGenerator
+}
+
+func NewOutbox(clock clock, uuid uuidGenerator) *Outbox {
+	return &Outbox{clock: clock, uuid: uuid}
+}
+
+// Send - stores the message within the transaction of the context, a message
+// outside of a transaction could be relayed for changes which are rolled back.
+func (o *Outbox) Send(ctx context.Context, message *kafka.Message) error {
+	tx, ok := dtx.TXFromContext(ctx)
+	if !ok {
+		return errs.NewUnexpectedBehaviorError("outbox message outside of a transaction")
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	headers := &kafka.Message{Headers: append([]kafka.Header(nil), message.Headers...)}
+	kafka.InjectTraceContext(ctx, headers)
+	data, err := encodeHeaders(headers.Headers)
+	if err != nil {
+		return err
+	}
+	now := o.clock.Now().UTC()
+	q := sq.Insert("public.outbox").
+		Columns("id", "topic", "key", "value", "headers", "available_at", "created_at").
+		Values(o.uuid.NewUUID(), message.Topic, message.Key, message.Value, data, now, now)
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if _, err := tx.GetSQLTx().ExecContext(ctx, query, args...); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	return nil
+}
+
+// headerDTO - a stored header, json encodes the value bytes as base64.
+type headerDTO struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// encodeHeaders - headers are stored as an ordered json list, the trace
+// context of the request is kept there to continue the trace when the message
+// is relayed.
+func encodeHeaders(headers []kafka.Header) ([]byte, error) {
+	values := make([]headerDTO, 0, len(headers))
+	for _, header := range headers {
+		values = append(values, headerDTO{Key: header.Key, Value: header.Value})
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, errs.NewUnexpectedBehaviorError("cant encode message headers").WithCause(err)
+	}
+	return data, nil
+}
+
+func decodeHeaders(data []byte) ([]kafka.Header, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var values []headerDTO
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, errs.NewUnexpectedBehaviorError("cant decode message headers").WithCause(err)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	headers := make([]kafka.Header, 0, len(values))
+	for _, value := range values {
+		headers = append(headers, kafka.Header{Key: value.Key, Value: value.Value})
+	}
+	return headers, nil
+}
@@ -0,0 +1,98 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mikalai-mitsin/example/internal/pkg/dtx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutbox_Send(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	mockUUID := NewMockuuidGenerator(ctrl)
+	mock.ExpectBegin()
+	mockTX, err := dtx.NewManager(mockDB, &dtx.Config{}).NewTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	query := "INSERT INTO public.outbox (id,topic,key,value,headers,available_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+	now := time.Now().UTC()
+	id := uuid.NewUUID()
+	message := &kafka.Message{Topic: "example.posts.post.v1", Key: "key", Value: []byte("value")}
+	tests := []struct {
+		name    string
+		setup   func()
+		ctx     context.Context
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockUUID.EXPECT().NewUUID().Return(id)
+				mock.ExpectExec(query).
+					WithArgs(id, message.Topic, message.Key, message.Value, []byte("[]"), now, now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			ctx:     dtx.WithTX(context.Background(), mockTX),
+			wantErr: nil,
+		},
+		{
+			name:    "outside of a transaction",
+			setup:   func() {},
+			ctx:     context.Background(),
+			wantErr: errs.NewUnexpectedBehaviorError("outbox message outside of a transaction"),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				mockUUID.EXPECT().NewUUID().Return(id)
+				mock.ExpectExec(query).
+					WithArgs(id, message.Topic, message.Key, message.Value, []byte("[]"), now, now).
+					WillReturnError(errors.New("test error"))
+			},
+			ctx:     dtx.WithTX(context.Background(), mockTX),
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			o := NewOutbox(mockClock, mockUUID)
+			err := o.Send(tt.ctx, message)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_encodeHeaders(t *testing.T) {
+	headers := []kafka.Header{
+		{Key: "traceparent", Value: []byte("00-1")},
+		{Key: "tag", Value: []byte{0x00, 0xff}},
+		{Key: "tag", Value: []byte{0x01}},
+	}
+	data, err := encodeHeaders(headers)
+	assert.NoError(t, err)
+	decoded, err := decodeHeaders(data)
+	assert.NoError(t, err)
+	assert.Equal(t, headers, decoded)
+}
@@ -0,0 +1,219 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+)
+
+type messageDTO struct {
+	ID          uuid.UUID `db:"id"`
+	Topic       string    `db:"topic"`
+	Key         string    `db:"key"`
+	Value       []byte    `db:"value"`
+	Headers     []byte    `db:"headers"`
+	Attempts    uint      `db:"attempts"`
+	AvailableAt time.Time `db:"available_at"`
+}
+
+// Table - outbox table with the columns the relay uses.
+var Table = postgres.NewTable("public.outbox", messageDTO{})
+
+// Relay - publishes pending outbox messages to kafka.
+//
+// Only one relay at a time works with the table (guarded by a session
+// advisory lock), messages are published in insertion order and a failed
+// message blocks the rest of the messages with the same key until it is
+// published or parked as dead after max attempts. Rows are not locked while
+// messages are sent, each message is marked right after its send.
+type Relay struct {
+	config   *Config
+	db       *sqlx.DB
+	producer producer
+	clock    clock
+	logger   log.Logger
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewRelay(
+	config *Config,
+	db *sqlx.DB,
+	producer *kafka.Producer,
+	clock clock,
+	logger log.Logger,
+) *Relay {
+	return &Relay{config: config, db: db, producer: producer, clock: clock, logger: logger}
+}
+
+func (r *Relay) Start(_ context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Publish(ctx); err != nil {
+					r.logger.Error("outbox relay error", log.Error(err))
+				}
+				if err := r.Cleanup(ctx); err != nil {
+					r.logger.Error("outbox cleanup error", log.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *Relay) Stop(_ context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+// Publish - publishes one batch of pending messages.
+func (r *Relay) Publish(ctx context.Context) error {
+	conn, err := r.db.Connx(ctx)
+	if err != nil {
+		return errs.FromPostgresError(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	var locked bool
+	if err := conn.GetContext(ctx, &locked, "SELECT pg_try_advisory_lock(hashtext('public.outbox'))"); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	if !locked {
+		return nil
+	}
+	defer func() {
+		if _, err := conn.ExecContext(
+			context.Background(),
+			"SELECT pg_advisory_unlock(hashtext('public.outbox'))",
+		); err != nil {
+			r.logger.Error("cant release outbox lock", log.Error(err))
+		}
+	}()
+	now := r.clock.Now().UTC()
+	// Messages behind a delayed message with the same key are skipped, so a
+	// blocked key does not take the batch from the rest of the keys.
+	delayed := sq.Select("1").
+		From("public.outbox AS delayed").
+		Where("delayed.key = outbox.key").
+		Where("delayed.seq < outbox.seq").
+		Where(sq.Eq{"delayed.published_at": nil, "delayed.dead_at": nil}).
+		Where(sq.Gt{"delayed.available_at": now})
+	q := sq.Select(
+		"outbox.id",
+		"outbox.topic",
+		"outbox.key",
+		"outbox.value",
+		"outbox.headers",
+		"outbox.attempts",
+		"outbox.available_at",
+	).
+		From("public.outbox").
+		Where(sq.Eq{"outbox.published_at": nil, "outbox.dead_at": nil}).
+		Where(sq.LtOrEq{"outbox.available_at": now}).
+		Where(delayed.Prefix("NOT EXISTS (").Suffix(")")).
+		OrderBy("outbox.seq ASC").
+		Limit(r.config.BatchSize)
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	var messages []messageDTO
+	if err := conn.SelectContext(ctx, &messages, query, args...); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	blocked := make(map[string]struct{})
+	for _, message := range messages {
+		if _, ok := blocked[message.Key]; ok {
+			continue
+		}
+		update := sq.Update("public.outbox").Where(sq.Eq{"id": message.ID})
+		headers, err := decodeHeaders(message.Headers)
+		if err != nil {
+			return err
+		}
+		sendErr := r.producer.Send(
+			ctx,
+			&kafka.Message{
+				Topic:   message.Topic,
+				Key:     message.Key,
+				Value:   message.Value,
+				Headers: headers,
+			},
+		)
+		switch {
+		case sendErr == nil:
+			update = update.Set("published_at", now)
+		case r.config.MaxAttempts > 0 && message.Attempts+1 >= r.config.MaxAttempts:
+			r.logger.Error(
+				"outbox message is dead",
+				log.Error(sendErr),
+				log.String("id", message.ID.String()),
+				log.String("topic", message.Topic),
+				log.String("key", message.Key),
+				log.Int("attempts", int(message.Attempts+1)),
+			)
+			update = update.
+				Set("attempts", message.Attempts+1).
+				Set("last_error", sendErr.Error()).
+				Set("dead_at", now)
+		default:
+			blocked[message.Key] = struct{}{}
+			r.logger.Warn(
+				"cant publish outbox message",
+				log.Error(sendErr),
+				log.String("id", message.ID.String()),
+				log.String("topic", message.Topic),
+				log.String("key", message.Key),
+				log.Int("attempts", int(message.Attempts+1)),
+			)
+			update = update.
+				Set("attempts", message.Attempts+1).
+				Set("last_error", sendErr.Error()).
+				Set("available_at", now.Add(r.backoff(message.Attempts+1)))
+		}
+		query, args := update.PlaceholderFormat(sq.Dollar).MustSql()
+		if _, err := conn.ExecContext(ctx, query, args...); err != nil {
+			return errs.FromPostgresError(err)
+		}
+	}
+	return nil
+}
+
+// Cleanup - removes published messages older than retention.
+func (r *Relay) Cleanup(ctx context.Context) error {
+	q := sq.Delete("public.outbox").
+		Where(sq.NotEq{"published_at": nil}).
+		Where(sq.Lt{"published_at": r.clock.Now().UTC().Add(-r.config.Retention)})
+	query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return errs.FromPostgresError(err)
+	}
+	return nil
+}
+
+func (r *Relay) backoff(attempts uint) time.Duration {
+	backoff := r.config.PollInterval
+	for i := uint(1); i < attempts && backoff < r.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.config.MaxBackoff)
+}
@@ -0,0 +1,169 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mikalai-mitsin/example/internal/pkg/kafka"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/mikalai-mitsin/example/internal/pkg/postgres"
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRelay_Publish(t *testing.T) {
+	mockDB, mock, err := postgres.NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer mockDB.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClock := NewMockclock(ctrl)
+	mockProducer := NewMockproducer(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	config := &Config{PollInterval: time.Second, BatchSize: 10, MaxBackoff: time.Minute, MaxAttempts: 3}
+	now := time.Now().UTC()
+	lockQuery := "SELECT pg_try_advisory_lock(hashtext('public.outbox'))"
+	unlockQuery := "SELECT pg_advisory_unlock(hashtext('public.outbox'))"
+	selectQuery := "SELECT outbox.id, outbox.topic, outbox.key, outbox.value, outbox.headers, outbox.attempts, outbox.available_at FROM public.outbox WHERE outbox.dead_at IS NULL AND outbox.published_at IS NULL AND outbox.available_at <= $1 AND NOT EXISTS ( SELECT 1 FROM public.outbox AS delayed WHERE delayed.key = outbox.key AND delayed.seq < outbox.seq AND delayed.dead_at IS NULL AND delayed.published_at IS NULL AND delayed.available_at > $2 ) ORDER BY outbox.seq ASC LIMIT 10"
+	publishedQuery := "UPDATE public.outbox SET published_at = $1 WHERE id = $2"
+	failedQuery := "UPDATE public.outbox SET attempts = $1, last_error = $2, available_at = $3 WHERE id = $4"
+	deadQuery := "UPDATE public.outbox SET attempts = $1, last_error = $2, dead_at = $3 WHERE id = $4"
+	columns := []string{"id", "topic", "key", "value", "headers", "attempts", "available_at"}
+	first, second, third := uuid.NewUUID(), uuid.NewUUID(), uuid.NewUUID()
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr bool
+	}{
+		{
+			name: "not locked",
+			setup: func() {
+				mock.ExpectQuery(lockQuery).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(false))
+			},
+			wantErr: false,
+		},
+		{
+			name: "failed message blocks its key",
+			setup: func() {
+				mock.ExpectQuery(lockQuery).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectQuery(selectQuery).
+					WithArgs(now, now).
+					WillReturnRows(
+						sqlmock.NewRows(columns).
+							AddRow(
+								first.String(),
+								"topic",
+								"a",
+								[]byte("1"),
+								[]byte(`[{"key":"traceparent","value":"MDAtMQ=="},{"key":"tag","value":"AP8="},{"key":"tag","value":"AQ=="}]`),
+								0,
+								now,
+							).
+							AddRow(second.String(), "topic", "b", []byte("2"), []byte("[]"), 0, now).
+							AddRow(third.String(), "topic", "a", []byte("3"), []byte("[]"), 0, now),
+					)
+				mockProducer.EXPECT().
+					Send(gomock.Any(), &kafka.Message{
+						Topic: "topic",
+						Key:   "a",
+						Value: []byte("1"),
+						Headers: []kafka.Header{
+							{Key: "traceparent", Value: []byte("00-1")},
+							{Key: "tag", Value: []byte{0x00, 0xff}},
+							{Key: "tag", Value: []byte{0x01}},
+						},
+					}).
+					Return(errors.New("kafka is down"))
+				mock.ExpectExec(failedQuery).
+					WithArgs(uint(1), "kafka is down", now.Add(time.Second), first).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mockProducer.EXPECT().
+					Send(gomock.Any(), &kafka.Message{Topic: "topic", Key: "b", Value: []byte("2")}).
+					Return(nil)
+				mock.ExpectExec(publishedQuery).
+					WithArgs(now, second).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(unlockQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: false,
+		},
+		{
+			name: "message is dead after max attempts",
+			setup: func() {
+				mock.ExpectQuery(lockQuery).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectQuery(selectQuery).
+					WithArgs(now, now).
+					WillReturnRows(
+						sqlmock.NewRows(columns).
+							AddRow(first.String(), "topic", "a", []byte("1"), []byte("[]"), 2, now).
+							AddRow(third.String(), "topic", "a", []byte("3"), []byte("[]"), 0, now),
+					)
+				mockProducer.EXPECT().
+					Send(gomock.Any(), &kafka.Message{Topic: "topic", Key: "a", Value: []byte("1")}).
+					Return(errors.New("message too large"))
+				mock.ExpectExec(deadQuery).
+					WithArgs(uint(3), "message too large", now, first).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mockProducer.EXPECT().
+					Send(gomock.Any(), &kafka.Message{Topic: "topic", Key: "a", Value: []byte("3")}).
+					Return(nil)
+				mock.ExpectExec(publishedQuery).
+					WithArgs(now, third).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(unlockQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: false,
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(lockQuery).
+					WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+				mockClock.EXPECT().Now().Return(now)
+				mock.ExpectQuery(selectQuery).WithArgs(now, now).WillReturnError(errors.New("test error"))
+				mock.ExpectExec(unlockQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			r := &Relay{
+				config:   config,
+				db:       mockDB,
+				producer: mockProducer,
+				clock:    mockClock,
+				logger:   logger,
+			}
+			err := r.Publish(context.Background())
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRelay_backoff(t *testing.T) {
+	r := &Relay{config: &Config{PollInterval: time.Second, MaxBackoff: 10 * time.Second}}
+	assert.Equal(t, time.Second, r.backoff(1))
+	assert.Equal(t, 2*time.Second, r.backoff(2))
+	assert.Equal(t, 8*time.Second, r.backoff(4))
+	assert.Equal(t, 10*time.Second, r.backoff(5))
+	assert.Equal(t, 10*time.Second, r.backoff(50))
+}
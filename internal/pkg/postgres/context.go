@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type primaryKey struct{}
+
+type writesKey struct{}
+
+// WithPrimary - forces the reads within the context to the primary.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// WithReadYourWrites - starts a scope, e.g. a request, where the reads go to
+// the primary once a write is made, so a replica lag can't hide the write.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(writesKey{}).(*atomic.Bool); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, writesKey{}, &atomic.Bool{})
+}
+
+// MarkWrite - records a write in the read-your-writes scope of the context.
+func MarkWrite(ctx context.Context) {
+	if written, ok := ctx.Value(writesKey{}).(*atomic.Bool); ok {
+		written.Store(true)
+	}
+}
+
+// PrimaryReads - reports whether the reads within the context must go to the primary.
+func PrimaryReads(ctx context.Context) bool {
+	if primary, ok := ctx.Value(primaryKey{}).(bool); ok && primary {
+		return true
+	}
+	written, ok := ctx.Value(writesKey{}).(*atomic.Bool)
+	return ok && written.Load()
+}
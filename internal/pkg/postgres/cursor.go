@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+)
+
+// CursorCodec - encodes the keysets of the pages into opaque cursors signed
+// with the secret of the config, so a client cannot forge a position.
+type CursorCodec struct {
+	secret []byte
+}
+
+// NewCursorCodec - fails without the secret, the cursors signed with an empty
+// secret could be forged by anyone.
+func NewCursorCodec(config *Config) (*CursorCodec, error) {
+	if config.CursorSecret == "" {
+		return nil, errs.NewUnexpectedBehaviorError("database cursor secret is required")
+	}
+	return &CursorCodec{secret: []byte(config.CursorSecret)}, nil
+}
+
+type cursorPayload struct {
+	OrderBy []string `json:"o"`
+	Values  []any    `json:"v"`
+}
+
+// Encode - cursor of the values of the ordering columns of the last row.
+func (c *CursorCodec) Encode(orderBy []string, values []any) (string, error) {
+	payload, err := json.Marshal(cursorPayload{OrderBy: orderBy, Values: values})
+	if err != nil {
+		return "", errs.NewUnexpectedBehaviorError(err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(c.sign(payload)), nil
+}
+
+// Decode - values of the cursor, fails if the cursor is forged or was issued
+// for another ordering.
+func (c *CursorCodec) Decode(cursor string, orderBy []string) ([]any, error) {
+	invalid := errs.NewInvalidFormError().WithParam("cursor", "Invalid cursor.")
+	encodedPayload, encodedSignature, found := strings.Cut(cursor, ".")
+	if !found {
+		return nil, invalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, invalid.WithCause(err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, invalid.WithCause(err)
+	}
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return nil, invalid
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var decoded cursorPayload
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, invalid.WithCause(err)
+	}
+	if !slices.Equal(decoded.OrderBy, orderBy) || len(decoded.Values) != len(orderBy) {
+		return nil, invalid
+	}
+	return decoded.Values, nil
+}
+
+func (c *CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorCodec(t *testing.T) {
+	codec, err := NewCursorCodec(&Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	orderBy := []string{"posts.created_at DESC", "posts.deleted_at ASC", "posts.id ASC"}
+	createdAt := time.Date(2026, time.March, 4, 5, 6, 7, 891011000, time.UTC)
+	cursor, err := codec.Encode(orderBy, []any{createdAt, nil, "0190f5b4-2d4e-7b2e-8c1f-5d6a7b8c9d0e"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	invalid := errs.NewInvalidFormError().WithParam("cursor", "Invalid cursor.")
+	forger, err := NewCursorCodec(&Config{CursorSecret: "forged"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	forged, err := forger.Encode(orderBy, []any{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	tests := []struct {
+		name    string
+		cursor  string
+		orderBy []string
+		want    []any
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			cursor:  cursor,
+			orderBy: orderBy,
+			want: []any{
+				"2026-03-04T05:06:07.891011Z",
+				nil,
+				"0190f5b4-2d4e-7b2e-8c1f-5d6a7b8c9d0e",
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "another ordering",
+			cursor:  cursor,
+			orderBy: []string{"posts.id ASC"},
+			want:    nil,
+			wantErr: invalid,
+		},
+		{
+			name:    "forged signature",
+			cursor:  forged,
+			orderBy: orderBy,
+			want:    nil,
+			wantErr: invalid,
+		},
+		{
+			name:    "malformed",
+			cursor:  "page-2",
+			orderBy: orderBy,
+			want:    nil,
+			wantErr: invalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := codec.Decode(tt.cursor, tt.orderBy)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewCursorCodec(t *testing.T) {
+	codec, err := NewCursorCodec(&Config{CursorSecret: ""})
+	assert.ErrorIs(t, err, errs.NewUnexpectedBehaviorError("database cursor secret is required"))
+	assert.Nil(t, codec)
+}
+
+func TestCursorCodec_numbers(t *testing.T) {
+	codec, err := NewCursorCodec(&Config{CursorSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	cursor, err := codec.Encode([]string{"likes.id ASC"}, []any{uint64(1) << 60})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	got, err := codec.Decode(cursor, []string{"likes.id ASC"})
+	assert.NoError(t, err)
+	assert.Equal(t, []any{json.Number("1152921504606846976")}, got)
+}
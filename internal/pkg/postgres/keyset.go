@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Keyset - seek predicate of the cursor pagination, matches the rows after the
+// values in the order, e.g. "posts.created_at DESC", "posts.id ASC". The last
+// column must be unique.
+//
+// The Nullable columns sort NULLs the postgres way: last in ascending and
+// first in descending order.
+type Keyset struct {
+	OrderBy  []string
+	Values   []any
+	Nullable []string
+}
+
+// nolint:stylecheck
+func (k Keyset) ToSql() (string, []interface{}, error) {
+	if len(k.OrderBy) == 0 || len(k.OrderBy) != len(k.Values) {
+		return "", nil, fmt.Errorf("keyset has %d values for %d columns", len(k.Values), len(k.OrderBy))
+	}
+	after := sq.Or{}
+	for i, item := range k.OrderBy {
+		column, desc := parseOrder(item)
+		next, ok := k.next(column, desc, k.Values[i])
+		if !ok {
+			continue
+		}
+		seek := sq.And{}
+		for j := range i {
+			previous, _ := parseOrder(k.OrderBy[j])
+			seek = append(seek, sq.Eq{previous: k.Values[j]})
+		}
+		after = append(after, append(seek, next))
+	}
+	if len(after) == 0 {
+		return "1 = 0", nil, nil
+	}
+	return after.ToSql()
+}
+
+// next - predicate of the column values after the value, false if there are
+// none.
+func (k Keyset) next(column string, desc bool, value any) (sq.Sqlizer, bool) {
+	switch {
+	case value == nil && desc:
+		return sq.NotEq{column: nil}, true
+	case value == nil:
+		return nil, false
+	case desc:
+		return sq.Lt{column: value}, true
+	case slices.Contains(k.Nullable, column):
+		return sq.Or{sq.Gt{column: value}, sq.Eq{column: nil}}, true
+	default:
+		return sq.Gt{column: value}, true
+	}
+}
+
+// KeysetValues - values of the ordering columns of the row, the columns are
+// matched with the db tags of the DTO.
+func KeysetValues(dto any, orderBy []string) []any {
+	fields := make(map[string]reflect.Value)
+	collectFields(reflect.ValueOf(dto), fields)
+	values := make([]any, len(orderBy))
+	for i, item := range orderBy {
+		column, _ := parseOrder(item)
+		if dot := strings.LastIndex(column, "."); dot >= 0 {
+			column = column[dot+1:]
+		}
+		field, ok := fields[column]
+		if !ok {
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+		values[i] = field.Interface()
+	}
+	return values
+}
+
+func collectFields(value reflect.Value, fields map[string]reflect.Value) {
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collectFields(value.Field(i), fields)
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("db"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = value.Field(i)
+	}
+}
+
+func parseOrder(item string) (string, bool) {
+	column, direction, _ := strings.Cut(item, " ")
+	return column, strings.EqualFold(direction, "DESC")
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyset_ToSql(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyset   Keyset
+		want     string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "id",
+			keyset:   Keyset{OrderBy: []string{"posts.id ASC"}, Values: []any{"a"}},
+			want:     "((posts.id > ?))",
+			wantArgs: []interface{}{"a"},
+			wantErr:  false,
+		},
+		{
+			name: "mixed directions",
+			keyset: Keyset{
+				OrderBy: []string{"posts.body DESC", "posts.id ASC"},
+				Values:  []any{"b", "a"},
+			},
+			want:     "((posts.body < ?) OR (posts.body = ? AND posts.id > ?))",
+			wantArgs: []interface{}{"b", "b", "a"},
+			wantErr:  false,
+		},
+		{
+			name: "nullable ascending",
+			keyset: Keyset{
+				OrderBy:  []string{"posts.deleted_at ASC", "posts.id ASC"},
+				Values:   []any{"t", "a"},
+				Nullable: []string{"posts.deleted_at"},
+			},
+			want:     "(((posts.deleted_at > ? OR posts.deleted_at IS NULL)) OR (posts.deleted_at = ? AND posts.id > ?))",
+			wantArgs: []interface{}{"t", "t", "a"},
+			wantErr:  false,
+		},
+		{
+			name: "null ascending",
+			keyset: Keyset{
+				OrderBy:  []string{"posts.deleted_at ASC", "posts.id ASC"},
+				Values:   []any{nil, "a"},
+				Nullable: []string{"posts.deleted_at"},
+			},
+			want:     "((posts.deleted_at IS NULL AND posts.id > ?))",
+			wantArgs: []interface{}{"a"},
+			wantErr:  false,
+		},
+		{
+			name: "null descending",
+			keyset: Keyset{
+				OrderBy:  []string{"posts.deleted_at DESC", "posts.id ASC"},
+				Values:   []any{nil, "a"},
+				Nullable: []string{"posts.deleted_at"},
+			},
+			want:     "((posts.deleted_at IS NOT NULL) OR (posts.deleted_at IS NULL AND posts.id > ?))",
+			wantArgs: []interface{}{"a"},
+			wantErr:  false,
+		},
+		{
+			name:    "values mismatch",
+			keyset:  Keyset{OrderBy: []string{"posts.id ASC"}, Values: nil},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, args, err := tt.keyset.ToSql()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestKeysetValues(t *testing.T) {
+	type baseDTO struct {
+		ID        uuid.UUID  `db:"id,omitempty"`
+		CreatedAt time.Time  `db:"created_at,omitempty"`
+		DeletedAt *time.Time `db:"deleted_at"`
+	}
+	type itemDTO struct {
+		baseDTO
+		Headline *string `db:"headline"`
+	}
+	id := uuid.NewUUID()
+	createdAt := time.Now()
+	got := KeysetValues(
+		itemDTO{baseDTO: baseDTO{ID: id, CreatedAt: createdAt, DeletedAt: nil}},
+		[]string{"posts.created_at DESC", "posts.deleted_at ASC", "posts.id ASC"},
+	)
+	assert.Equal(t, []any{createdAt, nil, id}, got)
+}
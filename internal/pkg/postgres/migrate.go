@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+)
+
+// MigrationStatus - state of a migration in the database.
+type MigrationStatus struct {
+	Version uint
+	Name    string
+	Applied bool
+	Dirty   bool
+}
+
+// Up - applies the given number of pending migrations, all of them if steps is 0.
+func (m MigrateManager) Up(_ context.Context, steps uint) error {
+	return m.run(func(instance *migrate.Migrate) error {
+		if steps == 0 {
+			return instance.Up()
+		}
+		return instance.Steps(int(steps))
+	})
+}
+
+// Down - reverts the given number of applied migrations, all of them if steps is 0.
+func (m MigrateManager) Down(_ context.Context, steps uint) error {
+	return m.run(func(instance *migrate.Migrate) error {
+		if steps == 0 {
+			return instance.Down()
+		}
+		return instance.Steps(-int(steps))
+	})
+}
+
+// Goto - migrates up or down to the given version.
+func (m MigrateManager) Goto(_ context.Context, version uint) error {
+	return m.run(func(instance *migrate.Migrate) error {
+		return instance.Migrate(version)
+	})
+}
+
+// Force - sets the version without running migrations and clears the dirty
+// state, -1 means no migrations are applied.
+func (m MigrateManager) Force(_ context.Context, version int) error {
+	return m.run(func(instance *migrate.Migrate) error {
+		return instance.Force(version)
+	})
+}
+
+// Version - the current version and whether the last migration is failed.
+func (m MigrateManager) Version(_ context.Context) (uint, bool, error) {
+	var (
+		version uint
+		dirty   bool
+	)
+	err := m.run(func(instance *migrate.Migrate) error {
+		var err error
+		version, dirty, err = instance.Version()
+		if errors.Is(err, migrate.ErrNilVersion) {
+			return nil
+		}
+		return err
+	})
+	return version, dirty, err
+}
+
+// Status - all the embedded migrations with their state.
+func (m MigrateManager) Status(ctx context.Context) ([]MigrationStatus, error) {
+	current, dirty, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := iofs.New(MigrationsFS, "migrations")
+	if err != nil {
+		return nil, errs.NewUnexpectedBehaviorError("cant read migrations").WithCause(err)
+	}
+	defer driver.Close()
+	var statuses []MigrationStatus
+	version, err := driver.First()
+	for err == nil {
+		_, name, readErr := driver.ReadUp(version)
+		if readErr != nil {
+			return nil, errs.NewUnexpectedBehaviorError("cant read migrations").WithCause(readErr)
+		}
+		statuses = append(statuses, MigrationStatus{
+			Version: version,
+			Name:    name,
+			Applied: version <= current,
+			Dirty:   dirty && version == current,
+		})
+		version, err = driver.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, errs.NewUnexpectedBehaviorError("cant read migrations").WithCause(err)
+	}
+	return statuses, nil
+}
+
+func (m MigrateManager) run(command func(instance *migrate.Migrate) error) error {
+	driver, err := iofs.New(MigrationsFS, "migrations")
+	if err != nil {
+		return errs.NewUnexpectedBehaviorError("cant read migrations").WithCause(err)
+	}
+	instance, err := migrate.NewWithSourceInstance("iofs", driver, m.config.URI)
+	if err != nil {
+		return errs.NewUnexpectedBehaviorError("cant connect to database").WithCause(err)
+	}
+	defer instance.Close()
+	if err := command(instance); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return errs.NewUnexpectedBehaviorError("migration failed").WithCause(err)
+	}
+	return nil
+}
+
+var migrationNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// CreateMigration - adds empty up and down files of the next migration to dir.
+func CreateMigration(dir string, name string) ([]string, error) {
+	if !migrationNamePattern.MatchString(name) {
+		return nil, errs.NewInvalidFormError().
+			WithParam("name", "Must contain only lowercase letters, digits and underscores.")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errs.NewUnexpectedBehaviorError("cant read migrations").WithCause(err)
+	}
+	var last uint64
+	for _, entry := range entries {
+		migration, err := source.DefaultParse(entry.Name())
+		if err != nil {
+			continue
+		}
+		last = max(last, uint64(migration.Version))
+	}
+	prefix := fmt.Sprintf("%06d_%s", last+1, name)
+	files := make([]string, 0, 2)
+	for _, direction := range []source.Direction{source.Up, source.Down} {
+		path := filepath.Join(dir, strings.Join([]string{prefix, string(direction), "sql"}, "."))
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, errs.NewUnexpectedBehaviorError("cant create migration").WithCause(err)
+		}
+		if err := file.Close(); err != nil {
+			return nil, errs.NewUnexpectedBehaviorError("cant create migration").WithCause(err)
+		}
+		files = append(files, path)
+	}
+	return files, nil
+}
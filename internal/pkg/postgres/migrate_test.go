@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMigration(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"000001_init.up.sql", "000007_inbox.up.sql", "000007_inbox.down.sql", "README.md"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+			t.Fatal(err)
+			return
+		}
+	}
+	files, err := CreateMigration(dir, "add_index")
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{
+			filepath.Join(dir, "000008_add_index.up.sql"),
+			filepath.Join(dir, "000008_add_index.down.sql"),
+		},
+		files,
+	)
+	for _, file := range files {
+		assert.FileExists(t, file)
+	}
+	_, err = CreateMigration(dir, "Add index")
+	assert.ErrorIs(
+		t,
+		err,
+		errs.NewInvalidFormError().
+			WithParam("name", "Must contain only lowercase letters, digits and underscores."),
+	)
+}
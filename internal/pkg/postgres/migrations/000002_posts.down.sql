@@ -1,11 +1,18 @@
 DROP TABLE public.posts;
 
-DELETE
-FROM public.permissions
-WHERE id IN (
-    'post_list',
-    'post_detail',
-    'post_create',
-    'post_update',
-    'post_delete'
-);
+DO
+$$
+    BEGIN
+        IF to_regclass('public.permissions') IS NOT NULL THEN
+            DELETE
+            FROM public.permissions
+            WHERE id IN (
+                     'post_list',
+                     'post_detail',
+                     'post_create',
+                     'post_update',
+                     'post_delete'
+                );
+        END IF;
+    END
+$$;
@@ -1,11 +1,18 @@
 DROP TABLE public.tags;
 
-DELETE
-FROM public.permissions
-WHERE id IN (
-    'tag_list',
-    'tag_detail',
-    'tag_create',
-    'tag_update',
-    'tag_delete'
-);
+DO
+$$
+    BEGIN
+        IF to_regclass('public.permissions') IS NOT NULL THEN
+            DELETE
+            FROM public.permissions
+            WHERE id IN (
+                     'tag_list',
+                     'tag_detail',
+                     'tag_create',
+                     'tag_update',
+                     'tag_delete'
+                );
+        END IF;
+    END
+$$;
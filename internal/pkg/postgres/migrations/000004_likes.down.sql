@@ -1,11 +1,18 @@
 DROP TABLE public.likes;
 
-DELETE
-FROM public.permissions
-WHERE id IN (
-    'like_list',
-    'like_detail',
-    'like_create',
-    'like_update',
-    'like_delete'
-);
+DO
+$$
+    BEGIN
+        IF to_regclass('public.permissions') IS NOT NULL THEN
+            DELETE
+            FROM public.permissions
+            WHERE id IN (
+                     'like_list',
+                     'like_detail',
+                     'like_create',
+                     'like_update',
+                     'like_delete'
+                );
+        END IF;
+    END
+$$;
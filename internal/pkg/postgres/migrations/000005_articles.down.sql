@@ -1,11 +1,18 @@
 DROP TABLE public.articles;
 
-DELETE
-FROM public.permissions
-WHERE id IN (
-    'article_list',
-    'article_detail',
-    'article_create',
-    'article_update',
-    'article_delete'
-);
+DO
+$$
+    BEGIN
+        IF to_regclass('public.permissions') IS NOT NULL THEN
+            DELETE
+            FROM public.permissions
+            WHERE id IN (
+                     'article_list',
+                     'article_detail',
+                     'article_create',
+                     'article_update',
+                     'article_delete'
+                );
+        END IF;
+    END
+$$;
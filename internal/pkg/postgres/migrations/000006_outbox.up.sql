@@ -0,0 +1,20 @@
+CREATE TABLE public.outbox
+(
+    id           uuid      NOT NULL
+        CONSTRAINT outbox_pk PRIMARY KEY,
+    seq          bigserial NOT NULL,
+    topic        text      NOT NULL,
+    key          text      NOT NULL,
+    value        bytea     NOT NULL,
+    attempts     integer   NOT NULL DEFAULT 0,
+    last_error   text,
+    available_at timestamp NOT NULL DEFAULT (now() at time zone 'utc'),
+    published_at timestamp,
+    created_at   timestamp NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX outbox_pending
+    ON public.outbox (seq)
+    WHERE published_at IS NULL;
+CREATE INDEX outbox_published_at
+    ON public.outbox (published_at)
+    WHERE published_at IS NOT NULL;
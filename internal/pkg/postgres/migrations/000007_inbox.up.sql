@@ -0,0 +1,10 @@
+CREATE TABLE public.inbox
+(
+    group_id     text      NOT NULL,
+    topic        text      NOT NULL,
+    message_id   text      NOT NULL,
+    processed_at timestamp NOT NULL DEFAULT (now() at time zone 'utc'),
+    CONSTRAINT inbox_pk PRIMARY KEY (group_id, topic, message_id)
+);
+CREATE INDEX inbox_processed_at
+    ON public.inbox (processed_at);
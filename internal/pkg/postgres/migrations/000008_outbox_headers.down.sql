@@ -0,0 +1,2 @@
+ALTER TABLE public.outbox
+    DROP COLUMN headers;
@@ -0,0 +1,2 @@
+ALTER TABLE public.outbox
+    ADD COLUMN headers jsonb NOT NULL DEFAULT '{}';
@@ -0,0 +1,10 @@
+DROP TABLE public.permissions;
+
+ALTER TABLE public.articles
+    DROP COLUMN deleted_at;
+ALTER TABLE public.likes
+    DROP COLUMN deleted_at;
+ALTER TABLE public.tags
+    DROP COLUMN deleted_at;
+ALTER TABLE public.posts
+    DROP COLUMN deleted_at;
@@ -0,0 +1,39 @@
+ALTER TABLE public.posts
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+ALTER TABLE public.tags
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+ALTER TABLE public.likes
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+ALTER TABLE public.articles
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+
+CREATE TABLE IF NOT EXISTS public.permissions
+(
+    id         text      NOT NULL
+        CONSTRAINT permissions_pk PRIMARY KEY,
+    name       text      NOT NULL,
+    updated_at timestamp NOT NULL DEFAULT (now() at time zone 'utc'),
+    created_at timestamp NOT NULL DEFAULT (now() at time zone 'utc')
+);
+INSERT INTO public.permissions (id, name)
+VALUES ('post_list', 'Post list'),
+       ('post_detail', 'Post detail'),
+       ('post_create', 'Post create'),
+       ('post_update', 'Post update'),
+       ('post_delete', 'Post delete'),
+       ('tag_list', 'Tag list'),
+       ('tag_detail', 'Tag detail'),
+       ('tag_create', 'Tag create'),
+       ('tag_update', 'Tag update'),
+       ('tag_delete', 'Tag delete'),
+       ('like_list', 'Like list'),
+       ('like_detail', 'Like detail'),
+       ('like_create', 'Like create'),
+       ('like_update', 'Like update'),
+       ('like_delete', 'Like delete'),
+       ('article_list', 'Article list'),
+       ('article_detail', 'Article detail'),
+       ('article_create', 'Article create'),
+       ('article_update', 'Article update'),
+       ('article_delete', 'Article delete')
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS public.search_articles;
+CREATE INDEX search_articles
+    ON public.articles
+        USING GIN (to_tsvector('english', title || subtitle || body));
+DROP INDEX IF EXISTS public.search_likes;
+DROP INDEX IF EXISTS public.search_tags;
+DROP INDEX IF EXISTS public.search_posts;
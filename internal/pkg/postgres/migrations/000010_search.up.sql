@@ -0,0 +1,13 @@
+CREATE INDEX search_posts
+    ON public.posts
+        USING GIN (to_tsvector('english', body));
+CREATE INDEX search_tags
+    ON public.tags
+        USING GIN (to_tsvector('english', value));
+CREATE INDEX search_likes
+    ON public.likes
+        USING GIN (to_tsvector('english', value));
+DROP INDEX IF EXISTS public.search_articles;
+CREATE INDEX search_articles
+    ON public.articles
+        USING GIN (to_tsvector('english', title || ' ' || subtitle || ' ' || body));
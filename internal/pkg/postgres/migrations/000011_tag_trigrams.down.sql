@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.trigram_tags;
@@ -0,0 +1,4 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX trigram_tags
+    ON public.tags
+        USING GIN (value gin_trgm_ops);
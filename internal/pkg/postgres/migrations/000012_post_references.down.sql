@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS public.post_id_likes;
+ALTER TABLE public.likes
+    DROP CONSTRAINT IF EXISTS likes_post_id_fk;
+DROP INDEX IF EXISTS public.post_id_tags;
+ALTER TABLE public.tags
+    DROP CONSTRAINT IF EXISTS tags_post_id_fk;
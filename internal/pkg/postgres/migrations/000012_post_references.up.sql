@@ -0,0 +1,13 @@
+-- Tags and likes do not outlive their post. The constraints are NOT VALID so
+-- they apply to the new rows without a full scan and without failing on the
+-- orphans written before.
+ALTER TABLE public.tags
+    ADD CONSTRAINT tags_post_id_fk FOREIGN KEY (post_id)
+        REFERENCES public.posts (id) ON DELETE CASCADE NOT VALID;
+CREATE INDEX IF NOT EXISTS post_id_tags
+    ON public.tags (post_id);
+ALTER TABLE public.likes
+    ADD CONSTRAINT likes_post_id_fk FOREIGN KEY (post_id)
+        REFERENCES public.posts (id) ON DELETE CASCADE NOT VALID;
+CREATE INDEX IF NOT EXISTS post_id_likes
+    ON public.likes (post_id);
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS public.user_roles;
+DROP TABLE IF EXISTS public.role_permissions;
+DROP TABLE IF EXISTS public.roles;
+
+DELETE
+FROM public.permissions
+WHERE id IN (
+             'grant_list',
+             'grant_detail',
+             'grant_create',
+             'grant_delete'
+    );
@@ -0,0 +1,61 @@
+-- Permissions are granted to the roles and the roles to the users, the user
+-- is the subject of the access token. The first admin is granted by hand:
+-- INSERT INTO public.user_roles (user_id, role_id) VALUES ('<subject>', 'admin');
+INSERT INTO public.permissions (id, name)
+VALUES ('grant_list', 'Grant list'),
+       ('grant_detail', 'Grant detail'),
+       ('grant_create', 'Grant create'),
+       ('grant_delete', 'Grant delete')
+ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS public.roles
+(
+    id         text      NOT NULL
+        CONSTRAINT roles_pk PRIMARY KEY,
+    name       text      NOT NULL,
+    updated_at timestamp NOT NULL DEFAULT (now() at time zone 'utc'),
+    created_at timestamp NOT NULL DEFAULT (now() at time zone 'utc')
+);
+INSERT INTO public.roles (id, name)
+VALUES ('admin', 'Admin'),
+       ('editor', 'Editor'),
+       ('viewer', 'Viewer')
+ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS public.role_permissions
+(
+    role_id       text      NOT NULL
+        CONSTRAINT role_permissions_role_id_fk REFERENCES public.roles (id) ON DELETE CASCADE,
+    permission_id text      NOT NULL
+        CONSTRAINT role_permissions_permission_id_fk REFERENCES public.permissions (id) ON DELETE CASCADE,
+    created_at    timestamp NOT NULL DEFAULT (now() at time zone 'utc'),
+    CONSTRAINT role_permissions_pk PRIMARY KEY (role_id, permission_id)
+);
+INSERT INTO public.role_permissions (role_id, permission_id)
+SELECT 'admin', id
+FROM public.permissions
+ON CONFLICT DO NOTHING;
+INSERT INTO public.role_permissions (role_id, permission_id)
+SELECT 'editor', id
+FROM public.permissions
+WHERE id NOT LIKE 'grant\_%'
+ON CONFLICT DO NOTHING;
+INSERT INTO public.role_permissions (role_id, permission_id)
+SELECT 'viewer', id
+FROM public.permissions
+WHERE id NOT LIKE 'grant\_%'
+  AND (id LIKE '%\_list' OR id LIKE '%\_detail')
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS public.user_roles
+(
+    id         uuid      DEFAULT uuidv7()
+        CONSTRAINT user_roles_pk PRIMARY KEY,
+    user_id    text      NOT NULL,
+    role_id    text      NOT NULL
+        CONSTRAINT user_roles_role_id_fk REFERENCES public.roles (id) ON DELETE CASCADE,
+    created_at timestamp NOT NULL DEFAULT (now() at time zone 'utc'),
+    CONSTRAINT user_roles_user_id_role_id_key UNIQUE (user_id, role_id)
+);
+CREATE INDEX IF NOT EXISTS role_id_user_roles
+    ON public.user_roles (role_id);
@@ -0,0 +1,13 @@
+DELETE
+FROM public.permissions
+WHERE id IN (
+             'post_moderate',
+             'article_moderate'
+    );
+
+DROP INDEX IF EXISTS public.author_id_articles;
+ALTER TABLE public.articles
+    DROP COLUMN IF EXISTS author_id;
+DROP INDEX IF EXISTS public.author_id_posts;
+ALTER TABLE public.posts
+    DROP COLUMN IF EXISTS author_id;
@@ -0,0 +1,21 @@
+-- The author is the subject which created the post or the article, the rows
+-- written before have no author and only the moderators change them.
+ALTER TABLE public.posts
+    ADD COLUMN IF NOT EXISTS author_id text NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS author_id_posts
+    ON public.posts (author_id);
+ALTER TABLE public.articles
+    ADD COLUMN IF NOT EXISTS author_id text NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS author_id_articles
+    ON public.articles (author_id);
+
+INSERT INTO public.permissions (id, name)
+VALUES ('post_moderate', 'Post moderate'),
+       ('article_moderate', 'Article moderate')
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.role_permissions (role_id, permission_id)
+VALUES ('admin', 'post_moderate'),
+       ('admin', 'article_moderate'),
+       ('editor', 'post_moderate'),
+       ('editor', 'article_moderate')
+ON CONFLICT DO NOTHING;
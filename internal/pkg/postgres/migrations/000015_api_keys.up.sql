@@ -0,0 +1,23 @@
+-- API keys of the service callers, only the SHA-256 hash of the secret is
+-- stored. The scopes are the permissions granted to the requests made with the
+-- key, they are managed with the apikey command.
+CREATE TABLE IF NOT EXISTS public.api_keys
+(
+    id           uuid      NOT NULL
+        CONSTRAINT api_keys_pk PRIMARY KEY,
+    name         text      NOT NULL,
+    hash         text      NOT NULL
+        CONSTRAINT api_keys_hash_key UNIQUE,
+    created_at   timestamp NOT NULL DEFAULT (now() at time zone 'utc'),
+    expires_at   timestamp,
+    last_used_at timestamp
+);
+
+CREATE TABLE IF NOT EXISTS public.api_key_scopes
+(
+    api_key_id    uuid NOT NULL
+        CONSTRAINT api_key_scopes_api_key_id_fk REFERENCES public.api_keys (id) ON DELETE CASCADE,
+    permission_id text NOT NULL
+        CONSTRAINT api_key_scopes_permission_id_fk REFERENCES public.permissions (id) ON DELETE CASCADE,
+    CONSTRAINT api_key_scopes_pk PRIMARY KEY (api_key_id, permission_id)
+);
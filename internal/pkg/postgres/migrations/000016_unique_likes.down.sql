@@ -0,0 +1,7 @@
+DELETE
+FROM public.permissions
+WHERE id IN (
+             'like_toggle'
+    );
+
+DROP INDEX IF EXISTS public.likes_post_id_user_id_key;
@@ -0,0 +1,25 @@
+-- A user likes a post once. The duplicates written before are soft deleted,
+-- the latest like of the user is kept.
+UPDATE public.likes
+SET deleted_at = (now() at time zone 'utc')
+WHERE id IN (SELECT id
+             FROM (SELECT id,
+                          row_number() OVER (
+                              PARTITION BY post_id, user_id
+                              ORDER BY created_at DESC, id DESC
+                              ) AS position
+                   FROM public.likes
+                   WHERE deleted_at IS NULL) AS duplicates
+             WHERE position > 1);
+CREATE UNIQUE INDEX IF NOT EXISTS likes_post_id_user_id_key
+    ON public.likes (post_id, user_id)
+    WHERE deleted_at IS NULL;
+
+INSERT INTO public.permissions (id, name)
+VALUES ('like_toggle', 'Like toggle')
+ON CONFLICT (id) DO NOTHING;
+INSERT INTO public.role_permissions (role_id, permission_id)
+VALUES ('admin', 'like_toggle'),
+       ('editor', 'like_toggle'),
+       ('viewer', 'like_toggle')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS public.outbox_pending_key;
+DROP INDEX IF EXISTS public.outbox_pending;
+CREATE INDEX IF NOT EXISTS outbox_pending
+    ON public.outbox (seq)
+    WHERE published_at IS NULL;
+UPDATE public.outbox
+SET headers = (SELECT coalesce(jsonb_object_agg(
+                                   header ->> 'key',
+                                   convert_from(decode(header ->> 'value', 'base64'), 'UTF8')
+                                   ), '{}')
+               FROM jsonb_array_elements(headers) AS header)
+WHERE jsonb_typeof(headers) = 'array';
+ALTER TABLE public.outbox
+    ALTER COLUMN headers SET DEFAULT '{}';
+ALTER TABLE public.outbox
+    DROP COLUMN dead_at;
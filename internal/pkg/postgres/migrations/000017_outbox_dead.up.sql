@@ -0,0 +1,22 @@
+-- A message which fails max attempts times is parked as dead and no longer
+-- blocks the rest of the messages with the same key.
+ALTER TABLE public.outbox
+    ADD COLUMN dead_at timestamp;
+-- Headers are an ordered list of key/value pairs, the values are base64
+-- encoded bytes.
+ALTER TABLE public.outbox
+    ALTER COLUMN headers SET DEFAULT '[]';
+UPDATE public.outbox
+SET headers = (SELECT coalesce(jsonb_agg(jsonb_build_object(
+                                   'key', header.key,
+                                   'value', encode(convert_to(header.value, 'UTF8'), 'base64')
+                                   ) ORDER BY header.key), '[]')
+               FROM jsonb_each_text(headers) AS header)
+WHERE jsonb_typeof(headers) = 'object';
+DROP INDEX IF EXISTS public.outbox_pending;
+CREATE INDEX IF NOT EXISTS outbox_pending
+    ON public.outbox (seq)
+    WHERE published_at IS NULL AND dead_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_pending_key
+    ON public.outbox (key, seq)
+    WHERE published_at IS NULL AND dead_at IS NULL;
@@ -2,13 +2,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"embed"
-	"errors"
+	"time"
 
-	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 
-	"github.com/golang-migrate/migrate/v4/source/iofs"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -16,9 +15,21 @@ import (
 var MigrationsFS embed.FS
 
 type Config struct {
-	URI                string `env:"DATABASE_URI"                  toml:"uri"`
-	MaxOpenConnections int    `env:"DATABASE_MAX_OPEN_CONNECTIONS" toml:"max_open_connections" env-default:"50"`
-	MaxIDLEConnections int    `env:"DATABASE_MAX_IDLE_CONNECTIONS" toml:"max_idle_connections" env-default:"10"`
+	URI                string        `env:"DATABASE_URI"                  toml:"uri"`
+	ReplicaURIs        []string      `env:"DATABASE_REPLICA_URIS"         toml:"replica_uris"         env-separator:","`
+	MaxOpenConnections int           `env:"DATABASE_MAX_OPEN_CONNECTIONS" toml:"max_open_connections" env-default:"50"`
+	MaxIDLEConnections int           `env:"DATABASE_MAX_IDLE_CONNECTIONS" toml:"max_idle_connections" env-default:"10"`
+	VerifySchema       bool          `env:"DATABASE_VERIFY_SCHEMA"        toml:"verify_schema"        env-default:"true"`
+	HealthCheck        time.Duration `env:"DATABASE_HEALTH_CHECK"         toml:"health_check"         env-default:"5s"`
+	MaxReplicaLag      time.Duration `env:"DATABASE_MAX_REPLICA_LAG"      toml:"max_replica_lag"      env-default:"10s"`
+	CursorSecret       string        `env:"DATABASE_CURSOR_SECRET"        toml:"cursor_secret"`
+}
+
+// Database - queries shared by the primary and the replica set.
+type Database interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
 }
 
 func NewDatabase(config *Config) (*sqlx.DB, error) {
@@ -42,21 +53,3 @@ func NewMigrateManager(database *sqlx.DB, config *Config) *MigrateManager {
 		config:   config,
 	}
 }
-
-func (m MigrateManager) Up(_ context.Context) error {
-	source, err := iofs.New(MigrationsFS, "migrations")
-	if err != nil {
-		return err
-	}
-	instance, err := migrate.NewWithSourceInstance("iofs", source, m.config.URI)
-	if err != nil {
-		return err
-	}
-	if err := instance.Up(); err != nil {
-		if errors.Is(err, migrate.ErrNoChange) {
-			return nil
-		}
-		return err
-	}
-	return nil
-}
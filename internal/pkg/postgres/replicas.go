@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+)
+
+const replicaLagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() THEN 0
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+type replica struct {
+	id      int
+	db      *sqlx.DB
+	healthy atomic.Bool
+}
+
+// ReplicaSet - spreads the reads over the healthy replicas round-robin.
+//
+// A replica is healthy while it answers the health check and its replication
+// lag is under the limit. The reads go to the primary if there is no healthy
+// replica or the context asks for it, see WithPrimary and WithReadYourWrites.
+// Writes always go to the primary.
+type ReplicaSet struct {
+	config   *Config
+	primary  *sqlx.DB
+	replicas []*replica
+	next     atomic.Uint64
+	logger   log.Logger
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewReplicaSet(config *Config, primary *sqlx.DB, logger log.Logger) (*ReplicaSet, error) {
+	set := &ReplicaSet{config: config, primary: primary, logger: logger}
+	for i, uri := range config.ReplicaURIs {
+		db, err := sqlx.Open("postgres", uri)
+		if err != nil {
+			_ = set.close()
+			return nil, err
+		}
+		db.SetMaxOpenConns(config.MaxOpenConnections)
+		db.SetMaxIdleConns(config.MaxIDLEConnections)
+		set.replicas = append(set.replicas, &replica{id: i, db: db})
+	}
+	return set, nil
+}
+
+// Start - checks the replicas and keeps checking them in the background.
+func (s *ReplicaSet) Start(ctx context.Context) error {
+	s.check(ctx)
+	if len(s.replicas) == 0 || s.config.HealthCheck <= 0 {
+		return nil
+	}
+	checkCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.config.HealthCheck)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-checkCtx.Done():
+				return
+			case <-ticker.C:
+				s.check(checkCtx)
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *ReplicaSet) Stop(_ context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return s.close()
+}
+
+func (s *ReplicaSet) close() error {
+	var result error
+	for _, replica := range s.replicas {
+		result = errors.Join(result, replica.db.Close())
+	}
+	return result
+}
+
+func (s *ReplicaSet) check(ctx context.Context) {
+	for _, replica := range s.replicas {
+		err := s.checkReplica(ctx, replica)
+		healthy := err == nil
+		if replica.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			s.logger.Info("database replica is healthy", log.Int("replica", replica.id))
+		} else {
+			s.logger.Warn(
+				"database replica is unhealthy",
+				log.Int("replica", replica.id),
+				log.Error(err),
+			)
+		}
+	}
+}
+
+func (s *ReplicaSet) checkReplica(ctx context.Context, replica *replica) error {
+	timeout := s.config.HealthCheck
+	if timeout <= 0 {
+		timeout = time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var lag float64
+	if err := replica.db.GetContext(ctx, &lag, replicaLagQuery); err != nil {
+		return err
+	}
+	if s.config.MaxReplicaLag > 0 && time.Duration(lag*float64(time.Second)) > s.config.MaxReplicaLag {
+		return errors.New("replication lag is over the limit")
+	}
+	return nil
+}
+
+// DB - database for the reads within the context.
+func (s *ReplicaSet) DB(ctx context.Context) *sqlx.DB {
+	if len(s.replicas) == 0 || PrimaryReads(ctx) {
+		return s.primary
+	}
+	start := s.next.Add(1)
+	for i := range uint64(len(s.replicas)) {
+		replica := s.replicas[(start+i)%uint64(len(s.replicas))]
+		if replica.healthy.Load() {
+			return replica.db
+		}
+	}
+	return s.primary
+}
+
+func (s *ReplicaSet) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return s.primary.ExecContext(ctx, query, args...)
+}
+
+func (s *ReplicaSet) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	return s.DB(ctx).GetContext(ctx, dest, query, args...)
+}
+
+func (s *ReplicaSet) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	return s.DB(ctx).SelectContext(ctx, dest, query, args...)
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestReplicaSet(t *testing.T, replicas int) (*ReplicaSet, []sqlmock.Sqlmock) {
+	t.Helper()
+	primary, primaryMock, err := NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &ReplicaSet{config: &Config{MaxReplicaLag: 10 * time.Second}, primary: primary, logger: logger}
+	mocks := []sqlmock.Sqlmock{primaryMock}
+	for i := range replicas {
+		var db *sqlx.DB
+		var mock sqlmock.Sqlmock
+		db, mock, err = NewMockPostgreSQL(t)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set.replicas = append(set.replicas, &replica{id: i, db: db})
+		mocks = append(mocks, mock)
+	}
+	return set, mocks
+}
+
+func TestReplicaSet_DB(t *testing.T) {
+	set, _ := newTestReplicaSet(t, 2)
+	set.replicas[0].healthy.Store(true)
+	set.replicas[1].healthy.Store(true)
+	tests := []struct {
+		name  string
+		setup func()
+		ctx   func() context.Context
+		want  []*sqlx.DB
+	}{
+		{
+			name:  "round-robin",
+			setup: func() {},
+			ctx:   context.Background,
+			want:  []*sqlx.DB{set.replicas[1].db, set.replicas[0].db, set.replicas[1].db},
+		},
+		{
+			name: "skips unhealthy replica",
+			setup: func() {
+				set.replicas[0].healthy.Store(false)
+			},
+			ctx:  context.Background,
+			want: []*sqlx.DB{set.replicas[1].db, set.replicas[1].db},
+		},
+		{
+			name: "primary without healthy replicas",
+			setup: func() {
+				set.replicas[1].healthy.Store(false)
+			},
+			ctx:  context.Background,
+			want: []*sqlx.DB{set.primary},
+		},
+		{
+			name: "primary is forced",
+			setup: func() {
+				set.replicas[0].healthy.Store(true)
+				set.replicas[1].healthy.Store(true)
+			},
+			ctx: func() context.Context {
+				return WithPrimary(context.Background())
+			},
+			want: []*sqlx.DB{set.primary, set.primary},
+		},
+		{
+			name:  "read your writes",
+			setup: func() {},
+			ctx: func() context.Context {
+				ctx := WithReadYourWrites(context.Background())
+				MarkWrite(ctx)
+				return ctx
+			},
+			want: []*sqlx.DB{set.primary, set.primary},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set.next.Store(0)
+			tt.setup()
+			ctx := tt.ctx()
+			for _, want := range tt.want {
+				assert.Same(t, want, set.DB(ctx))
+			}
+		})
+	}
+}
+
+func TestReplicaSet_check(t *testing.T) {
+	set, mocks := newTestReplicaSet(t, 3)
+	set.replicas[2].healthy.Store(true)
+	mocks[1].ExpectQuery(replicaLagQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(0.5))
+	mocks[2].ExpectQuery(replicaLagQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(60.0))
+	mocks[3].ExpectQuery(replicaLagQuery).WillReturnError(errors.New("connection refused"))
+	set.check(context.Background())
+	assert.True(t, set.replicas[0].healthy.Load())
+	assert.False(t, set.replicas[1].healthy.Load())
+	assert.False(t, set.replicas[2].healthy.Load())
+	for _, mock := range mocks {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	}
+}
+
+func TestWithReadYourWrites(t *testing.T) {
+	ctx := WithReadYourWrites(context.Background())
+	assert.False(t, PrimaryReads(ctx))
+	MarkWrite(WithReadYourWrites(context.WithValue(ctx, primaryKey{}, false)))
+	assert.True(t, PrimaryReads(ctx))
+	MarkWrite(context.Background())
+	assert.False(t, PrimaryReads(context.Background()))
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+)
+
+// Table - table with the columns a repository reads and writes.
+type Table struct {
+	Schema  string
+	Name    string
+	Columns []string
+}
+
+// NewTable - builds the table from the db tags of the repository DTO, name is
+// schema qualified, e.g. public.posts.
+func NewTable(name string, dto any) Table {
+	schema, table, found := strings.Cut(name, ".")
+	if !found {
+		schema, table = "public", name
+	}
+	return Table{Schema: schema, Name: table, Columns: columns(reflect.TypeOf(dto))}
+}
+
+func (t Table) String() string {
+	return t.Schema + "." + t.Name
+}
+
+func columns(dtoType reflect.Type) []string {
+	for dtoType.Kind() == reflect.Pointer {
+		dtoType = dtoType.Elem()
+	}
+	var result []string
+	for i := range dtoType.NumField() {
+		field := dtoType.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			result = append(result, columns(field.Type)...)
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("db"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		result = append(result, name)
+	}
+	return result
+}
+
+type columnDTO struct {
+	Name       string  `db:"column_name"`
+	IsNullable string  `db:"is_nullable"`
+	Default    *string `db:"column_default"`
+	Generated  string  `db:"is_generated"`
+	Identity   string  `db:"is_identity"`
+}
+
+// VerifySchema - compares the tables with information_schema and fails on
+// drift: a column used by a repository is missing, or a required column
+// without default is not written by the repository.
+func VerifySchema(ctx context.Context, db *sqlx.DB, tables ...Table) error {
+	var drift []string
+	for _, table := range tables {
+		q := sq.Select("column_name", "is_nullable", "column_default", "is_generated", "is_identity").
+			From("information_schema.columns").
+			Where(sq.Eq{"table_schema": table.Schema, "table_name": table.Name}).
+			OrderBy("ordinal_position")
+		query, args := q.PlaceholderFormat(sq.Dollar).MustSql()
+		var dtos []columnDTO
+		if err := db.SelectContext(ctx, &dtos, query, args...); err != nil {
+			return errs.FromPostgresError(err)
+		}
+		if len(dtos) == 0 {
+			drift = append(drift, fmt.Sprintf("%s: table is missing", table))
+			continue
+		}
+		existing := make([]string, 0, len(dtos))
+		for _, dto := range dtos {
+			existing = append(existing, dto.Name)
+			required := dto.IsNullable == "NO" &&
+				dto.Default == nil &&
+				dto.Generated == "NEVER" &&
+				dto.Identity == "NO"
+			if required && !slices.Contains(table.Columns, dto.Name) {
+				drift = append(
+					drift,
+					fmt.Sprintf("%s: required column %s is not written", table, dto.Name),
+				)
+			}
+		}
+		for _, column := range table.Columns {
+			if !slices.Contains(existing, column) {
+				drift = append(drift, fmt.Sprintf("%s: column %s is missing", table, column))
+			}
+		}
+	}
+	if len(drift) > 0 {
+		return errs.NewError(errs.ErrorCodeFailedPrecondition, "Schema drift.").
+			WithParam("drift", strings.Join(drift, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDTO struct {
+	ID        string     `db:"id,omitempty"`
+	DeletedAt *time.Time `db:"deleted_at"`
+	Body      string     `db:"body"`
+	Ignored   string     `db:"-"`
+	Untagged  string
+}
+
+func TestNewTable(t *testing.T) {
+	assert.Equal(
+		t,
+		Table{Schema: "public", Name: "posts", Columns: []string{"id", "deleted_at", "body"}},
+		NewTable("public.posts", testDTO{}),
+	)
+}
+
+func TestVerifySchema(t *testing.T) {
+	db, mock, err := NewMockPostgreSQL(t)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	defer db.Close()
+	query := "SELECT column_name, is_nullable, column_default, is_generated, is_identity FROM information_schema.columns WHERE table_name = $1 AND table_schema = $2 ORDER BY ordinal_position"
+	columns := []string{"column_name", "is_nullable", "column_default", "is_generated", "is_identity"}
+	table := NewTable("public.posts", testDTO{})
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs("posts", "public").
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("id", "NO", "uuidv7()", "NEVER", "NO").
+						AddRow("body", "NO", nil, "NEVER", "NO").
+						AddRow("deleted_at", "YES", nil, "NEVER", "NO").
+						AddRow("updated_at", "NO", "now()", "NEVER", "NO"))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "drift",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs("posts", "public").
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("id", "NO", "uuidv7()", "NEVER", "NO").
+						AddRow("body", "NO", nil, "NEVER", "NO").
+						AddRow("author_id", "NO", nil, "NEVER", "NO"))
+			},
+			wantErr: errs.NewError(errs.ErrorCodeFailedPrecondition, "Schema drift.").
+				WithParam(
+					"drift",
+					"public.posts: required column author_id is not written; public.posts: column deleted_at is missing",
+				),
+		},
+		{
+			name: "missing table",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs("posts", "public").
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantErr: errs.NewError(errs.ErrorCodeFailedPrecondition, "Schema drift.").
+				WithParam("drift", "public.posts: table is missing"),
+		},
+		{
+			name: "database error",
+			setup: func() {
+				mock.ExpectQuery(query).
+					WithArgs("posts", "public").
+					WillReturnError(errors.New("test error"))
+			},
+			wantErr: errs.FromPostgresError(errors.New("test error")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			err := VerifySchema(context.Background(), db, table)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,9 +2,27 @@ package postgres
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+
+	sq "github.com/Masterminds/squirrel"
 )
 
+// SearchLang - text search configuration of the GIN search indexes of the
+// migrations (000010_search), searches in it use the indexes.
+const SearchLang = "english"
+
+var searchLangPattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// searchWeights - weights of the fields in the relevance rank, the first field
+// is the most relevant one.
+var searchWeights = []string{"A", "B", "C", "D"}
+
+// Search - full-text search over the fields with the web search syntax:
+// quoted phrases, OR and -exclusion.
+//
+// The predicate matches the GIN indexes of the migrations only for the
+// english configuration, other languages fall back to a sequential scan.
 type Search struct {
 	Lang   string
 	Fields []string
@@ -13,13 +31,62 @@ type Search struct {
 
 // nolint:stylecheck
 func (s Search) ToSql() (string, []interface{}, error) {
+	lang, err := s.lang()
+	if err != nil {
+		return "", nil, err
+	}
+	vector := fmt.Sprintf("to_tsvector('%s', %s)", lang, strings.Join(s.Fields, " || ' ' || "))
+	return fmt.Sprintf("%s @@ %s", vector, s.query(lang)), []interface{}{s.Query}, nil
+}
+
+// Rank - orders by relevance, the earlier a field is listed, the higher its weight.
+func (s Search) Rank() sq.Sqlizer {
+	return searchExpression{search: s, build: func(lang string) string {
+		vectors := make([]string, len(s.Fields))
+		for i, field := range s.Fields {
+			weight := searchWeights[min(i, len(searchWeights)-1)]
+			vectors[i] = fmt.Sprintf("setweight(to_tsvector('%s', %s), '%s')", lang, field, weight)
+		}
+		return fmt.Sprintf("ts_rank_cd(%s, %s) DESC", strings.Join(vectors, " || "), s.query(lang))
+	}}
+}
+
+// Headline - snippet of the fields with the matches highlighted.
+func (s Search) Headline() sq.Sqlizer {
+	return searchExpression{search: s, build: func(lang string) string {
+		return fmt.Sprintf(
+			"ts_headline('%s', %s, %s, 'MaxFragments=2, MaxWords=30, MinWords=10')",
+			lang,
+			strings.Join(s.Fields, " || ' ' || "),
+			s.query(lang),
+		)
+	}}
+}
+
+func (s Search) lang() (string, error) {
 	if s.Lang == "" {
-		s.Lang = "english"
+		return SearchLang, nil
+	}
+	if !searchLangPattern.MatchString(s.Lang) {
+		return "", fmt.Errorf("invalid search language %q", s.Lang)
+	}
+	return s.Lang, nil
+}
+
+func (s Search) query(lang string) string {
+	return fmt.Sprintf("websearch_to_tsquery('%s', ?)", lang)
+}
+
+type searchExpression struct {
+	search Search
+	build  func(lang string) string
+}
+
+// nolint:stylecheck
+func (e searchExpression) ToSql() (string, []interface{}, error) {
+	lang, err := e.search.lang()
+	if err != nil {
+		return "", nil, err
 	}
-	vector := "to_tsvector('%s', %s)"
-	vector = fmt.Sprintf(vector, s.Lang, strings.Join(s.Fields, " || ' ' || "))
-	query := "plainto_tsquery('%s', ?)"
-	query = fmt.Sprintf(query, s.Lang)
-	args := []interface{}{s.Query}
-	return fmt.Sprintf("%s @@ %s", vector, query), args, nil
+	return e.build(lang), []interface{}{e.search.Query}, nil
 }
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name         string
+		search       Search
+		wantWhere    string
+		wantRank     string
+		wantHeadline string
+		wantErr      bool
+	}{
+		{
+			name:         "default language",
+			search:       Search{Fields: []string{"title", "body"}, Query: `"red cat" or dog -fish`},
+			wantWhere:    "to_tsvector('english', title || ' ' || body) @@ websearch_to_tsquery('english', ?)",
+			wantRank:     "ts_rank_cd(setweight(to_tsvector('english', title), 'A') || setweight(to_tsvector('english', body), 'B'), websearch_to_tsquery('english', ?)) DESC",
+			wantHeadline: "ts_headline('english', title || ' ' || body, websearch_to_tsquery('english', ?), 'MaxFragments=2, MaxWords=30, MinWords=10')",
+			wantErr:      false,
+		},
+		{
+			name:         "language",
+			search:       Search{Lang: "french", Fields: []string{"a", "b", "c", "d", "e"}, Query: "chat"},
+			wantWhere:    "to_tsvector('french', a || ' ' || b || ' ' || c || ' ' || d || ' ' || e) @@ websearch_to_tsquery('french', ?)",
+			wantRank:     "ts_rank_cd(setweight(to_tsvector('french', a), 'A') || setweight(to_tsvector('french', b), 'B') || setweight(to_tsvector('french', c), 'C') || setweight(to_tsvector('french', d), 'D') || setweight(to_tsvector('french', e), 'D'), websearch_to_tsquery('french', ?)) DESC",
+			wantHeadline: "ts_headline('french', a || ' ' || b || ' ' || c || ' ' || d || ' ' || e, websearch_to_tsquery('french', ?), 'MaxFragments=2, MaxWords=30, MinWords=10')",
+			wantErr:      false,
+		},
+		{
+			name:    "invalid language",
+			search:  Search{Lang: "english'); DROP TABLE posts; --", Fields: []string{"body"}, Query: "cat"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, expression := range []struct {
+				sqlizer sq.Sqlizer
+				want    string
+			}{
+				{sqlizer: tt.search, want: tt.wantWhere},
+				{sqlizer: tt.search.Rank(), want: tt.wantRank},
+				{sqlizer: tt.search.Headline(), want: tt.wantHeadline},
+			} {
+				got, args, err := expression.sqlizer.ToSql()
+				if tt.wantErr {
+					assert.Error(t, err)
+					continue
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, expression.want, got)
+				assert.Equal(t, []interface{}{tt.search.Query}, args)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package purge
+
+import "time"
+
+// Config - Retention is how long the soft deleted records of the entity are
+// kept, the entities without it are never purged. Zero Interval disables the
+// scheduled purge, the purge command still works.
+type Config struct {
+	Interval  time.Duration            `env:"PURGE_INTERVAL"   toml:"interval"   env-default:"1h"`
+	BatchSize uint64                   `env:"PURGE_BATCH_SIZE" toml:"batch_size" env-default:"100"`
+	Retention map[string]time.Duration `env:"PURGE_RETENTION"  toml:"retention"`
+}
@@ -0,0 +1,17 @@
+package purge
+
+//go:generate mockgen -package=$GOPACKAGE -source=$GOFILE -destination=mock.go
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type dtxManager interface {
+	RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(ctx context.Context) error) error
+}
+
+// clock - clock interface
+type clock interface {
+	Now() time.Time
+}
@@ -0,0 +1,148 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+)
+
+// Func - hard deletes up to limit records soft deleted before the time and
+// returns the number of them.
+type Func func(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error)
+
+// Result - number of the records of the entity purged by one run.
+type Result struct {
+	Entity string
+	Count  int
+}
+
+// errDryRun - rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+// Worker - periodically hard deletes the soft deleted records older than the
+// retention of their entity, in batches through the registered purges.
+type Worker struct {
+	config     *Config
+	dtxManager dtxManager
+	clock      clock
+	logger     log.Logger
+	entities   []string
+	purges     map[string]Func
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func NewWorker(config *Config, dtxManager dtxManager, clock clock, logger log.Logger) *Worker {
+	return &Worker{
+		config:     config,
+		dtxManager: dtxManager,
+		clock:      clock,
+		logger:     logger,
+		purges:     make(map[string]Func),
+	}
+}
+
+// Register - adds the purge of the entity, entities are purged in the order
+// they are registered.
+func (w *Worker) Register(entity string, purge Func) {
+	if _, ok := w.purges[entity]; !ok {
+		w.entities = append(w.entities, entity)
+	}
+	w.purges[entity] = purge
+}
+
+func (w *Worker) Start(_ context.Context) error {
+	if w.config.Interval <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				results, err := w.Purge(ctx, false)
+				if err != nil {
+					w.logger.Error("purge error", log.Error(err))
+					continue
+				}
+				for _, result := range results {
+					if result.Count > 0 {
+						w.logger.Info(
+							"purged",
+							log.String("entity", result.Entity),
+							log.Int("count", result.Count),
+						)
+					}
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (w *Worker) Stop(_ context.Context) error {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+	return nil
+}
+
+// Purge - purges all the entities with retention, each batch in its own
+// transaction. A dry run purges in a single transaction which is rolled back,
+// so it reports exactly what would be purged, the records stay locked until
+// it ends. The purges run as the system principal.
+func (w *Worker) Purge(ctx context.Context, dryRun bool) ([]Result, error) {
+	ctx = auth.WithSystem(ctx)
+	if !dryRun {
+		return w.purge(ctx)
+	}
+	var results []Result
+	err := w.dtxManager.RunInTx(ctx, nil, func(ctx context.Context) error {
+		var err error
+		results, err = w.purge(ctx)
+		if err != nil {
+			return err
+		}
+		return errDryRun
+	})
+	if !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (w *Worker) purge(ctx context.Context) ([]Result, error) {
+	now := w.clock.Now().UTC()
+	results := make([]Result, 0, len(w.entities))
+	for _, entity := range w.entities {
+		retention, ok := w.config.Retention[entity]
+		if !ok || retention <= 0 {
+			continue
+		}
+		result := Result{Entity: entity}
+		for {
+			count, err := w.purges[entity](ctx, now.Add(-retention), w.config.BatchSize)
+			if err != nil {
+				return nil, err
+			}
+			result.Count += count
+			if uint64(count) < w.config.BatchSize {
+				break
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,135 @@
+package purge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mikalai-mitsin/example/internal/pkg/auth"
+	"github.com/mikalai-mitsin/example/internal/pkg/errs"
+	"github.com/mikalai-mitsin/example/internal/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWorker_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDtxManager := NewMockdtxManager(ctrl)
+	mockClock := NewMockclock(ctrl)
+	logger, err := log.NewLog("error")
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	ctx := context.Background()
+	now := time.Now().UTC()
+	config := &Config{
+		BatchSize: 2,
+		Retention: map[string]time.Duration{"post": time.Hour, "tag": 0},
+	}
+	type call struct {
+		deletedBefore time.Time
+		limit         uint64
+		system        bool
+	}
+	var calls []call
+	counts := map[string][]int{}
+	purgeFunc := func(entity string) Func {
+		return func(ctx context.Context, deletedBefore time.Time, limit uint64) (int, error) {
+			calls = append(calls, call{deletedBefore: deletedBefore, limit: limit, system: auth.IsSystem(ctx)})
+			if len(counts[entity]) == 0 {
+				return 0, errs.NewUnexpectedBehaviorError("test error")
+			}
+			count := counts[entity][0]
+			counts[entity] = counts[entity][1:]
+			return count, nil
+		}
+	}
+	type args struct {
+		dryRun bool
+	}
+	tests := []struct {
+		name      string
+		setup     func()
+		args      args
+		want      []Result
+		wantCalls []call
+		wantErr   error
+	}{
+		{
+			name: "ok",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				counts["post"] = []int{2, 2, 1}
+			},
+			args: args{dryRun: false},
+			want: []Result{{Entity: "post", Count: 5}},
+			wantCalls: []call{
+				{deletedBefore: now.Add(-time.Hour), limit: 2, system: true},
+				{deletedBefore: now.Add(-time.Hour), limit: 2, system: true},
+				{deletedBefore: now.Add(-time.Hour), limit: 2, system: true},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "dry run",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(auth.WithSystem(ctx), nil, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ any, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				mockClock.EXPECT().Now().Return(now)
+				counts["post"] = []int{0}
+			},
+			args:      args{dryRun: true},
+			want:      []Result{{Entity: "post", Count: 0}},
+			wantCalls: []call{{deletedBefore: now.Add(-time.Hour), limit: 2, system: true}},
+			wantErr:   nil,
+		},
+		{
+			name: "purge error",
+			setup: func() {
+				mockClock.EXPECT().Now().Return(now)
+				counts["post"] = []int{2}
+			},
+			args: args{dryRun: false},
+			want: nil,
+			wantCalls: []call{
+				{deletedBefore: now.Add(-time.Hour), limit: 2, system: true},
+				{deletedBefore: now.Add(-time.Hour), limit: 2, system: true},
+			},
+			wantErr: errs.NewUnexpectedBehaviorError("test error"),
+		},
+		{
+			name: "dry run error",
+			setup: func() {
+				mockDtxManager.EXPECT().
+					RunInTx(auth.WithSystem(ctx), nil, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ any, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				mockClock.EXPECT().Now().Return(now)
+			},
+			args:      args{dryRun: true},
+			want:      nil,
+			wantCalls: []call{{deletedBefore: now.Add(-time.Hour), limit: 2, system: true}},
+			wantErr:   errs.NewUnexpectedBehaviorError("test error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = nil
+			tt.setup()
+			w := NewWorker(config, mockDtxManager, mockClock, logger)
+			w.Register("post", purgeFunc("post"))
+			w.Register("tag", purgeFunc("tag"))
+			w.Register("like", purgeFunc("like"))
+			got, err := w.Purge(ctx, tt.args.dryRun)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
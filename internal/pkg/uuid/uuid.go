@@ -25,6 +25,14 @@ func MustParse(id string) UUID {
 	return UUID(parse)
 }
 
+func Parse(id string) (UUID, error) {
+	parse, err := uuid.Parse(id)
+	if err != nil {
+		return UUID{}, err
+	}
+	return UUID(parse), nil
+}
+
 func (id UUID) Validate() error {
 	if err := uuid.Validate(id.String()); err != nil {
 		return errs.NewInvalidParameter("invalid format").WithCause(err)
@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ArticleService_Create_FullMethodName = "/examplepb.v1.ArticleService/Create"
-	ArticleService_Get_FullMethodName    = "/examplepb.v1.ArticleService/Get"
-	ArticleService_Update_FullMethodName = "/examplepb.v1.ArticleService/Update"
-	ArticleService_Delete_FullMethodName = "/examplepb.v1.ArticleService/Delete"
-	ArticleService_List_FullMethodName   = "/examplepb.v1.ArticleService/List"
+	ArticleService_Create_FullMethodName  = "/examplepb.v1.ArticleService/Create"
+	ArticleService_Get_FullMethodName     = "/examplepb.v1.ArticleService/Get"
+	ArticleService_Update_FullMethodName  = "/examplepb.v1.ArticleService/Update"
+	ArticleService_Delete_FullMethodName  = "/examplepb.v1.ArticleService/Delete"
+	ArticleService_Restore_FullMethodName = "/examplepb.v1.ArticleService/Restore"
+	ArticleService_List_FullMethodName    = "/examplepb.v1.ArticleService/List"
 )
 
 // ArticleServiceClient is the client API for ArticleService service.
@@ -35,6 +36,7 @@ type ArticleServiceClient interface {
 	Get(ctx context.Context, in *ArticleGet, opts ...grpc.CallOption) (*Article, error)
 	Update(ctx context.Context, in *ArticleUpdate, opts ...grpc.CallOption) (*Article, error)
 	Delete(ctx context.Context, in *ArticleDelete, opts ...grpc.CallOption) (*Article, error)
+	Restore(ctx context.Context, in *ArticleRestore, opts ...grpc.CallOption) (*Article, error)
 	List(ctx context.Context, in *ArticleFilter, opts ...grpc.CallOption) (*ListArticle, error)
 }
 
@@ -86,6 +88,16 @@ func (c *articleServiceClient) Delete(ctx context.Context, in *ArticleDelete, op
 	return out, nil
 }
 
+func (c *articleServiceClient) Restore(ctx context.Context, in *ArticleRestore, opts ...grpc.CallOption) (*Article, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Article)
+	err := c.cc.Invoke(ctx, ArticleService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) List(ctx context.Context, in *ArticleFilter, opts ...grpc.CallOption) (*ListArticle, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListArticle)
@@ -104,6 +116,7 @@ type ArticleServiceServer interface {
 	Get(context.Context, *ArticleGet) (*Article, error)
 	Update(context.Context, *ArticleUpdate) (*Article, error)
 	Delete(context.Context, *ArticleDelete) (*Article, error)
+	Restore(context.Context, *ArticleRestore) (*Article, error)
 	List(context.Context, *ArticleFilter) (*ListArticle, error)
 }
 
@@ -126,6 +139,9 @@ func (UnimplementedArticleServiceServer) Update(context.Context, *ArticleUpdate)
 func (UnimplementedArticleServiceServer) Delete(context.Context, *ArticleDelete) (*Article, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedArticleServiceServer) Restore(context.Context, *ArticleRestore) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedArticleServiceServer) List(context.Context, *ArticleFilter) (*ListArticle, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -221,6 +237,24 @@ func _ArticleService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArticleRestore)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).Restore(ctx, req.(*ArticleRestore))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArticleService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArticleFilter)
 	if err := dec(in); err != nil {
@@ -262,6 +296,10 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ArticleService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ArticleService_Restore_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _ArticleService_List_Handler,
@@ -25,6 +25,7 @@ const (
 	LikeService_Update_FullMethodName = "/examplepb.v1.LikeService/Update"
 	LikeService_Delete_FullMethodName = "/examplepb.v1.LikeService/Delete"
 	LikeService_List_FullMethodName   = "/examplepb.v1.LikeService/List"
+	LikeService_Toggle_FullMethodName = "/examplepb.v1.LikeService/Toggle"
 )
 
 // LikeServiceClient is the client API for LikeService service.
@@ -36,6 +37,9 @@ type LikeServiceClient interface {
 	Update(ctx context.Context, in *LikeUpdate, opts ...grpc.CallOption) (*Like, error)
 	Delete(ctx context.Context, in *LikeDelete, opts ...grpc.CallOption) (*Like, error)
 	List(ctx context.Context, in *LikeFilter, opts ...grpc.CallOption) (*ListLike, error)
+	// Toggle returns the like of the caller, the like is empty if the caller does
+	// not like the post after the toggle.
+	Toggle(ctx context.Context, in *LikeToggle, opts ...grpc.CallOption) (*Like, error)
 }
 
 type likeServiceClient struct {
@@ -96,6 +100,16 @@ func (c *likeServiceClient) List(ctx context.Context, in *LikeFilter, opts ...gr
 	return out, nil
 }
 
+func (c *likeServiceClient) Toggle(ctx context.Context, in *LikeToggle, opts ...grpc.CallOption) (*Like, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Like)
+	err := c.cc.Invoke(ctx, LikeService_Toggle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LikeServiceServer is the server API for LikeService service.
 // All implementations should embed UnimplementedLikeServiceServer
 // for forward compatibility.
@@ -105,6 +119,9 @@ type LikeServiceServer interface {
 	Update(context.Context, *LikeUpdate) (*Like, error)
 	Delete(context.Context, *LikeDelete) (*Like, error)
 	List(context.Context, *LikeFilter) (*ListLike, error)
+	// Toggle returns the like of the caller, the like is empty if the caller does
+	// not like the post after the toggle.
+	Toggle(context.Context, *LikeToggle) (*Like, error)
 }
 
 // UnimplementedLikeServiceServer should be embedded to have
@@ -129,6 +146,9 @@ func (UnimplementedLikeServiceServer) Delete(context.Context, *LikeDelete) (*Lik
 func (UnimplementedLikeServiceServer) List(context.Context, *LikeFilter) (*ListLike, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedLikeServiceServer) Toggle(context.Context, *LikeToggle) (*Like, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Toggle not implemented")
+}
 func (UnimplementedLikeServiceServer) testEmbeddedByValue() {}
 
 // UnsafeLikeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -239,6 +259,24 @@ func _LikeService_List_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LikeService_Toggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeToggle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LikeServiceServer).Toggle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LikeService_Toggle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LikeServiceServer).Toggle(ctx, req.(*LikeToggle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LikeService_ServiceDesc is the grpc.ServiceDesc for LikeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -266,6 +304,10 @@ var LikeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _LikeService_List_Handler,
 		},
+		{
+			MethodName: "Toggle",
+			Handler:    _LikeService_Toggle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "examplepb/v1/like.proto",
@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PostService_Create_FullMethodName = "/examplepb.v1.PostService/Create"
-	PostService_Get_FullMethodName    = "/examplepb.v1.PostService/Get"
-	PostService_Update_FullMethodName = "/examplepb.v1.PostService/Update"
-	PostService_Delete_FullMethodName = "/examplepb.v1.PostService/Delete"
-	PostService_List_FullMethodName   = "/examplepb.v1.PostService/List"
+	PostService_Create_FullMethodName  = "/examplepb.v1.PostService/Create"
+	PostService_Get_FullMethodName     = "/examplepb.v1.PostService/Get"
+	PostService_Update_FullMethodName  = "/examplepb.v1.PostService/Update"
+	PostService_Delete_FullMethodName  = "/examplepb.v1.PostService/Delete"
+	PostService_Restore_FullMethodName = "/examplepb.v1.PostService/Restore"
+	PostService_List_FullMethodName    = "/examplepb.v1.PostService/List"
 )
 
 // PostServiceClient is the client API for PostService service.
@@ -35,6 +36,7 @@ type PostServiceClient interface {
 	Get(ctx context.Context, in *PostGet, opts ...grpc.CallOption) (*Post, error)
 	Update(ctx context.Context, in *PostUpdate, opts ...grpc.CallOption) (*Post, error)
 	Delete(ctx context.Context, in *PostDelete, opts ...grpc.CallOption) (*Post, error)
+	Restore(ctx context.Context, in *PostRestore, opts ...grpc.CallOption) (*Post, error)
 	List(ctx context.Context, in *PostFilter, opts ...grpc.CallOption) (*ListPost, error)
 }
 
@@ -86,6 +88,16 @@ func (c *postServiceClient) Delete(ctx context.Context, in *PostDelete, opts ...
 	return out, nil
 }
 
+func (c *postServiceClient) Restore(ctx context.Context, in *PostRestore, opts ...grpc.CallOption) (*Post, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Post)
+	err := c.cc.Invoke(ctx, PostService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) List(ctx context.Context, in *PostFilter, opts ...grpc.CallOption) (*ListPost, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPost)
@@ -104,6 +116,7 @@ type PostServiceServer interface {
 	Get(context.Context, *PostGet) (*Post, error)
 	Update(context.Context, *PostUpdate) (*Post, error)
 	Delete(context.Context, *PostDelete) (*Post, error)
+	Restore(context.Context, *PostRestore) (*Post, error)
 	List(context.Context, *PostFilter) (*ListPost, error)
 }
 
@@ -126,6 +139,9 @@ func (UnimplementedPostServiceServer) Update(context.Context, *PostUpdate) (*Pos
 func (UnimplementedPostServiceServer) Delete(context.Context, *PostDelete) (*Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedPostServiceServer) Restore(context.Context, *PostRestore) (*Post, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedPostServiceServer) List(context.Context, *PostFilter) (*ListPost, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -221,6 +237,24 @@ func _PostService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostRestore)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).Restore(ctx, req.(*PostRestore))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PostService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PostFilter)
 	if err := dec(in); err != nil {
@@ -262,6 +296,10 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _PostService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _PostService_Restore_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _PostService_List_Handler,
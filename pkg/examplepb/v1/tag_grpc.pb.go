@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TagService_Create_FullMethodName = "/examplepb.v1.TagService/Create"
-	TagService_Get_FullMethodName    = "/examplepb.v1.TagService/Get"
-	TagService_Update_FullMethodName = "/examplepb.v1.TagService/Update"
-	TagService_Delete_FullMethodName = "/examplepb.v1.TagService/Delete"
-	TagService_List_FullMethodName   = "/examplepb.v1.TagService/List"
+	TagService_Create_FullMethodName  = "/examplepb.v1.TagService/Create"
+	TagService_Get_FullMethodName     = "/examplepb.v1.TagService/Get"
+	TagService_Update_FullMethodName  = "/examplepb.v1.TagService/Update"
+	TagService_Delete_FullMethodName  = "/examplepb.v1.TagService/Delete"
+	TagService_List_FullMethodName    = "/examplepb.v1.TagService/List"
+	TagService_Suggest_FullMethodName = "/examplepb.v1.TagService/Suggest"
 )
 
 // TagServiceClient is the client API for TagService service.
@@ -36,6 +37,7 @@ type TagServiceClient interface {
 	Update(ctx context.Context, in *TagUpdate, opts ...grpc.CallOption) (*Tag, error)
 	Delete(ctx context.Context, in *TagDelete, opts ...grpc.CallOption) (*Tag, error)
 	List(ctx context.Context, in *TagFilter, opts ...grpc.CallOption) (*ListTag, error)
+	Suggest(ctx context.Context, in *TagSuggest, opts ...grpc.CallOption) (*ListTagSuggestion, error)
 }
 
 type tagServiceClient struct {
@@ -96,6 +98,16 @@ func (c *tagServiceClient) List(ctx context.Context, in *TagFilter, opts ...grpc
 	return out, nil
 }
 
+func (c *tagServiceClient) Suggest(ctx context.Context, in *TagSuggest, opts ...grpc.CallOption) (*ListTagSuggestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagSuggestion)
+	err := c.cc.Invoke(ctx, TagService_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TagServiceServer is the server API for TagService service.
 // All implementations should embed UnimplementedTagServiceServer
 // for forward compatibility.
@@ -105,6 +117,7 @@ type TagServiceServer interface {
 	Update(context.Context, *TagUpdate) (*Tag, error)
 	Delete(context.Context, *TagDelete) (*Tag, error)
 	List(context.Context, *TagFilter) (*ListTag, error)
+	Suggest(context.Context, *TagSuggest) (*ListTagSuggestion, error)
 }
 
 // UnimplementedTagServiceServer should be embedded to have
@@ -129,6 +142,9 @@ func (UnimplementedTagServiceServer) Delete(context.Context, *TagDelete) (*Tag,
 func (UnimplementedTagServiceServer) List(context.Context, *TagFilter) (*ListTag, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedTagServiceServer) Suggest(context.Context, *TagSuggest) (*ListTagSuggestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
 func (UnimplementedTagServiceServer) testEmbeddedByValue() {}
 
 // UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -239,6 +255,24 @@ func _TagService_List_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagSuggest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).Suggest(ctx, req.(*TagSuggest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -266,6 +300,10 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _TagService_List_Handler,
 		},
+		{
+			MethodName: "Suggest",
+			Handler:    _TagService_Suggest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "examplepb/v1/tag.proto",